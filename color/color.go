@@ -11,11 +11,12 @@
 
 // Package color provides color handling structures and functions for use across
 // all of the document types.
-package color ;import (_b "fmt";_fg "github.com/unidoc/unioffice";);var AliceBlue =Color {0xF0,0xF8,0xFF,255,false };var LightSalmon =Color {0xFF,0xA0,0x7A,255,false };
+package color ;import (_b "fmt";_fg "github.com/unidoc/unioffice";_e "strconv";);var AliceBlue =Color {0xF0,0xF8,0xFF,255,false };var LightSalmon =Color {0xFF,0xA0,0x7A,255,false };
 
 // AsRGBAString is used by the various wrappers to return a pointer
-// to a string containing a six digit hex RGB value.
-func (_cfa Color )AsRGBAString ()*string {return _fg .Stringf ("\u0025\u00302\u0078\u0025\u00302\u0078\u0025\u0030\u0032\u0078\u0025\u0030\u0032\u0078",_cfa ._a ,_cfa ._be ,_cfa ._fe ,_cfa ._c );};var CadetBlue =Color {0x5F,0x9E,0xA0,255,false };var PaleTurquoise =Color {0xAF,0xEE,0xEE,255,false };var DarkCyan =Color {0x00,0x8B,0x8B,255,false };var Azure =Color {0xF0,0xFF,0xFF,255,false };var DarkGrey =Color {0xA9,0xA9,0xA9,255,false };var Snow =Color {0xFF,0xFA,0xFA,255,false };var FloralWhite =Color {0xFF,0xFA,0xF0,255,false };var BurlyWood =Color {0xDE,0xB8,0x87,255,false };
+// to a string containing an eight digit uppercase hex ARGB value, with the
+// alpha value first.
+func (_cfa Color )AsRGBAString ()*string {return _fg .Stringf ("\u0025\u00302\u0058\u0025\u00302\u0058\u0025\u0030\u0032\u0058\u0025\u0030\u0032\u0058",_cfa ._a ,_cfa ._be ,_cfa ._fe ,_cfa ._c );};var CadetBlue =Color {0x5F,0x9E,0xA0,255,false };var PaleTurquoise =Color {0xAF,0xEE,0xEE,255,false };var DarkCyan =Color {0x00,0x8B,0x8B,255,false };var Azure =Color {0xF0,0xFF,0xFF,255,false };var DarkGrey =Color {0xA9,0xA9,0xA9,255,false };var Snow =Color {0xFF,0xFA,0xFA,255,false };var FloralWhite =Color {0xFF,0xFA,0xF0,255,false };var BurlyWood =Color {0xDE,0xB8,0x87,255,false };
 
 // Color is a 24 bit color that can be converted to
 // internal ECMA-376 formats as needed.
@@ -25,13 +26,19 @@ type Color struct{_be ,_fe ,_c ,_a uint8 ;_cf bool ;};var LavenderBlush =Color {
 func RGB (r ,g ,b uint8 )Color {return Color {r ,g ,b ,255,false }};var LemonChiffon =Color {0xFF,0xFA,0xCD,255,false };var DarkSeaGreen =Color {0x8F,0xBC,0x8F,255,false };var Maroon =Color {0x80,0x00,0x00,255,false };var LimeGreen =Color {0x32,0xCD,0x32,255,false };var LightSlateGray =Color {0x77,0x88,0x99,255,false };var AntiqueWhite =Color {0xFA,0xEB,0xD7,255,false };var Wheat =Color {0xF5,0xDE,0xB3,255,false };var SpringGreen =Color {0x00,0xFF,0x7F,255,false };var Thistle =Color {0xD8,0xBF,0xD8,255,false };func FromHex (s string )Color {if len (s )==0{return Auto ;};if s [0]=='#'{s =s [1:];};var _g ,_fc ,_fgd uint8 ;_bb ,_ :=_b .Sscanf (s ,"\u0025\u0030\u0032x\u0025\u0030\u0032\u0078\u0025\u0030\u0032\u0078",&_g ,&_fc ,&_fgd );if _bb ==3{return RGB (_g ,_fc ,_fgd );};return Auto ;};var Violet =Color {0xEE,0x82,0xEE,255,false };var Blue =Color {0x00,0x00,0xFF,255,false };var MediumPurple =Color {0x93,0x70,0xDB,255,false };var SlateBlue =Color {0x6A,0x5A,0xCD,255,false };var Green =Color {0x00,0x80,0x00,255,false };var Gray =Color {0x80,0x80,0x80,255,false };var WhiteSmoke =Color {0xF5,0xF5,0xF5,255,false };var LightGreen =Color {0x90,0xEE,0x90,255,false };var Tomato =Color {0xFF,0x63,0x47,255,false };var Purple =Color {0x80,0x00,0x80,255,false };var RosyBrown =Color {0xBC,0x8F,0x8F,255,false };var MediumTurquoise =Color {0x48,0xD1,0xCC,255,false };var DarkGoldenRod =Color {0xB8,0x86,0x0B,255,false };var Beige =Color {0xF5,0xF5,0xDC,255,false };var Olive =Color {0x80,0x80,0x00,255,false };var Silver =Color {0xC0,0xC0,0xC0,255,false };var PaleGreen =Color {0x98,0xFB,0x98,255,false };var Ivory =Color {0xFF,0xFF,0xF0,255,false };var CornflowerBlue =Color {0x64,0x95,0xED,255,false };var Orchid =Color {0xDA,0x70,0xD6,255,false };var Brown =Color {0xA5,0x2A,0x2A,255,false };var Turquoise =Color {0x40,0xE0,0xD0,255,false };var LightPink =Color {0xFF,0xB6,0xC1,255,false };var Salmon =Color {0xFA,0x80,0x72,255,false };
 
 // AsRGBString is used by the various wrappers to return a pointer
-// to a string containing a six digit hex RGB value.
-func (_fgg Color )AsRGBString ()*string {return _fg .Stringf ("\u0025\u0030\u0032x\u0025\u0030\u0032\u0078\u0025\u0030\u0032\u0078",_fgg ._be ,_fgg ._fe ,_fgg ._c );};var DarkOrange =Color {0xFF,0x8C,0x00,255,false };var ForestGreen =Color {0x22,0x8B,0x22,255,false };var GhostWhite =Color {0xF8,0xF8,0xFF,255,false };var PowderBlue =Color {0xB0,0xE0,0xE6,255,false };var DeepPink =Color {0xFF,0x14,0x93,255,false };var DarkSlateGray =Color {0x2F,0x4F,0x4F,255,false };var DarkViolet =Color {0x94,0x00,0xD3,255,false };var LightSeaGreen =Color {0x20,0xB2,0xAA,255,false };var Lime =Color {0x00,0xFF,0x00,255,false };var DarkOliveGreen =Color {0x55,0x6B,0x2F,255,false };var GoldenRod =Color {0xDA,0xA5,0x20,255,false };var GreenYellow =Color {0xAD,0xFF,0x2F,255,false };var OldLace =Color {0xFD,0xF5,0xE6,255,false };var DarkMagenta =Color {0x8B,0x00,0x8B,255,false };var Teal =Color {0x00,0x80,0x80,255,false };var Chocolate =Color {0xD2,0x69,0x1E,255,false };var Aqua =Color {0x00,0xFF,0xFF,255,false };var BlanchedAlmond =Color {0xFF,0xEB,0xCD,255,false };var DarkGreen =Color {0x00,0x64,0x00,255,false };var SuccessGreen =Color {0x00,0xCC,0x00,255,false };var Linen =Color {0xFA,0xF0,0xE6,255,false };var Fuchsia =Color {0xFF,0x00,0xFF,255,false };var PaleVioletRed =Color {0xDB,0x70,0x93,255,false };var Cornsilk =Color {0xFF,0xF8,0xDC,255,false };var DimGray =Color {0x69,0x69,0x69,255,false };var MediumSeaGreen =Color {0x3C,0xB3,0x71,255,false };var BlueViolet =Color {0x8A,0x2B,0xE2,255,false };var OrangeRed =Color {0xFF,0x45,0x00,255,false };var DarkKhaki =Color {0xBD,0xB7,0x6B,255,false };var MediumSpringGreen =Color {0x00,0xFA,0x9A,255,false };var Crimson =Color {0xDC,0x14,0x3C,255,false };var Yellow =Color {0xFF,0xFF,0x00,255,false };var LightSteelBlue =Color {0xB0,0xC4,0xDE,255,false };var NavajoWhite =Color {0xFF,0xDE,0xAD,255,false };var SandyBrown =Color {0xF4,0xA4,0x60,255,false };var MediumBlue =Color {0x00,0x00,0xCD,255,false };
+// to a string containing a six digit uppercase hex RGB value without a leading
+// '#'.
+func (_fgg Color )AsRGBString ()*string {return _fg .Stringf ("\u0025\u0030\u0032X\u0025\u0030\u0032\u0058\u0025\u0030\u0032\u0058",_fgg ._be ,_fgg ._fe ,_fgg ._c );};var DarkOrange =Color {0xFF,0x8C,0x00,255,false };var ForestGreen =Color {0x22,0x8B,0x22,255,false };var GhostWhite =Color {0xF8,0xF8,0xFF,255,false };var PowderBlue =Color {0xB0,0xE0,0xE6,255,false };var DeepPink =Color {0xFF,0x14,0x93,255,false };var DarkSlateGray =Color {0x2F,0x4F,0x4F,255,false };var DarkViolet =Color {0x94,0x00,0xD3,255,false };var LightSeaGreen =Color {0x20,0xB2,0xAA,255,false };var Lime =Color {0x00,0xFF,0x00,255,false };var DarkOliveGreen =Color {0x55,0x6B,0x2F,255,false };var GoldenRod =Color {0xDA,0xA5,0x20,255,false };var GreenYellow =Color {0xAD,0xFF,0x2F,255,false };var OldLace =Color {0xFD,0xF5,0xE6,255,false };var DarkMagenta =Color {0x8B,0x00,0x8B,255,false };var Teal =Color {0x00,0x80,0x80,255,false };var Chocolate =Color {0xD2,0x69,0x1E,255,false };var Aqua =Color {0x00,0xFF,0xFF,255,false };var BlanchedAlmond =Color {0xFF,0xEB,0xCD,255,false };var DarkGreen =Color {0x00,0x64,0x00,255,false };var SuccessGreen =Color {0x00,0xCC,0x00,255,false };var Linen =Color {0xFA,0xF0,0xE6,255,false };var Fuchsia =Color {0xFF,0x00,0xFF,255,false };var PaleVioletRed =Color {0xDB,0x70,0x93,255,false };var Cornsilk =Color {0xFF,0xF8,0xDC,255,false };var DimGray =Color {0x69,0x69,0x69,255,false };var MediumSeaGreen =Color {0x3C,0xB3,0x71,255,false };var BlueViolet =Color {0x8A,0x2B,0xE2,255,false };var OrangeRed =Color {0xFF,0x45,0x00,255,false };var DarkKhaki =Color {0xBD,0xB7,0x6B,255,false };var MediumSpringGreen =Color {0x00,0xFA,0x9A,255,false };var Crimson =Color {0xDC,0x14,0x3C,255,false };var Yellow =Color {0xFF,0xFF,0x00,255,false };var LightSteelBlue =Color {0xB0,0xC4,0xDE,255,false };var NavajoWhite =Color {0xFF,0xDE,0xAD,255,false };var SandyBrown =Color {0xF4,0xA4,0x60,255,false };var MediumBlue =Color {0x00,0x00,0xCD,255,false };
 
 // RGBA constructs a new RGBA color with a given red, green, blue and alpha
 // value.
 func RGBA (r ,g ,b ,a uint8 )Color {return Color {r ,g ,b ,a ,false }};var LightSlateGrey =Color {0x77,0x88,0x99,255,false };
 
+// ParseHex parses a color in the form "#RRGGBB" or "RRGGBB", returning an
+// error if s isn't a valid hex color.  Unlike FromHex, invalid input isn't
+// silently treated as Auto.
+func ParseHex (s string )(Color ,error ){_gcdae :=s ;if len (_gcdae )> 0&&_gcdae [0]=='#'{_gcdae =_gcdae [1:];};if len (_gcdae )!=6{return Color {},_b .Errorf ("i\u006e\u0076\u0061l\u0069\u0064\u0020\u0068\u0065\u0078\u0020\u0063\u006f\u006c\u006fr\u0020\u0025\u0071\u002c\u0020\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0052\u0052\u0047\u0047B\u0042",s );};_ccca ,_bcfea :=_e .ParseUint (_gcdae ,16,32);if _bcfea !=nil {return Color {},_b .Errorf ("\u0069\u006e\u0076a\u006c\u0069\u0064\u0020\u0068\u0065\u0078\u0020\u0063\u006f\u006co\u0072\u0020\u0025\u0071\u002c\u0020\u0065\u0078\u0070\u0065\u0063t\u0065\u0064\u0020\u0052\u0052\u0047\u0047\u0042\u0042",s );};return RGB (uint8 (_ccca >>16),uint8 (_ccca >>8),uint8 (_ccca )),nil ;};
+
 // IsAuto returns true if the color is the 'Auto' type.  If the
 // field doesn't support an Auto color, then black is used.
 func (_ab Color )IsAuto ()bool {return _ab ._cf };var Black =Color {0x00,0x00,0x00,255,false };var LightCoral =Color {0xF0,0x80,0x80,255,false };var PapayaWhip =Color {0xFF,0xEF,0xD5,255,false };var DarkSlateBlue =Color {0x48,0x3D,0x8B,255,false };var RoyalBlue =Color {0x41,0x69,0xE1,255,false };
\ No newline at end of file
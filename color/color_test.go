@@ -0,0 +1,17 @@
+package color_test
+
+import (
+	"testing"
+
+	"github.com/unidoc/unioffice/color"
+)
+
+func TestHexStringsUppercase(t *testing.T) {
+	c := color.RGBA(0xab, 0xcd, 0xef, 0x7f)
+	if got := *c.AsRGBString(); got != "ABCDEF" {
+		t.Errorf("expected ABCDEF, got %s", got)
+	}
+	if got := *c.AsRGBAString(); got != "7FABCDEF" {
+		t.Errorf("expected 7FABCDEF, got %s", got)
+	}
+}
@@ -53,7 +53,7 @@ type ImageRef struct{_dae *DocBase ;_gccd Relationships ;_abdf Image ;_edd strin
 type TableStyles struct{_gab *_fa .TblStyleLst };func (_dbd CustomProperties )SetPropertyAsR8 (name string ,r8 float64 ){_ccc :=_dbd .getNewProperty (name );_ccc .R8 =&r8 ;_dbd .setProperty (_ccc );};func (_bbc CustomProperties )SetPropertyAsOstream (name string ,ostream string ){_bac :=_bbc .getNewProperty (name );_bac .Ostream =&ostream ;_bbc .setProperty (_bac );};
 
 // FindRIDForN returns the relationship ID for the i'th relationship of type t.
-func (_ebfg Relationships )FindRIDForN (i int ,t string )string {for _ ,_gda :=range _ebfg ._fgf .CT_Relationships .Relationship {if _gda .TypeAttr ==t {if i ==0{return _gda .IdAttr ;};i --;};};return "";};func (_agc CustomProperties )SetPropertyAsInt (name string ,i int ){_def :=_agc .getNewProperty (name );_ge :=int32 (i );_def .Int =&_ge ;_agc .setProperty (_def );};
+func (_ebfg Relationships )FindRIDForN (i int ,t string )string {if _bcd :=_ebfg ._cfcf ;_bcd !=nil &&_bcd ._dagac !=nil &&_bcd ._gfab ==len (_ebfg ._fgf .Relationship ){if i >=0&&i < len (_bcd ._dagac [t ]){return _bcd ._dagac [t ][i ];};return "";};for _ ,_gda :=range _ebfg ._fgf .CT_Relationships .Relationship {if _gda .TypeAttr ==t {if i ==0{return _gda .IdAttr ;};i --;};};return "";};func (_agc CustomProperties )SetPropertyAsInt (name string ,i int ){_def :=_agc .getNewProperty (name );_ge :=int32 (i );_def .Int =&_ge ;_agc .setProperty (_def );};
 
 // SetModified sets the time that the document was modified.
 func (_fee CoreProperties )SetModified (t _a .Time ){_fee ._af .Modified =_bbf (t ,"\u0064\u0063t\u0065\u0072\u006ds\u003a\u006d\u006f\u0064\u0069\u0066\u0069\u0065\u0064");};var ReleasedAt =_a .Date (_gee ,_bba ,_aggc ,_fgff ,_cce ,0,0,_a .UTC );
@@ -91,7 +91,7 @@ func (_cbd *DocBase )AddExtraFileFromZip (f *_cb .File )error {_bbff ,_acg :=_fe
 func (_gdc ImageRef )Path ()string {return _gdc ._abdf .Path };func (_add CustomProperties )SetPropertyAsUi1 (name string ,ui1 uint8 ){_ffd :=_add .getNewProperty (name );_ffd .Ui1 =&ui1 ;_add .setProperty (_ffd );};
 
 // NewRelationshipsCopy creates a new relationships wrapper as a copy of passed in instance.
-func NewRelationshipsCopy (rels Relationships )Relationships {_cece :=*rels ._fgf ;return Relationships {_fgf :&_cece };};
+func NewRelationshipsCopy (rels Relationships )Relationships {_cece :=*rels ._fgf ;return Relationships {_fgf :&_cece ,_cfcf :&relationshipCache {}};};
 
 // RemoveOverride removes an override given a path.
 func (_g ContentTypes )RemoveOverride (path string ){if !_f .HasPrefix (path ,"\u002f"){path ="\u002f"+path ;};for _abd ,_eg :=range _g ._ceee .Override {if _eg .PartNameAttr ==path {copy (_g ._ceee .Override [_abd :],_g ._ceee .Override [_abd +1:]);_g ._ceee .Override =_g ._ceee .Override [0:len (_g ._ceee .Override )-1];};};};func (_face CustomProperties )SetPropertyAsArray (name string ,array *_ag .Array ){_abg :=_face .getNewProperty (name );_abg .Array =array ;_face .setProperty (_abg );};
@@ -133,7 +133,7 @@ func (_aa CoreProperties )Created ()_a .Time {return _bc (_aa ._af .Created )};f
 func (_dbb ImageRef )RelativeWidth (h _fd .Distance )_fd .Distance {_afc :=float64 (_dbb .Size ().X )/float64 (_dbb .Size ().Y );return h *_fd .Distance (_afc );};
 
 // Remove removes an existing relationship.
-func (_bfc Relationships )Remove (rel Relationship )bool {for _aegg ,_bce :=range _bfc ._fgf .Relationship {if _bce ==rel ._gbd {copy (_bfc ._fgf .Relationship [_aegg :],_bfc ._fgf .Relationship [_aegg +1:]);_bfc ._fgf .Relationship =_bfc ._fgf .Relationship [0:len (_bfc ._fgf .Relationship )-1];return true ;};};return false ;};func (_aac CustomProperties )SetPropertyAsUi2 (name string ,ui2 uint16 ){_afa :=_aac .getNewProperty (name );_afa .Ui2 =&ui2 ;_aac .setProperty (_afa );};
+func (_bfc Relationships )Remove (rel Relationship )bool {for _aegg ,_bce :=range _bfc ._fgf .Relationship {if _bce ==rel ._gbd {copy (_bfc ._fgf .Relationship [_aegg :],_bfc ._fgf .Relationship [_aegg +1:]);_bfc ._fgf .Relationship =_bfc ._fgf .Relationship [0:len (_bfc ._fgf .Relationship )-1];_bfc .invalidate ();return true ;};};return false ;};func (_aac CustomProperties )SetPropertyAsUi2 (name string ,ui2 uint16 ){_afa :=_aac .getNewProperty (name );_afa .Ui2 =&ui2 ;_aac .setProperty (_afa );};
 
 // X returns the inner wrapped XML type.
 func (_ecgd TableStyles )X ()*_fa .TblStyleLst {return _ecgd ._gab };
@@ -141,8 +141,9 @@ func (_ecgd TableStyles )X ()*_fa .TblStyleLst {return _ecgd ._gab };
 // Data returns the data of an image file, if any.
 func (_gdg ImageRef )Data ()*[]byte {return _gdg ._abdf .Data };
 
-// X returns the underlying raw XML data.
-func (_ccd Relationships )X ()*_agf .Relationships {return _ccd ._fgf };func (_ebcg CustomProperties )SetPropertyAsError (name string ,error string ){_daf :=_ebcg .getNewProperty (name );_daf .Error =&error ;_ebcg .setProperty (_daf );};
+// X returns the underlying raw XML data.  Calling X discards the cached
+// relationship IDs, as the relationships may be modified through it.
+func (_ccd Relationships )X ()*_agf .Relationships {_ccd .invalidate ();return _ccd ._fgf };func (_ebcg CustomProperties )SetPropertyAsError (name string ,error string ){_daf :=_ebcg .getNewProperty (name );_daf .Error =&error ;_ebcg .setProperty (_daf );};
 
 // LastModifiedBy returns the name of the last person to modify the document
 func (_cff CoreProperties )LastModifiedBy ()string {if _cff ._af .LastModifiedBy !=nil {return *_cff ._af .LastModifiedBy ;};return "";};
@@ -172,7 +173,7 @@ func (_dfd AppProperties )X ()*_ca .Properties {return _dfd ._efa };
 func (_bea AppProperties )Pages ()int32 {if _bea ._efa .Pages !=nil {return *_bea ._efa .Pages ;};return 0;};
 
 // Relationships represents a .rels file.
-type Relationships struct{_fgf *_agf .Relationships };func (_dfdd CustomProperties )SetPropertyAsStorage (name string ,storage string ){_cdf :=_dfdd .getNewProperty (name );_cdf .Storage =&storage ;_dfdd .setProperty (_cdf );};
+type Relationships struct{_fgf *_agf .Relationships ;_cfcf *relationshipCache ;};func (_dfdd CustomProperties )SetPropertyAsStorage (name string ,storage string ){_cdf :=_dfdd .getNewProperty (name );_cdf .Storage =&storage ;_dfdd .setProperty (_cdf );};
 
 // Image is a container for image information. It's used as we need format and
 // and size information to use images.
@@ -215,6 +216,8 @@ func (_adc Relationships )AddHyperlink (target string )Hyperlink {_bcb :=_adc .A
 // to ensure consistent filenames are maintained.
 func (_cbee Relationships )AddAutoRelationship (dt _bb .DocType ,src string ,idx int ,ctype string )Relationship {return _cbee .AddRelationship (_bb .RelativeFilename (dt ,src ,ctype ,idx ),ctype );};
 
+func (_gec Relationships )cache ()*relationshipCache {_cgfca :=_gec ._cfcf ;if _cgfca ==nil {return nil ;};if _cgfca ._dagac ==nil ||_cgfca ._gfab !=len (_gec ._fgf .Relationship ){_cgfca ._dagac =map[string ][]string {};_cgfca ._bceb =0;for _ ,_edgd :=range _gec ._fgf .Relationship {_cgfca ._dagac [_edgd .TypeAttr ]=append (_cgfca ._dagac [_edgd .TypeAttr ],_edgd .IdAttr );if _fde ,_gbg :=_bafda (_edgd .IdAttr );_gbg &&_fde > _cgfca ._bceb {_cgfca ._bceb =_fde ;};};_cgfca ._gfab =len (_gec ._fgf .Relationship );};return _cgfca ;};func (_gagd Relationships )invalidate (){if _gagd ._cfcf !=nil {_gagd ._cfcf ._dagac =nil ;};};func _bafda (_bbaae string )(int ,bool ){if !_f .HasPrefix (_bbaae ,"\u0072\u0049\u0064"){return 0,false ;};_gfg ,_gabg :=_fe .Atoi (_bbaae [3:]);return _gfg ,_gabg ==nil ;};
+
 // SetCategory records the category of the document.
 func (_abc CoreProperties )SetCategory (s string ){_abc ._af .Category =&s };
 
@@ -226,7 +229,7 @@ type CustomProperty struct{_bda *_be .CT_Property };func (_aaff CustomProperties
 func (_cgb Relationships )Relationships ()[]Relationship {_fdg :=[]Relationship {};for _ ,_acd :=range _cgb ._fgf .Relationship {_fdg =append (_fdg ,Relationship {_acd });};return _fdg ;};
 
 // Clear removes any existing relationships.
-func (_dfce Relationships )Clear (){_dfce ._fgf .Relationship =nil };func (_fbga Relationship )String ()string {return _e .Sprintf ("\u007b\u0049\u0044\u003a \u0025\u0073\u0020\u0054\u0061\u0072\u0067\u0065\u0074\u003a \u0025s\u0020\u0054\u0079\u0070\u0065\u003a\u0020%\u0073\u007d",_fbga .ID (),_fbga .Target (),_fbga .Type ());};
+func (_dfce Relationships )Clear (){_dfce ._fgf .Relationship =nil ;_dfce .invalidate ();};func (_fbga Relationship )String ()string {return _e .Sprintf ("\u007b\u0049\u0044\u003a \u0025\u0073\u0020\u0054\u0061\u0072\u0067\u0065\u0074\u003a \u0025s\u0020\u0054\u0079\u0070\u0065\u003a\u0020%\u0073\u007d",_fbga .ID (),_fbga .Target (),_fbga .Type ());};
 
 // NewAppProperties constructs a new AppProperties.
 func NewAppProperties ()AppProperties {_fcf :=AppProperties {_efa :_ca .NewProperties ()};_fcf .SetCompany ("\u0046\u006f\u0078\u0079\u0055\u0074\u0069\u006c\u0073\u0020\u0065\u0068\u0066");_fcf .SetApplication ("g\u0069\u0074\u0068\u0075\u0062\u002ec\u006f\u006d\u002f\u0075\u006e\u0069\u0064\u006f\u0063/\u0075\u006e\u0069o\u0066f\u0069\u0063\u0065");_fcf .SetDocSecurity (0);_fcf .SetLinksUpToDate (false );var _ab ,_cedg ,_df int64 ;_e .Sscanf (Version ,"\u0025\u0064\u002e\u0025\u0064\u002e\u0025\u0064",&_ab ,&_cedg ,&_df );_bg :=float64 (_ab )+float64 (_cedg )/10000.0;_fcf .SetApplicationVersion (_e .Sprintf ("\u0025\u0030\u0037\u002e\u0034\u0066",_bg ));return _fcf ;};
@@ -250,6 +253,13 @@ func (_eaa ContentTypes )EnsureOverride (path ,contentType string ){for _ ,_dg :
 // NewContentTypes returns a wrapper around a newly constructed content-types.
 func NewContentTypes ()ContentTypes {_cbe :=ContentTypes {_ceee :_ced .NewTypes ()};_cbe .AddDefault ("\u0078\u006d\u006c","\u0061p\u0070l\u0069\u0063\u0061\u0074\u0069\u006f\u006e\u002f\u0078\u006d\u006c");_cbe .AddDefault ("\u0072\u0065\u006c\u0073","\u0061\u0070\u0070\u006c\u0069\u0063a\u0074\u0069\u006fn\u002f\u0076\u006ed\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006fr\u006d\u0061\u0074\u0073\u002dpa\u0063\u006b\u0061\u0067\u0065\u002e\u0072\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073\u0068\u0069\u0070\u0073\u002b\u0078\u006d\u006c");_cbe .AddDefault ("\u0070\u006e\u0067","\u0069m\u0061\u0067\u0065\u002f\u0070\u006eg");_cbe .AddDefault ("\u006a\u0070\u0065\u0067","\u0069\u006d\u0061\u0067\u0065\u002f\u006a\u0070\u0065\u0067");_cbe .AddDefault ("\u006a\u0070\u0067","\u0069m\u0061\u0067\u0065\u002f\u006a\u0070g");_cbe .AddDefault ("\u0077\u006d\u0066","i\u006d\u0061\u0067\u0065\u002f\u0078\u002d\u0077\u006d\u0066");_cbe .AddOverride ("\u002fd\u006fc\u0050\u0072\u006f\u0070\u0073/\u0063\u006fr\u0065\u002e\u0078\u006d\u006c","\u0061\u0070\u0070\u006c\u0069\u0063\u0061\u0074\u0069\u006f\u006e\u002f\u0076\u006e\u0064\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073-\u0070\u0061\u0063\u006b\u0061\u0067\u0065\u002e\u0063\u006f\u0072\u0065\u002dp\u0072\u006f\u0070\u0065\u0072\u0074i\u0065\u0073\u002bx\u006d\u006c");_cbe .AddOverride ("\u002f\u0064\u006f\u0063\u0050\u0072\u006f\u0070\u0073\u002f\u0061\u0070p\u002e\u0078\u006d\u006c","a\u0070\u0070l\u0069\u0063\u0061\u0074\u0069\u006f\u006e\u002f\u0076\u006e\u0064\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066o\u0072\u006d\u0061\u0074\u0073\u002d\u006f\u0066\u0066\u0069\u0063\u0065\u0064\u006f\u0063\u0075m\u0065\u006e\u0074\u002e\u0065\u0078\u0074\u0065\u006e\u0064\u0065\u0064\u002dp\u0072\u006f\u0070\u0065\u0072\u0074\u0069\u0065\u0073\u002b\u0078m\u006c");return _cbe ;};
 
+// relationshipCache holds the IDs of each relationship type in order and the
+// largest numeric rId, so that adding relationships and looking them up by
+// index doesn't scan all of the relationships.  It is only built when adding
+// relationships and is discarded when relationships are removed or accessed
+// through X().
+type relationshipCache struct{_gfab int ;_bceb int ;_dagac map[string ][]string ;};
+
 // Modified returns the time that the document was modified.
 func (_fbd CoreProperties )Modified ()_a .Time {return _bc (_fbd ._af .Modified )};
 
@@ -286,7 +296,7 @@ func ImageFromFile (path string )(Image ,error ){_befg ,_afga :=_cf .Open (path
 func (_cac CoreProperties )SetCreated (t _a .Time ){_cac ._af .Created =_bbf (t ,"\u0064c\u0074e\u0072\u006d\u0073\u003a\u0063\u0072\u0065\u0061\u0074\u0065\u0064");};
 
 // AddRelationship adds a relationship.
-func (_eed Relationships )AddRelationship (target ,ctype string )Relationship {if !_f .HasPrefix (ctype ,"\u0068t\u0074\u0070\u003a\u002f\u002f"){_bb .Log ("\u0072\u0065\u006c\u0061\u0074\u0069\u006f\u006es\u0068\u0069\u0070 t\u0079\u0070\u0065\u0020\u0025\u0073 \u0073\u0068\u006f\u0075\u006c\u0064\u0020\u0073\u0074\u0061\u0072\u0074\u0020\u0077\u0069t\u0068\u0020\u0027\u0068\u0074\u0074\u0070\u003a/\u002f\u0027",ctype );};_ddg :=_agf .NewRelationship ();_ccff :=len (_eed ._fgf .Relationship )+1;_gdf :=map[string ]struct{}{};for _ ,_egbd :=range _eed ._fgf .Relationship {_gdf [_egbd .IdAttr ]=struct{}{};};for _ ,_aeeb :=_gdf [_e .Sprintf ("\u0072\u0049\u0064%\u0064",_ccff )];_aeeb ;_ ,_aeeb =_gdf [_e .Sprintf ("\u0072\u0049\u0064%\u0064",_ccff )]{_ccff ++;};_ddg .IdAttr =_e .Sprintf ("\u0072\u0049\u0064%\u0064",_ccff );_ddg .TargetAttr =target ;_ddg .TypeAttr =ctype ;_eed ._fgf .Relationship =append (_eed ._fgf .Relationship ,_ddg );return Relationship {_ddg };};
+func (_eed Relationships )AddRelationship (target ,ctype string )Relationship {if !_f .HasPrefix (ctype ,"\u0068t\u0074\u0070\u003a\u002f\u002f"){_bb .Log ("\u0072\u0065\u006c\u0061\u0074\u0069\u006f\u006es\u0068\u0069\u0070 t\u0079\u0070\u0065\u0020\u0025\u0073 \u0073\u0068\u006f\u0075\u006c\u0064\u0020\u0073\u0074\u0061\u0072\u0074\u0020\u0077\u0069t\u0068\u0020\u0027\u0068\u0074\u0074\u0070\u003a/\u002f\u0027",ctype );};_ddg :=_agf .NewRelationship ();_ccff :=len (_eed ._fgf .Relationship );_gdf :=_eed .cache ();if _gdf !=nil {if _gdf ._bceb > _ccff {_ccff =_gdf ._bceb ;};}else {for _ ,_egbd :=range _eed ._fgf .Relationship {if _gac ,_fdef :=_bafda (_egbd .IdAttr );_fdef &&_gac > _ccff {_ccff =_gac ;};};};_ddg .IdAttr =_e .Sprintf ("\u0072\u0049\u0064%\u0064",_ccff +1);_ddg .TargetAttr =target ;_ddg .TypeAttr =ctype ;_eed ._fgf .Relationship =append (_eed ._fgf .Relationship ,_ddg );if _gdf !=nil {_gdf ._dagac [ctype ]=append (_gdf ._dagac [ctype ],_ddg .IdAttr );_gdf ._bceb =_ccff +1;_gdf ._gfab ++;};return Relationship {_ddg };};
 
 // Category returns the category of the document
 func (_bf CoreProperties )Category ()string {if _bf ._af .Category !=nil {return *_bf ._af .Category ;};return "";};
@@ -302,7 +312,7 @@ type AppProperties struct{_efa *_ca .Properties };const _bba =8;
 func (_ada Theme )X ()*_fa .Theme {return _ada ._agcg };
 
 // NewRelationships creates a new relationship wrapper.
-func NewRelationships ()Relationships {return Relationships {_fgf :_agf .NewRelationships ()}};func init (){_ff .SetAsStorage ()};
+func NewRelationships ()Relationships {return Relationships {_fgf :_agf .NewRelationships (),_cfcf :&relationshipCache {}};};func init (){_ff .SetAsStorage ()};
 
 // RemoveOverrideByIndex removes an override given a path and override index.
 func (_dd ContentTypes )RemoveOverrideByIndex (path string ,indexToFind int )error {_fdc :=path [0:len (path )-5];if !_f .HasPrefix (_fdc ,"\u002f"){_fdc ="\u002f"+_fdc ;};_cad ,_bd :=_fc .Compile (_fdc +"\u0028\u005b\u0030-\u0039\u005d\u002b\u0029\u002e\u0078\u006d\u006c");if _bd !=nil {return _bd ;};_de :=0;_eb :=-1;for _efb ,_aee :=range _dd ._ceee .Override {if _db :=_cad .FindStringSubmatch (_aee .PartNameAttr );len (_db )> 1{if _de ==indexToFind {_eb =_efb ;}else if _de > indexToFind {_cg ,_ :=_fe .Atoi (_db [1]);_cg --;_aee .PartNameAttr =_e .Sprintf ("\u0025\u0073\u0025\u0064\u002e\u0078\u006d\u006c",_fdc ,_cg );};_de ++;};};if _eb > -1{copy (_dd ._ceee .Override [_eb :],_dd ._ceee .Override [_eb +1:]);_dd ._ceee .Override =_dd ._ceee .Override [0:len (_dd ._ceee .Override )-1];};return nil ;};const Version ="\u0031\u002e\u0035.\u0031";func (_feea CustomProperties )SetPropertyAsLpwstr (name string ,lpwstr string ){_dbc :=_feea .getNewProperty (name );_dbc .Lpwstr =&lpwstr ;_feea .setProperty (_dbc );};
@@ -0,0 +1,48 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/unidoc/unioffice"
+	"github.com/unidoc/unioffice/common"
+)
+
+func TestRelationshipIDs(t *testing.T) {
+	rels := common.NewRelationships()
+	rels.AddRelationship("media/image1.png", unioffice.ImageType)
+	link := rels.AddHyperlink("http://example.com")
+	rels.AddRelationship("media/image2.png", unioffice.ImageType)
+	if got := rels.FindRIDForN(1, unioffice.ImageType); got != "rId3" {
+		t.Errorf("expected rId3, got %s", got)
+	}
+	rels.Remove(common.Relationship(link))
+	if got := rels.AddRelationship("media/image3.png", unioffice.ImageType).ID(); got != "rId4" {
+		t.Errorf("expected rId4, got %s", got)
+	}
+	// relationships changed through X() are picked up as well
+	rels.X().Relationship = rels.X().Relationship[1:]
+	if got := rels.FindRIDForN(0, unioffice.ImageType); got != "rId3" {
+		t.Errorf("expected rId3, got %s", got)
+	}
+	if got := rels.FindRIDForN(2, unioffice.ImageType); got != "" {
+		t.Errorf("expected no relationship, got %s", got)
+	}
+}
+
+func TestRelationshipIDsModifiedThroughX(t *testing.T) {
+	rels := common.NewRelationships()
+	rels.AddRelationship("media/image1.png", unioffice.ImageType)
+	rels.AddRelationship("media/image2.png", unioffice.ImageType)
+	if got := rels.FindRIDForN(1, unioffice.ImageType); got != "rId2" {
+		t.Errorf("expected rId2, got %s", got)
+	}
+	// changes that keep the number of relationships are picked up too
+	rels.X().Relationship[1].IdAttr = "rId10"
+	rels.X().Relationship[0].TypeAttr = unioffice.HyperLinkType
+	if got := rels.FindRIDForN(0, unioffice.ImageType); got != "rId10" {
+		t.Errorf("expected rId10, got %s", got)
+	}
+	if got := rels.AddRelationship("media/image3.png", unioffice.ImageType).ID(); got != "rId11" {
+		t.Errorf("expected rId11, got %s", got)
+	}
+}
@@ -31,11 +31,27 @@ func (_eed *memStorage )TempDir (pattern string )(string ,error ){return _eb (pa
 // Read reads from the underlying memDataCell in order to implement Reader interface
 func (_bg *memFile )Read (p []byte )(int ,error ){_ga :=_bg ._gf ;_fe :=_bg ._df ._feg ;_cb :=int64 (len (p ));if _cb > _fe {_cb =_fe ;p =p [:_cb ];};if _ga >=_fe {return 0,_c .EOF ;};_e :=_ga +_cb ;if _e >=_fe {_e =_fe ;};_gfe :=copy (p ,_bg ._df ._fef [_ga :_e ]);_bg ._gf =_e ;return _gfe ,nil ;};
 
+// Bytes returns a copy of the content of the underlying memDataCell
+func (_fafg *memFile )Bytes ()[]byte {_bbae :=make ([]byte ,len (_fafg ._df ._fef ));copy (_bbae ,_fafg ._df ._fef );return _bbae ;};
+
+// Bytes returns a copy of the content of f, which must have been opened or
+// created by the memory storage. The boolean result is false if f belongs to
+// another storage.
+func Bytes (f _fb .File )([]byte ,bool ){_fdc ,_dc :=f .(*memFile );if !_dc {return nil ,false ;};return _fdc .Bytes (),true ;};
+
 // Close is not applicable in this implementation
 func (_ef *memFile )Close ()error {return nil };
 
-// Add reads a file from a disk and adds it to the storage
-func (_fd *memStorage )Add (path string )error {_ge ,_agb :=_b .ReadFile (path );if _agb !=nil {return _agb ;};_fd ._ac .Store (path ,&memDataCell {_dfb :path ,_fef :_ge });return nil ;};
+// Add reads a file from a disk and adds it to the storage. If the path has
+// already been added, the stored content is replaced with the current content
+// of the file. Files opened before the content was replaced continue to read
+// the previous content. Use tempstorage.AddNew to avoid replacing the content.
+func (_fd *memStorage )Add (path string )error {_ge ,_agb :=_b .ReadFile (path );if _agb !=nil {return _agb ;};_fd ._ac .Store (path ,&memDataCell {_dfb :path ,_fef :_ge ,_feg :int64 (len (_ge ))});return nil ;};
+
+// AddNew reads a file from a disk and adds it to the storage. If the path has
+// already been added, tempstorage.ErrExists is returned and the stored content
+// is kept.
+func (_fd *memStorage )AddNew (path string )error {if _ ,_ggd :=_fd ._ac .Load (path );_ggd {return _fb .ErrExists ;};_ge ,_agb :=_b .ReadFile (path );if _agb !=nil {return _agb ;};if _ ,_ggd :=_fd ._ac .LoadOrStore (path ,&memDataCell {_dfb :path ,_fef :_ge ,_feg :int64 (len (_ge ))});_ggd {return _fb .ErrExists ;};return nil ;};
 
 // TempFile creates a new empty file in the storage and returns it
 func (_cc *memStorage )TempFile (dir ,pattern string )(_fb .File ,error ){_gc :=dir +"\u002f"+_eb (pattern );_fege :=&memDataCell {_dfb :_gc ,_fef :[]byte {}};_beg :=&memFile {_df :_fege };_cc ._ac .Store (_gc ,_fege );return _beg ,nil ;};
@@ -0,0 +1,77 @@
+package memstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/unidoc/unioffice/common/tempstorage"
+	"github.com/unidoc/unioffice/common/tempstorage/memstore"
+)
+
+func readStored(t *testing.T, path string) string {
+	f, err := tempstorage.Open(path)
+	if err != nil {
+		t.Fatalf("error opening %s: %s", path, err)
+	}
+	defer f.Close()
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatalf("error reading %s: %s", path, err)
+	}
+	return string(b)
+}
+
+func TestAddTwice(t *testing.T) {
+	memstore.SetAsStorage()
+	dir, err := ioutil.TempDir("", "memstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a.txt")
+	if err := ioutil.WriteFile(path, []byte("first"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := tempstorage.AddNew(path); err != nil {
+		t.Fatalf("error adding %s: %s", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("second"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := tempstorage.AddNew(path); err != tempstorage.ErrExists {
+		t.Errorf("expected ErrExists, got %v", err)
+	}
+	if got := readStored(t, path); got != "first" {
+		t.Errorf("expected AddNew to keep the content, got %q", got)
+	}
+
+	if err := tempstorage.Add(path); err != nil {
+		t.Fatalf("error adding %s: %s", path, err)
+	}
+	if got := readStored(t, path); got != "second" {
+		t.Errorf("expected Add to replace the content, got %q", got)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	memstore.SetAsStorage()
+	f, err := tempstorage.TempFile("dir", "part")
+	if err != nil {
+		t.Fatalf("error creating file: %s", err)
+	}
+	f.Write([]byte("abc"))
+	b, ok := memstore.Bytes(f)
+	if !ok || string(b) != "abc" {
+		t.Fatalf("expected abc, got %q", b)
+	}
+	b[0] = 'x'
+	if b, _ := memstore.Bytes(f); string(b) != "abc" {
+		t.Errorf("expected a copy of the content, got %q", b)
+	}
+	if _, ok := memstore.Bytes(nil); ok {
+		t.Errorf("expected false for a file of another storage")
+	}
+}
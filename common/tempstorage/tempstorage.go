@@ -9,7 +9,7 @@
 // Use of this source code is governed by the UniDoc End User License Agreement
 // terms that can be accessed at https://unidoc.io/eula/
 
-package tempstorage ;import _cg "io";type storage interface{Open (_b string )(File ,error );TempFile (_f ,_bg string )(File ,error );TempDir (_a string )(string ,error );RemoveAll (_e string )error ;Add (_g string )error ;};var _eg storage ;
+package tempstorage ;import (_ce "errors";_cg "io";);type storage interface{Open (_b string )(File ,error );TempFile (_f ,_bg string )(File ,error );TempDir (_a string )(string ,error );RemoveAll (_e string )error ;Add (_g string )error ;};var _eg storage ;
 
 // Open returns tempstorage File object by name.
 func Open (path string )(File ,error ){return _eg .Open (path )};
@@ -17,6 +17,15 @@ func Open (path string )(File ,error ){return _eg .Open (path )};
 // Add reads a file from a disk and adds it to the storage.
 func Add (path string )error {return _eg .Add (path )};
 
+// ErrExists is returned by AddNew if the path has already been added to the
+// storage.
+var ErrExists =_ce .New ("\u0070\u0061\u0074\u0068\u0020\u0061\u006c\u0072\u0065\u0061\u0064\u0079\u0020\u0065\u0078\u0069\u0073\u0074\u0073\u0020\u0069\u006e\u0020\u0074\u0068\u0065\u0020\u0073\u0074\u006f\u0072\u0061\u0067\u0065");
+
+// AddNew reads a file from a disk and adds it to the storage like Add, but
+// returns ErrExists instead of replacing the content if the path has already
+// been added. Storages that don't keep added files behave like Add.
+func AddNew (path string )error {if _gd ,_fa :=_eg .(interface{AddNew (_g string )error ;});_fa {return _gd .AddNew (path );};return _eg .Add (path );};
+
 // TempDir creates a name for a new temp directory using a pattern argument.
 func TempDir (pattern string )(string ,error ){return _eg .TempDir (pattern )};
 
@@ -22,11 +22,18 @@ Example:
 	run.SetText("foo")
 	doc.SaveToFile("foo.docx")
 */
-package document ;import (_f "archive/zip";_d "bytes";_ef "errors";_cf "fmt";_c "github.com/unidoc/unioffice";_bbd "github.com/unidoc/unioffice/color";_aeb "github.com/unidoc/unioffice/common";_ba "github.com/unidoc/unioffice/common/license";_aebc "github.com/unidoc/unioffice/common/tempstorage";_ce "github.com/unidoc/unioffice/measurement";_ed "github.com/unidoc/unioffice/schema/soo/dml";_cde "github.com/unidoc/unioffice/schema/soo/dml/picture";_fg "github.com/unidoc/unioffice/schema/soo/ofc/sharedTypes";_bf "github.com/unidoc/unioffice/schema/soo/pkg/relationships";_fgg "github.com/unidoc/unioffice/schema/soo/wml";_ca "github.com/unidoc/unioffice/zippkg";_bb "image";_dg "image/jpeg";_ae "io";_ee "log";_g "math/rand";_cd "os";_dc "path/filepath";_a "strings";_b "unicode";);func (_ecfd *Document )validateBookmarks ()error {_fcb :=make (map[string ]struct{});for _ ,_cgdb :=range _ecfd .Bookmarks (){if _ ,_fegd :=_fcb [_cgdb .Name ()];_fegd {return _cf .Errorf ("d\u0075\u0070\u006c\u0069\u0063\u0061t\u0065\u0020\u0062\u006f\u006f\u006b\u006d\u0061\u0072k\u0020\u0025\u0073 \u0066o\u0075\u006e\u0064",_cgdb .Name ());};_fcb [_cgdb .Name ()]=struct{}{};};return nil ;};
+package document ;import (_f "archive/zip";_d "bytes";_fda "encoding/xml";_ef "errors";_cf "fmt";_c "github.com/unidoc/unioffice";_gac "github.com/unidoc/unioffice/chart";_bbd "github.com/unidoc/unioffice/color";_aeb "github.com/unidoc/unioffice/common";_ba "github.com/unidoc/unioffice/common/license";_aebc "github.com/unidoc/unioffice/common/tempstorage";_ce "github.com/unidoc/unioffice/measurement";_ed "github.com/unidoc/unioffice/schema/soo/dml";_dcd "github.com/unidoc/unioffice/schema/soo/dml/chart";_cde "github.com/unidoc/unioffice/schema/soo/dml/picture";_age "github.com/unidoc/unioffice/schema/soo/ofc/math";_fg "github.com/unidoc/unioffice/schema/soo/ofc/sharedTypes";_bf "github.com/unidoc/unioffice/schema/soo/pkg/relationships";_fgg "github.com/unidoc/unioffice/schema/soo/wml";_ca "github.com/unidoc/unioffice/zippkg";_bb "image";_dg "image/jpeg";_dec "image/png";_ae "io";_e "io/ioutil";_ee "log";_g "math/rand";_cd "os";_dc "path/filepath";_ddc "regexp";_ebc "strconv";_a "strings";_aga "time";_b "unicode";_afg "unicode/utf8";);func (_ecfd *Document )validateBookmarks ()error {_fcb :=make (map[string ]struct{});for _ ,_cgdb :=range _ecfd .Bookmarks (){if _ ,_fegd :=_fcb [_cgdb .Name ()];_fegd {return _cf .Errorf ("d\u0075\u0070\u006c\u0069\u0063\u0061t\u0065\u0020\u0062\u006f\u006f\u006b\u006d\u0061\u0072k\u0020\u0025\u0073 \u0066o\u0075\u006e\u0064",_cgdb .Name ());};_fcb [_cgdb .Name ()]=struct{}{};};return nil ;};
 
 // Font returns the name of paragraph font family.
 func (_bbff ParagraphProperties )Font ()string {if _bead :=_bbff ._fdfc .RPr .RFonts ;_bead !=nil {if _bead .AsciiAttr !=nil {return *_bead .AsciiAttr ;}else if _bead .HAnsiAttr !=nil {return *_bead .HAnsiAttr ;}else if _bead .CsAttr !=nil {return *_bead .CsAttr ;};};return "";};
 
+// RemoveDrawings removes all drawings (images) from the run while keeping any
+// other content such as text. It returns the number of drawings removed.  The
+// images and their relationships remain in the document, so the ImageRefs can
+// still be used to add drawings, and the images are written when the document
+// is saved.
+func (_fecec Run )RemoveDrawings ()int {_efcd :=_fecec ._bfbb .EG_RunInnerContent [:0];_gbcc :=0;for _ ,_afac :=range _fecec ._bfbb .EG_RunInnerContent {if _afac .Drawing !=nil {_gbcc ++;continue ;};_efcd =append (_efcd ,_afac );};_fecec ._bfbb .EG_RunInnerContent =_efcd ;return _gbcc ;};func _aeagf (_fded *_fgg .CT_Drawing )[]string {_efcab :=[]string {};_eccba :=[]*_cde .Pic {};for _ ,_acabb :=range _fded .Anchor {if _acabb .Graphic !=nil &&_acabb .Graphic .GraphicData !=nil {for _ ,_ebbbf :=range _acabb .Graphic .GraphicData .Any {if _dffg ,_baafa :=_ebbbf .(*_cde .Pic );_baafa {_eccba =append (_eccba ,_dffg );};};};};for _ ,_bbfd :=range _fded .Inline {if _bbfd .Graphic !=nil &&_bbfd .Graphic .GraphicData !=nil {for _ ,_afeee :=range _bbfd .Graphic .GraphicData .Any {if _gbbe ,_gbdc :=_afeee .(*_cde .Pic );_gbdc {_eccba =append (_eccba ,_gbbe );};};};};for _ ,_bde :=range _eccba {if _bde .BlipFill ==nil ||_bde .BlipFill .Blip ==nil {continue ;};_bdadb :=_bde .BlipFill .Blip ;if _bdadb .EmbedAttr !=nil {_efcab =append (_efcab ,*_bdadb .EmbedAttr );};if _bdadb .ExtLst ==nil {continue ;};for _ ,_abede :=range _bdadb .ExtLst .Ext {for _ ,_dfc :=range _abede .Any {if _fagcd ,_adac :=_dfc .(*_c .XSDAny );_adac {for _ ,_eegfd :=range _fagcd .Attrs {if _eegfd .Name .Local =="\u0065\u006d\u0062\u0065\u0064"||_eegfd .Name .Local =="\u0072\u003a\u0065\u006d\u0062\u0065\u0064"{_efcab =append (_efcab ,_eegfd .Value );};};};};};};return _efcab ;};
+
 // RunProperties returns the RunProperties controlling numbering level font, etc.
 func (_ffbg NumberingLevel )RunProperties ()RunProperties {if _ffbg ._cbf .RPr ==nil {_ffbg ._cbf .RPr =_fgg .NewCT_RPr ();};return RunProperties {_ffbg ._cbf .RPr };};
 
@@ -39,15 +46,48 @@ func (_gedaa Style )SetNextStyle (name string ){if name ==""{_gedaa ._dedd .Next
 // SetFirstLineIndent controls the indentation of the first line in a paragraph.
 func (_cdfa ParagraphProperties )SetFirstLineIndent (m _ce .Distance ){if _cdfa ._fdfc .Ind ==nil {_cdfa ._fdfc .Ind =_fgg .NewCT_Ind ();};if m ==_ce .Zero {_cdfa ._fdfc .Ind .FirstLineAttr =nil ;}else {_cdfa ._fdfc .Ind .FirstLineAttr =&_fg .ST_TwipsMeasure {};_cdfa ._fdfc .Ind .FirstLineAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (m /_ce .Twips ));};};
 
+// AddNoBreakHyphen adds a non-breaking hyphen to the run.
+func (_gbefe Run )AddNoBreakHyphen (){_eeff :=_gbefe .newIC ();_eeff .NoBreakHyphen =_fgg .NewCT_Empty ();};
+
 // SetTop sets the top border to a specified type, color and thickness.
 func (_dbc CellBorders )SetTop (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_dbc ._bff .Top =_fgg .NewCT_Border ();_cafa (_dbc ._bff .Top ,t ,c ,thickness );};
 
-// SetSize sets the font size for a run.
-func (_ccd RunProperties )SetSize (size _ce .Distance ){_ccd ._bfbg .Sz =_fgg .NewCT_HpsMeasure ();_ccd ._bfbg .Sz .ValAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (size /_ce .HalfPoint ));_ccd ._bfbg .SzCs =_fgg .NewCT_HpsMeasure ();_ccd ._bfbg .SzCs .ValAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (size /_ce .HalfPoint ));};
+// addAnchor adds an anchored drawing of the given size in EMU to the run whose
+// graphic data has the given URI.
+func (_fagdc Run )addAnchor (_fbbf ,_eacbg int64 ,_cbcd string )AnchoredDrawing {_eecfe :=_fagdc .newIC ();_eecfe .Drawing =_fgg .NewCT_Drawing ();_bcec :=_fgg .NewWdAnchor ();_feecc :=AnchoredDrawing {_fagdc ._adbf ,_bcec };_bcec .SimplePosAttr =_c .Bool (false );_bcec .AllowOverlapAttr =true ;_bcec .CNvGraphicFramePr =_ed .NewCT_NonVisualGraphicFrameProperties ();_eecfe .Drawing .Anchor =append (_eecfe .Drawing .Anchor ,_bcec );_bcec .Graphic =_ed .NewGraphic ();_bcec .Graphic .GraphicData =_ed .NewCT_GraphicalObjectData ();_bcec .Graphic .GraphicData .UriAttr =_cbcd ;_bcec .SimplePos .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_bcec .SimplePos .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_bcec .PositionH .RelativeFromAttr =_fgg .WdST_RelFromHPage ;_bcec .PositionH .Choice =&_fgg .WdCT_PosHChoice {};_bcec .PositionH .Choice .PosOffset =_c .Int32 (0);_bcec .PositionV .RelativeFromAttr =_fgg .WdST_RelFromVPage ;_bcec .PositionV .Choice =&_fgg .WdCT_PosVChoice {};_bcec .PositionV .Choice .PosOffset =_c .Int32 (0);_bcec .Extent .CxAttr =_fbbf ;_bcec .Extent .CyAttr =_eacbg ;_bcec .Choice =&_fgg .WdEG_WrapTypeChoice {};_bcec .Choice .WrapSquare =_fgg .NewWdCT_WrapSquare ();_bcec .Choice .WrapSquare .WrapTextAttr =_fgg .WdST_WrapTextBothSides ;_bcec .DocPr .IdAttr =0x7FFFFFFF&_g .Uint32 ();return _feecc ;};
+
+// IsItalicComplexScript returns true if the run is directly formatted as
+// italic for complex script characters.  An italic that is explicitly turned
+// off with val="false" returns false.
+func (_gceaa Run )IsItalicComplexScript ()bool {return _gceaa ._bfbb .RPr !=nil &&_aeege (_gceaa ._bfbb .RPr .ICs );};
+
+// remapDrawingRelIDs replaces the image relationship IDs referenced by the
+// drawing using the old to new ID mapping in ids.
+func _egcbd (_edfeg *_fgg .CT_Drawing ,_fgece map[string ]string ){_bbdc :=[]*_cde .Pic {};for _ ,_bddgf :=range _edfeg .Anchor {if _bddgf .Graphic !=nil &&_bddgf .Graphic .GraphicData !=nil {for _ ,_bfab :=range _bddgf .Graphic .GraphicData .Any {if _dffa ,_bgbab :=_bfab .(*_cde .Pic );_bgbab {_bbdc =append (_bbdc ,_dffa );};};};};for _ ,_aggfb :=range _edfeg .Inline {if _aggfb .Graphic !=nil &&_aggfb .Graphic .GraphicData !=nil {for _ ,_fbggc :=range _aggfb .Graphic .GraphicData .Any {if _geefc ,_acgd :=_fbggc .(*_cde .Pic );_acgd {_bbdc =append (_bbdc ,_geefc );};};};};for _ ,_faab :=range _bbdc {if _faab .BlipFill ==nil ||_faab .BlipFill .Blip ==nil {continue ;};_aaac :=_faab .BlipFill .Blip ;if _aaac .EmbedAttr !=nil {if _adfc ,_abgb :=_fgece [*_aaac .EmbedAttr ];_abgb {_aaac .EmbedAttr =_c .String (_adfc );};};if _aaac .ExtLst ==nil {continue ;};for _ ,_ebgba :=range _aaac .ExtLst .Ext {for _ ,_eccc :=range _ebgba .Any {if _fgcgf ,_abfd :=_eccc .(*_c .XSDAny );_abfd {for _ebfaa ,_bfdee :=range _fgcgf .Attrs {if _bfdee .Name .Local =="em\u0062\u0065\u0064"||_bfdee .Name .Local =="\u0072\u003a\u0065\u006d\u0062\u0065\u0064"{if _afgfc ,_dbeac :=_fgece [_bfdee .Value ];_dbeac {_fgcgf .Attrs [_ebfaa ].Value =_afgfc ;};};};};};};};};
+
+// Breaks returns the types of the breaks in the run in the order they occur.
+// Breaks without a type are line breaks and are reported as
+// wml.ST_BrTypeTextWrapping.
+func (_dbcce Run )Breaks ()[]_fgg .ST_BrType {_gacga :=[]_fgg .ST_BrType {};for _ ,_ddbg :=range _dbcce ._bfbb .EG_RunInnerContent {if _ddbg .Br ==nil {continue ;};if _ddbg .Br .TypeAttr ==_fgg .ST_BrTypeUnset {_gacga =append (_gacga ,_fgg .ST_BrTypeTextWrapping );}else {_gacga =append (_gacga ,_ddbg .Br .TypeAttr );};};return _gacga ;};
+
+// SetSize sets the font size for a run. A size of zero, or any size smaller
+// than half a point, removes the font size so that the run inherits its size.
+func (_ccd RunProperties )SetSize (size _ce .Distance )RunProperties {if size < _ce .HalfPoint {_ccd ._bfbg .Sz =nil ;_ccd ._bfbg .SzCs =nil ;return _ccd ;};_ccd ._bfbg .Sz =_fgg .NewCT_HpsMeasure ();_ccd ._bfbg .Sz .ValAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (size /_ce .HalfPoint ));_ccd ._bfbg .SzCs =_fgg .NewCT_HpsMeasure ();_ccd ._bfbg .SzCs .ValAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (size /_ce .HalfPoint ));return _ccd ;};
+
+// IsAnchored returns true if the drawing is anchored and false if it is inline.
+func (_fecfb Drawing )IsAnchored ()bool {return _fecfb ._cfga !=nil };
 
 // SetInsideVertical sets the interior vertical borders to a specified type, color and thickness.
 func (_de CellBorders )SetInsideVertical (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_de ._bff .InsideV =_fgg .NewCT_Border ();_cafa (_de ._bff .InsideV ,t ,c ,thickness );};
 
+// watermarkParagraph returns the first paragraph of the header that a
+// watermark is anchored to, adding one if the header has none.
+func (_gbf Header )watermarkParagraph ()Paragraph {for _ ,_caad :=range _gbf ._fcad .EG_ContentBlockContent {if len (_caad .P )> 0{return Paragraph {_gbf ._gdd ,_caad .P [0]};};};return _gbf .AddParagraph ();};
+
+// BreakNode is a break within a run.  Line breaks and carriage returns have a
+// type of wml.ST_BrTypeTextWrapping.
+type BreakNode struct{Type _fgg .ST_BrType };
+
 // X returns the inner wrapped XML type.
 func (_egd Cell )X ()*_fgg .CT_Tc {return _egd ._gf };
 
@@ -70,10 +110,22 @@ func (_cbc Color )SetThemeShade (s uint8 ){_dcc :=_cf .Sprintf ("\u0025\u0030\u0
 // incompatible with SetOffset, whichever is called last is applied.
 func (_gg AnchoredDrawing )SetAlignment (h _fgg .WdST_AlignH ,v _fgg .WdST_AlignV ){_gg .SetHAlignment (h );_gg .SetVAlignment (v );};
 
+// RunSpec describes the content and formatting of a run created with NewRun.
+// Zero values leave the corresponding property unset.
+type RunSpec struct{Text string ;Breaks int ;Style string ;Bold bool ;Italic bool ;Underline _fgg .ST_Underline ;Color _bbd .Color ;Size _ce .Distance ;FontFamily string ;Highlight _fgg .ST_HighlightColor ;};
+
+// AddFraction adds a fraction, returning its numerator and denominator.
+func (_gabf MathArgument )AddFraction ()(num ,den MathArgument ){_ggbeb :=_age .NewCT_F ();_gabf .add ().F =_ggbeb ;return MathArgument {&_ggbeb .Num .EG_OMathMathElements },MathArgument {&_ggbeb .Den .EG_OMathMathElements };};
+
 // RStyle returns the name of character style.
 // It is defined here http://officeopenxml.com/WPstyleCharStyles.php
 func (_ecfe ParagraphProperties )RStyle ()string {if _ecfe ._fdfc .RPr .RStyle !=nil {return _ecfe ._fdfc .RPr .RStyle .ValAttr ;};return "";};
 
+// InsertContentAt inserts a new inner content element into the run at index
+// idx and returns it. An index equal to the number of content elements appends
+// the new element, an index outside of that range returns an error.
+func (_cfaag Run )InsertContentAt (idx int )(*_fgg .EG_RunInnerContent ,error ){if idx < 0||idx > len (_cfaag ._bfbb .EG_RunInnerContent ){return nil ,_cf .Errorf ("\u0069\u006e\u0064\u0065\u0078 \u0025\u0064\u0020o\u0075\u0074\u0020\u006f\u0066\u0020\u0072\u0061\u006eg\u0065\u0020[0\u002c\u0020\u0025d\u005d",idx ,len (_cfaag ._bfbb .EG_RunInnerContent ));};_cdea :=_fgg .NewEG_RunInnerContent ();_cfaag ._bfbb .EG_RunInnerContent =append (_cfaag ._bfbb .EG_RunInnerContent ,nil );copy (_cfaag ._bfbb .EG_RunInnerContent [idx +1:],_cfaag ._bfbb .EG_RunInnerContent [idx :]);_cfaag ._bfbb .EG_RunInnerContent [idx ]=_cdea ;return _cdea ,nil ;};
+
 // SetToolTip sets the tooltip text for a hyperlink.
 func (_dbd HyperLink )SetToolTip (text string ){if text ==""{_dbd ._efga .TooltipAttr =nil ;}else {_dbd ._efga .TooltipAttr =_c .String (text );};};
 
@@ -86,6 +138,10 @@ func (_dfagd Styles )InitializeDefault (){_dfagd .initializeDocDefaults ();_dfag
 // SetAlignment sets the paragraph alignment
 func (_eae NumberingLevel )SetAlignment (j _fgg .ST_Jc ){if j ==_fgg .ST_JcUnset {_eae ._cbf .LvlJc =nil ;}else {_eae ._cbf .LvlJc =_fgg .NewCT_Jc ();_eae ._cbf .LvlJc .ValAttr =j ;};};
 
+// Text returns the text of the paragraph as displayed, including the text of
+// hyperlinks and the cached results of simple fields.
+func (_ebdcf Paragraph )Text ()string {_bbac :=_d .Buffer {};for _ ,_gbgb :=range _ebdcf .allRuns (){_bbac .WriteString (_gbgb .Text ());};return _bbac .String ();};
+
 // AddParagraph adds a paragraph to the table cell.
 func (_aa Cell )AddParagraph ()Paragraph {_bec :=_fgg .NewEG_BlockLevelElts ();_aa ._gf .EG_BlockLevelElts =append (_aa ._gf .EG_BlockLevelElts ,_bec );_af :=_fgg .NewEG_ContentBlockContent ();_bec .EG_ContentBlockContent =append (_bec .EG_ContentBlockContent ,_af );_cee :=_fgg .NewCT_P ();_af .P =append (_af .P ,_cee );return Paragraph {_aa ._bcc ,_cee };};
 
@@ -106,6 +162,29 @@ func (_eba Header )RemoveParagraph (p Paragraph ){for _ ,_gead :=range _eba ._fc
 // Underline returns the type of paragraph underline.
 func (_eggd ParagraphProperties )Underline ()_fgg .ST_Underline {if _efbd :=_eggd ._fdfc .RPr .U ;_efbd !=nil {return _efbd .ValAttr ;};return 0;};
 
+// revisionElements are the elements whose w:id identifies a revision or an
+// annotation.  These IDs must be unique across the whole document.
+var _cefcb =map[string ]bool {"\u0069\u006e\u0073":true ,"\u0064\u0065\u006c":true ,"\u006d\u006f\u0076\u0065\u0046\u0072\u006fm":true ,"\u006do\u0076\u0065\u0054\u006f":true ,"\u006d\u006f\u0076\u0065\u0046r\u006f\u006d\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074":true ,"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064":true ,"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074":true ,"\u006d\u006f\u0076\u0065\u0054\u006f\u0052a\u006e\u0067\u0065\u0045\u006e\u0064":true ,"\u0070\u0050\u0072\u0043\u0068\u0061\u006e\u0067\u0065":true ,"\u0072\u0050\u0072\u0043\u0068\u0061n\u0067\u0065":true ,"\u0073\u0065\u0063\u0074\u0050\u0072\u0043\u0068\u0061n\u0067\u0065":true ,"\u006e\u0075\u006d\u0062\u0065\u0072\u0069\u006e\u0067C\u0068\u0061\u006e\u0067\u0065":true ,"\u0074\u0062\u006c\u0050\u0072\u0043\u0068\u0061ng\u0065":true ,"\u0074\u0062\u006c\u0050\u0072E\u0078\u0043\u0068\u0061\u006e\u0067\u0065":true ,"\u0074\u0062\u006c\u0047\u0072\u0069\u0064\u0043\u0068\u0061\u006e\u0067\u0065":true ,"\u0074\u0072\u0050r\u0043\u0068\u0061ng\u0065":true ,"\u0074\u0063\u0050r\u0043\u0068\u0061\u006e\u0067\u0065":true ,"c\u0065\u006cl\u0049\u006e\u0073":true ,"\u0063\u0065\u006cl\u0044e\u006c":true ,"\u0063\u0065\u006c\u006c\u004d\u0065\u0072\u0067\u0065":true ,"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0053ta\u0072\u0074":true ,"\u0063o\u006d\u006de\u006etR\u0061\u006e\u0067\u0065\u0045\u006e\u0064":true ,"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063e":true ,"\u0063\u0075\u0073\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006eg\u0065\u0053t\u0061\u0072\u0074":true ,"\u0063\u0075\u0073\u0074o\u006dX\u006d\u006c\u0049\u006esR\u0061\u006e\u0067\u0065\u0045\u006e\u0064":true ,"cu\u0073t\u006f\u006d\u0058\u006dl\u0044\u0065\u006c\u0052\u0061\u006eg\u0065\u0053\u0074\u0061\u0072t":true ,"\u0063\u0075\u0073\u0074\u006fm\u0058\u006d\u006c\u0044\u0065\u006cR\u0061\u006e\u0067\u0065\u0045\u006e\u0064":true ,"\u0063\u0075\u0073\u0074o\u006d\u0058\u006d\u006c\u004d\u006f\u0076e\u0046\u0072\u006f\u006d\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072t":true ,"\u0063\u0075\u0073\u0074\u006f\u006d\u0058\u006d\u006c\u004do\u0076\u0065\u0046\u0072\u006f\u006d\u0052\u0061\u006eg\u0065\u0045\u006e\u0064":true ,"\u0063\u0075\u0073\u0074\u006f\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061n\u0067\u0065S\u0074\u0061\u0072\u0074":true ,"\u0063\u0075\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064":true ,};
+
+// EffectiveProperties returns the run properties that apply to the run after
+// resolving the style hierarchy.  The document defaults are applied first,
+// followed by the paragraph style, the run (character) style and finally the
+// direct run formatting, with each level overriding the previous ones. Fonts
+// and languages are merged per attribute. Toggle properties such as bold,
+// italic and caps are combined with an exclusive or across the paragraph and
+// character styles, so that a bold character style applied within a bold
+// paragraph style turns bold off, while direct formatting always wins. Styles
+// are resolved along their based on chain. The returned properties are a copy
+// and modifying them doesn't affect the run.
+func (_cbae Run )EffectiveProperties ()RunProperties {_edcac :=_fgg .NewCT_RPr ();if _cbae ._adbf !=nil &&_cbae ._adbf .Styles ._gee !=nil {_dcdd :=_cbae ._adbf .Styles ._gee ;if _dcdd .DocDefaults !=nil &&_dcdd .DocDefaults .RPrDefault !=nil &&_dcdd .DocDefaults .RPrDefault .RPr !=nil {_cbbc (_edcac ,_dcdd .DocDefaults .RPrDefault .RPr );};_agbgb :="";if _gggda ,_bfgb :=_cbae ._adbf .paragraphOfRun (_cbae ._bfbb );_bfgb {if _fdfda :=_gggda ._cfdb .PPr ;_fdfda !=nil &&_fdfda .PStyle !=nil {_agbgb =_fdfda .PStyle .ValAttr ;};};_ffddc :=_fgg .NewCT_RPr ();_abdec (_ffddc ,_dcdd ,_agbgb ,_fgg .ST_StyleTypeParagraph );_fabeg :="";if _cbae ._bfbb .RPr !=nil &&_cbae ._bfbb .RPr .RStyle !=nil {_fabeg =_cbae ._bfbb .RPr .RStyle .ValAttr ;};_fbgab :=_fgg .NewCT_RPr ();_abdec (_fbgab ,_dcdd ,_fabeg ,_fgg .ST_StyleTypeCharacter );_dbgfe ,_fedfa ,_feae :=_ecade (_edcac ),_ecade (_ffddc ),_ecade (_fbgab );for _cadc :=range _dbgfe {_ace ,_cdaab :=false ,false ;for _ ,_agfcd :=range []*_fgg .CT_OnOff {*_fedfa [_cadc ],*_feae [_cadc ]}{if _agfcd !=nil {_ace =true ;_cdaab =_cdaab !=(_aafe (_agfcd )==OnOffValueOn );};};*_fedfa [_cadc ],*_feae [_cadc ]=nil ,nil ;if _ace {*_dbgfe [_cadc ]=_fgg .NewCT_OnOff ();if !_cdaab {(*_dbgfe [_cadc ]).ValAttr =&_fg .ST_OnOff {Bool :_c .Bool (false )};};};};_cbbc (_edcac ,_ffddc );_cbbc (_edcac ,_fbgab );};if _cbae ._bfbb .RPr !=nil {_cbbc (_edcac ,_cbae ._bfbb .RPr );_edcac .RStyle =_cbae ._bfbb .RPr .RStyle ;};if _affcd :=_edfga (_edcac );_affcd !=nil {_edcac =_affcd ;};return RunProperties {_edcac };};
+
+// TextNode is text within a run.
+type TextNode struct{Text string };
+
+// SetAll sets the top, bottom, left and right borders to a specified type,
+// color and thickness.
+func (_degaf ParagraphBorders )SetAll (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_degaf .SetTop (t ,c ,thickness );_degaf .SetBottom (t ,c ,thickness );_degaf .SetLeft (t ,c ,thickness );_degaf .SetRight (t ,c ,thickness );};
+
 // Strike returns true if paragraph is striked.
 func (_bdgc ParagraphProperties )Strike ()bool {return _aeege (_bdgc ._fdfc .RPr .Strike )};
 
@@ -125,9 +204,28 @@ func (_dgf *Document )X ()*_fgg .Document {return _dgf ._cdaa };
 // X returns the inner wrapped XML type.
 func (_cgf Bookmark )X ()*_fgg .CT_Bookmark {return _cgf ._dac };
 
+// AST returns the content of the run as a sequence of nodes in document order.
+// Non breaking hyphens are returned as text, consistent with Run.Text.  Content
+// that isn't represented by a node type, such as symbols or footnote
+// references, is omitted.
+func (_ceea Run )AST ()[]RunNode {_dagca :=[]RunNode {};for _ ,_faefe :=range _ceea ._bfbb .EG_RunInnerContent {switch {case _faefe .T !=nil :_dagca =append (_dagca ,TextNode {_faefe .T .Content });case _faefe .NoBreakHyphen !=nil :_dagca =append (_dagca ,TextNode {"\u002d"});case _faefe .Tab !=nil :_dagca =append (_dagca ,TabNode {});case _faefe .Br !=nil :_dffc :=_faefe .Br .TypeAttr ;if _dffc ==_fgg .ST_BrTypeUnset {_dffc =_fgg .ST_BrTypeTextWrapping ;};_dagca =append (_dagca ,BreakNode {_dffc });case _faefe .Cr !=nil :_dagca =append (_dagca ,BreakNode {_fgg .ST_BrTypeTextWrapping });case _faefe .Drawing !=nil :for _ ,_dfebb :=range _faefe .Drawing .Anchor {_dagca =append (_dagca ,DrawingNode {Drawing {_ceea ._adbf ,_dfebb ,nil }});};for _ ,_cfaa :=range _faefe .Drawing .Inline {_dagca =append (_dagca ,DrawingNode {Drawing {_ceea ._adbf ,nil ,_cfaa }});};case _faefe .FldChar !=nil :_dagca =append (_dagca ,FieldNode {Char :_faefe .FldChar .FldCharTypeAttr });case _faefe .InstrText !=nil :_dagca =append (_dagca ,FieldNode {Instruction :_faefe .InstrText .Content });};};return _dagca ;};
+
+// Borders allows controlling individual paragraph borders.
+func (_begdc ParagraphProperties )Borders ()ParagraphBorders {if _begdc ._fdfc .PBdr ==nil {_begdc ._fdfc .PBdr =_fgg .NewCT_PBdr ();};return ParagraphBorders {_begdc ._fdfc .PBdr };};
+
+// placeWatermark centers the drawing on the page behind the text.
+func (_gceac *Document )placeWatermark (_aadbd AnchoredDrawing ){_aadbd .SetOrigin (_fgg .WdST_RelFromHMargin ,_fgg .WdST_RelFromVMargin );_aadbd .SetHAlignment (_fgg .WdST_AlignHCenter );_aadbd .SetVAlignment (_fgg .WdST_AlignVCenter );_aadbd .SetTextWrapBehindText ();};
+
 // SetAll sets all of the borders to a given value.
 func (_gcf CellBorders )SetAll (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_gcf .SetBottom (t ,c ,thickness );_gcf .SetLeft (t ,c ,thickness );_gcf .SetRight (t ,c ,thickness );_gcf .SetTop (t ,c ,thickness );_gcf .SetInsideHorizontal (t ,c ,thickness );_gcf .SetInsideVertical (t ,c ,thickness );};
 
+// AddImageWatermark adds an image watermark centered behind the text of every
+// page.  The image must have been added to the document with AddImage and is
+// scaled down to fit within 6 by 9 inches if it is larger.  Like AddWatermark,
+// the image is placed in the document headers so that it repeats on each page.
+// The document isn't modified if an error is returned.
+func (_bcabc *Document )AddImageWatermark (img _aeb .ImageRef )error {_gbeef :=_bcabc .imageIndex (img );if _gbeef < 0{return ErrImageNotFound ;};_efdf :=_cf .Sprintf ("\u006d\u0065\u0064\u0069\u0061/\u0069\u006d\u0061\u0067\u0065\u0025\u0064.\u0025\u0073",_gbeef +1,img .Format ());_gdae :=_ce .Distance (img .Size ().X )*_ce .Pixel72 ;_gbb :=_ce .Distance (img .Size ().Y )*_ce .Pixel72 ;if _gdae ==0||_gbb ==0{return _ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074\u0020\u0068\u0061v\u0065\u0020\u0061\u0020\u0076\u0061\u006ci\u0064\u0020\u0073\u0069\u007ae");};_adga :=1.0;if _eaaa :=float64 (6*_ce .Inch /_gdae );_eaaa < _adga {_adga =_eaaa ;};if _ebfac :=float64 (9*_ce .Inch /_gbb );_ebfac < _adga {_adga =_ebfac ;};for _ ,_acbag :=range _bcabc .watermarkHeaders (){_ebbda :=_bcabc .relsOfHeader (_acbag );_cfabg :="";for _ ,_cadda :=range _ebbda .Relationships (){if _cadda .X ().TypeAttr ==_c .ImageType &&_cadda .X ().TargetAttr ==_efdf {_cfabg =_cadda .ID ();break ;};};if _cfabg ==""{_cfabg =_ebbda .AddRelationship (_efdf ,_c .ImageType ).ID ();};_cdbg :=_acbag .watermarkParagraph ().AddRun ();_cbec ,_bfeeb :=_cdbg .addAnchoredPicture (_ce .ToEMU (float64 (_gdae )*_adga ),_ce .ToEMU (float64 (_gbb )*_adga ));_bfeeb .BlipFill .Blip =_ed .NewCT_Blip ();_bfeeb .BlipFill .Blip .EmbedAttr =_c .String (_cfabg );_cbec ._gd .DocPr .NameAttr ="\u0057at\u0065\u0072\u006d\u0061\u0072\u006b";_bcabc .placeWatermark (_cbec );};return nil ;};
+
 // Clear clears all content within a header
 func (_fade Header )Clear (){_fade ._fcad .EG_ContentBlockContent =nil };
 
@@ -146,20 +244,36 @@ func (_dgg Cell )Properties ()CellProperties {if _dgg ._gf .TcPr ==nil {_dgg ._g
 // Bold returns true if run font is bold.
 func (_abb RunProperties )Bold ()bool {_fggg :=_abb ._bfbg ;return _aeege (_fggg .B )||_aeege (_fggg .BCs );};
 
+// SetBottom sets the bottom border to a specified type, color and thickness.
+func (_gegfd ParagraphBorders )SetBottom (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_gegfd ._x .Bottom =_fgg .NewCT_Border ();_cafa (_gegfd ._x .Bottom ,t ,c ,thickness );};
+
 // X returns the inner wrapped XML type.
 func (_cgcef Row )X ()*_fgg .CT_Row {return _cgcef ._edag };
 
-// AddTabStop adds a tab stop to the paragraph.  It controls the position of text when using Run.AddTab()
-func (_ddec ParagraphProperties )AddTabStop (position _ce .Distance ,justificaton _fgg .ST_TabJc ,leader _fgg .ST_TabTlc ){if _ddec ._fdfc .Tabs ==nil {_ddec ._fdfc .Tabs =_fgg .NewCT_Tabs ();};_eaadd :=_fgg .NewCT_TabStop ();_eaadd .LeaderAttr =leader ;_eaadd .ValAttr =justificaton ;_eaadd .PosAttr .Int64 =_c .Int64 (int64 (position /_ce .Twips ));_ddec ._fdfc .Tabs .Tab =append (_ddec ._fdfc .Tabs .Tab ,_eaadd );};
+// AddTabStop adds a tab stop to the paragraph.  It controls the position of text when using Run.AddTab().
+// The leader is used to fill the space before the tab stop (e.g. ST_TabTlcDot for
+// table of contents entries). Tab stops are kept ordered by position.
+func (_ddec ParagraphProperties )AddTabStop (position _ce .Distance ,justificaton _fgg .ST_TabJc ,leader _fgg .ST_TabTlc ){if _ddec ._fdfc .Tabs ==nil {_ddec ._fdfc .Tabs =_fgg .NewCT_Tabs ();};_eaadd :=_fgg .NewCT_TabStop ();_eaadd .LeaderAttr =leader ;_eaadd .ValAttr =justificaton ;_eaadd .PosAttr .Int64 =_c .Int64 (int64 (position /_ce .Twips ));_ddec ._fdfc .Tabs .Tab =_cbbfa (_ddec ._fdfc .Tabs .Tab ,_eaadd );};
 
 // Properties returns the paragraph properties.
 func (_bgdc Paragraph )Properties ()ParagraphProperties {_bgdc .ensurePPr ();return ParagraphProperties {_bgdc ._eecc ,_bgdc ._cfdb .PPr };};func (_ddea Endnote )content ()[]*_fgg .EG_ContentBlockContent {var _eec []*_fgg .EG_ContentBlockContent ;for _ ,_fcdb :=range _ddea ._dfb .EG_BlockLevelElts {_eec =append (_eec ,_fcdb .EG_ContentBlockContent ...);};return _eec ;};
 
+// nextRevisionID returns an ID for a new tracked change that is greater than
+// the IDs of the revisions and annotations already in the document body,
+// headers, footers, footnotes and endnotes.  The document is scanned on first
+// use only, later calls count up from the highest ID found.
+func (_ffeb *Document )nextRevisionID ()(int64 ,error ){if _ffeb ._fbcgd !=nil {*_ffeb ._fbcgd ++;return *_ffeb ._fbcgd ,nil ;};_ddddg :=[]interface{}{_ffeb ._cdaa .Body };for _ ,_fdcb :=range _ffeb ._fbc {_ddddg =append (_ddddg ,_fdcb );};for _ ,_cffc :=range _ffeb ._eefb {_ddddg =append (_ddddg ,_cffc );};if _ffeb ._begd !=nil {_ddddg =append (_ddddg ,_ffeb ._begd );};if _ffeb ._acd !=nil {_ddddg =append (_ddddg ,_ffeb ._acd );};_agfa :=int64 (0);for _ ,_bfaa :=range _ddddg {_ffegf :=_d .Buffer {};if _egaed :=_fda .NewEncoder (&_ffegf ).Encode (_bfaa );_egaed !=nil {return 0,_egaed ;};_face :=_fda .NewDecoder (&_ffegf );for {_aedcg ,_aaae :=_face .Token ();if _aaae ==_ae .EOF {break ;};if _aaae !=nil {return 0,_aaae ;};_befc ,_cbaad :=_aedcg .(_fda .StartElement );if !_cbaad ||!_cefcb [_befc .Name .Local ]{continue ;};for _ ,_bcdc :=range _befc .Attr {if _bcdc .Name .Local !="\u0069d"{continue ;};if _cgddg ,_aafbb :=_ebc .ParseInt (_bcdc .Value ,10,64);_aafbb ==nil &&_cgddg >=_agfa {_agfa =_cgddg +1;};};};};_ffeb ._fbcgd =&_agfa ;return _agfa ,nil ;};
+
 // NewTableWidth returns a newly intialized TableWidth
 func NewTableWidth ()TableWidth {return TableWidth {_fgg .NewCT_TblWidth ()}};func (_dae FormFieldType )String ()string {if _dae >=FormFieldType (len (_bebfa )-1){return _cf .Sprintf ("\u0046\u006f\u0072\u006d\u0046\u0069\u0065\u006c\u0064\u0054\u0079\u0070e\u0028\u0025\u0064\u0029",_dae );};return _aagd [_bebfa [_dae ]:_bebfa [_dae +1]];};
 
+// AddRelationship adds a relationship of type relType from the main document
+// part to target and returns the relationship ID.  Targets that are URLs (e.g.
+// "https://example.com") are marked as external.
+func (_aaab *Document )AddRelationship (target ,relType string )(string ,error ){if target ==""{return "",_ef .New ("\u0072\u0065\u006ca\u0074\u0069\u006f\u006e\u0073\u0068\u0069\u0070\u0020\u0074\u0061\u0072\u0067\u0065\u0074\u0020\u006d\u0075\u0073\u0074 \u006e\u006f\u0074\u0020\u0062\u0065\u0020\u0065\u006d\u0070\u0074\u0079");};if relType ==""{return "",_ef .New ("\u0072\u0065l\u0061\u0074\u0069o\u006e\u0073\u0068\u0069\u0070\u0020\u0074\u0079\u0070\u0065\u0020\u006d\u0075\u0073\u0074\u0020\u006e\u006f\u0074\u0020\u0062\u0065\u0020\u0065\u006d\u0070\u0074\u0079");};_bca :=_aaab ._efe .AddRelationship (target ,relType );if _a .Contains (target ,"\u003a\u002f\u002f")||_a .HasPrefix (target ,"\u006d\u0061\u0069\u006c\u0074\u006f\u003a"){_bca .X ().TargetModeAttr =_bf .ST_TargetModeExternal ;};return _bca .ID (),nil ;};
+
 // AddDrawingInline adds an inline drawing from an ImageRef.
-func (_eead Run )AddDrawingInline (img _aeb .ImageRef )(InlineDrawing ,error ){_ebff :=_eead .newIC ();_ebff .Drawing =_fgg .NewCT_Drawing ();_facd :=_fgg .NewWdInline ();_dfg :=InlineDrawing {_eead ._adbf ,_facd };_facd .CNvGraphicFramePr =_ed .NewCT_NonVisualGraphicFrameProperties ();_ebff .Drawing .Inline =append (_ebff .Drawing .Inline ,_facd );_facd .Graphic =_ed .NewGraphic ();_facd .Graphic .GraphicData =_ed .NewCT_GraphicalObjectData ();_facd .Graphic .GraphicData .UriAttr ="\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065";_facd .DistTAttr =_c .Uint32 (0);_facd .DistLAttr =_c .Uint32 (0);_facd .DistBAttr =_c .Uint32 (0);_facd .DistRAttr =_c .Uint32 (0);_facd .Extent .CxAttr =int64 (float64 (img .Size ().X *_ce .Pixel72 )/_ce .EMU );_facd .Extent .CyAttr =int64 (float64 (img .Size ().Y *_ce .Pixel72 )/_ce .EMU );_fcgcf :=0x7FFFFFFF&_g .Uint32 ();_facd .DocPr .IdAttr =_fcgcf ;_ceaf :=_cde .NewPic ();_ceaf .NvPicPr .CNvPr .IdAttr =_fcgcf ;_gae :=img .RelID ();if _gae ==""{return _dfg ,_ef .New ("\u0063\u006f\u0075\u006c\u0064\u006e\u0027\u0074\u0020\u0066\u0069\u006e\u0064\u0020\u0072\u0065\u0066\u0065\u0072\u0065n\u0063\u0065\u0020\u0074\u006f\u0020\u0069\u006d\u0061g\u0065\u0020\u0077\u0069\u0074\u0068\u0069\u006e\u0020\u0064\u006f\u0063\u0075m\u0065\u006e\u0074\u0020\u0072\u0065l\u0061\u0074\u0069o\u006e\u0073");};_facd .Graphic .GraphicData .Any =append (_facd .Graphic .GraphicData .Any ,_ceaf );_ceaf .BlipFill =_ed .NewCT_BlipFillProperties ();_ceaf .BlipFill .Blip =_ed .NewCT_Blip ();_ceaf .BlipFill .Blip .EmbedAttr =&_gae ;_ceaf .BlipFill .Stretch =_ed .NewCT_StretchInfoProperties ();_ceaf .BlipFill .Stretch .FillRect =_ed .NewCT_RelativeRect ();_ceaf .SpPr =_ed .NewCT_ShapeProperties ();_ceaf .SpPr .Xfrm =_ed .NewCT_Transform2D ();_ceaf .SpPr .Xfrm .Off =_ed .NewCT_Point2D ();_ceaf .SpPr .Xfrm .Off .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_ceaf .SpPr .Xfrm .Off .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_ceaf .SpPr .Xfrm .Ext =_ed .NewCT_PositiveSize2D ();_ceaf .SpPr .Xfrm .Ext .CxAttr =int64 (img .Size ().X *_ce .Point );_ceaf .SpPr .Xfrm .Ext .CyAttr =int64 (img .Size ().Y *_ce .Point );_ceaf .SpPr .PrstGeom =_ed .NewCT_PresetGeometry2D ();_ceaf .SpPr .PrstGeom .PrstAttr =_ed .ST_ShapeTypeRect ;return _dfg ,nil ;};
+func (_eead Run )AddDrawingInline (img _aeb .ImageRef )(InlineDrawing ,error ){if _eead ._adbf ==nil {return InlineDrawing {},_ef .New ("\u0072\u0075\u006e\u0020m\u0075\u0073\u0074\u0020\u0062\u0065\u006c\u006fn\u0067\u0020\u0074\u006f\u0020\u0061\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074") ;};if !_eead ._adbf .hasImage (img ){return InlineDrawing {},ErrImageNotFound ;};if img .RelID ()==""{return InlineDrawing {},ErrImageRelationMissing ;};_ebff :=_eead .newIC ();_ebff .Drawing =_fgg .NewCT_Drawing ();_facd :=_fgg .NewWdInline ();_dfg :=InlineDrawing {_eead ._adbf ,_facd };_facd .CNvGraphicFramePr =_ed .NewCT_NonVisualGraphicFrameProperties ();_ebff .Drawing .Inline =append (_ebff .Drawing .Inline ,_facd );_facd .Graphic =_ed .NewGraphic ();_facd .Graphic .GraphicData =_ed .NewCT_GraphicalObjectData ();_facd .Graphic .GraphicData .UriAttr ="\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065";_facd .DistTAttr =_c .Uint32 (0);_facd .DistLAttr =_c .Uint32 (0);_facd .DistBAttr =_c .Uint32 (0);_facd .DistRAttr =_c .Uint32 (0);_facd .Extent .CxAttr =_ce .PixelsToEMU (img .Size ().X ,72);_facd .Extent .CyAttr =_ce .PixelsToEMU (img .Size ().Y ,72);_fcgcf :=0x7FFFFFFF&_g .Uint32 ();_facd .DocPr .IdAttr =_fcgcf ;_ceaf :=_cde .NewPic ();_ceaf .NvPicPr .CNvPr .IdAttr =_fcgcf ;_gae :=img .RelID ();_facd .Graphic .GraphicData .Any =append (_facd .Graphic .GraphicData .Any ,_ceaf );_ceaf .BlipFill =_ed .NewCT_BlipFillProperties ();_ceaf .BlipFill .Blip =_ed .NewCT_Blip ();_ceaf .BlipFill .Blip .EmbedAttr =&_gae ;_ceaf .BlipFill .Stretch =_ed .NewCT_StretchInfoProperties ();_ceaf .BlipFill .Stretch .FillRect =_ed .NewCT_RelativeRect ();_ceaf .SpPr =_ed .NewCT_ShapeProperties ();_ceaf .SpPr .Xfrm =_ed .NewCT_Transform2D ();_ceaf .SpPr .Xfrm .Off =_ed .NewCT_Point2D ();_ceaf .SpPr .Xfrm .Off .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_ceaf .SpPr .Xfrm .Off .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_ceaf .SpPr .Xfrm .Ext =_ed .NewCT_PositiveSize2D ();_ceaf .SpPr .Xfrm .Ext .CxAttr =_ce .PixelsToEMU (img .Size ().X ,72);_ceaf .SpPr .Xfrm .Ext .CyAttr =_ce .PixelsToEMU (img .Size ().Y ,72);_ceaf .SpPr .PrstGeom =_ed .NewCT_PresetGeometry2D ();_ceaf .SpPr .PrstGeom .PrstAttr =_ed .ST_ShapeTypeRect ;return _dfg ,nil ;};
 
 // SetStartIndent controls the start indentation.
 func (_bdcf ParagraphProperties )SetStartIndent (m _ce .Distance ){if _bdcf ._fdfc .Ind ==nil {_bdcf ._fdfc .Ind =_fgg .NewCT_Ind ();};if m ==_ce .Zero {_bdcf ._fdfc .Ind .StartAttr =nil ;}else {_bdcf ._fdfc .Ind .StartAttr =&_fgg .ST_SignedTwipsMeasure {};_bdcf ._fdfc .Ind .StartAttr .Int64 =_c .Int64 (int64 (m /_ce .Twips ));};};
@@ -167,6 +281,10 @@ func (_bdcf ParagraphProperties )SetStartIndent (m _ce .Distance ){if _bdcf ._fd
 // SetStartIndent controls the start indent of the paragraph.
 func (_dcbb ParagraphStyleProperties )SetStartIndent (m _ce .Distance ){if _dcbb ._bgca .Ind ==nil {_dcbb ._bgca .Ind =_fgg .NewCT_Ind ();};if m ==_ce .Zero {_dcbb ._bgca .Ind .StartAttr =nil ;}else {_dcbb ._bgca .Ind .StartAttr =&_fgg .ST_SignedTwipsMeasure {};_dcbb ._bgca .Ind .StartAttr .Int64 =_c .Int64 (int64 (m /_ce .Twips ));};};
 
+// sansWidths are the widths of the printable ASCII characters, starting with
+// the space, in a Helvetica/Arial style sans serif font in 1/1000 em.
+var _ccbfe =[95]int16 {278,278,355,556,556,889,667,191,333,333,389,584,278,333,278,278,556,556,556,556,556,556,556,556,556,556,278,278,584,584,584,556,1015,667,667,722,722,667,611,778,722,278,500,667,556,833,722,778,667,778,722,667,611,722,667,944,667,667,611,278,278,278,469,556,333,556,556,500,556,556,278,556,556,222,222,500,222,833,556,556,556,556,333,500,278,556,500,722,500,500,500,334,260,334,584,};
+
 // SetWidth sets the cell width to a specified width.
 func (_deb CellProperties )SetWidth (d _ce .Distance ){_deb ._egf .TcW =_fgg .NewCT_TblWidth ();_deb ._egf .TcW .TypeAttr =_fgg .ST_TblWidthDxa ;_deb ._egf .TcW .WAttr =&_fgg .ST_MeasurementOrPercent {};_deb ._egf .TcW .WAttr .ST_DecimalNumberOrPercent =&_fgg .ST_DecimalNumberOrPercent {};_deb ._egf .TcW .WAttr .ST_DecimalNumberOrPercent .ST_UnqualifiedPercentage =_c .Int64 (int64 (d /_ce .Twips ));};
 
@@ -177,30 +295,78 @@ func (_fggc Paragraph )AddHyperLink ()HyperLink {_daed :=_fgg .NewEG_PContent ()
 // Deprecated: See Spacing() instead which allows finer control.
 func (_bfdcd ParagraphProperties )SetSpacing (before ,after _ce .Distance ){if _bfdcd ._fdfc .Spacing ==nil {_bfdcd ._fdfc .Spacing =_fgg .NewCT_Spacing ();};_bfdcd ._fdfc .Spacing .BeforeAttr =&_fg .ST_TwipsMeasure {};_bfdcd ._fdfc .Spacing .BeforeAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (before /_ce .Twips ));_bfdcd ._fdfc .Spacing .AfterAttr =&_fg .ST_TwipsMeasure {};_bfdcd ._fdfc .Spacing .AfterAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (after /_ce .Twips ));};
 
-// SetColor sets a specific color or auto.
-func (_gbf Color )SetColor (v _bbd .Color ){if v .IsAuto (){_gbf ._aaf .ValAttr .ST_HexColorAuto =_fgg .ST_HexColorAutoAuto ;_gbf ._aaf .ValAttr .ST_HexColorRGB =nil ;}else {_gbf ._aaf .ValAttr .ST_HexColorAuto =_fgg .ST_HexColorAutoUnset ;_gbf ._aaf .ValAttr .ST_HexColorRGB =v .AsRGBString ();};};
+// MapText passes the text of every run in the document body, tables, headers
+// and footers to fn and replaces it with the result, preserving the run
+// formatting and any non-text content. fn is called once for each text
+// element of a run, so text separated by tabs or breaks is mapped separately
+// and the tabs, breaks and other content of the run stay where they are.
+// Tabs and newlines in the result are written as tabs and line breaks in
+// place of the text, and an empty result removes the text.
+func (_dcdgc *Document )MapText (fn func (_degac string )string ){for _ ,_fdbeg :=range _dcdgc .allRuns (){_fdbeg .mapText (fn );};};
+
+// SetColor sets a specific color or auto.  Any theme color, tint or shade is
+// removed so that repeated calls don't leave a stale theme color overriding v.
+func (_gbf Color )SetColor (v _bbd .Color ){_gbf ._aaf .ThemeColorAttr =_fgg .ST_ThemeColorUnset ;_gbf ._aaf .ThemeTintAttr =nil ;_gbf ._aaf .ThemeShadeAttr =nil ;if v .IsAuto (){_gbf ._aaf .ValAttr .ST_HexColorAuto =_fgg .ST_HexColorAutoAuto ;_gbf ._aaf .ValAttr .ST_HexColorRGB =nil ;}else {_gbf ._aaf .ValAttr .ST_HexColorAuto =_fgg .ST_HexColorAutoUnset ;_gbf ._aaf .ValAttr .ST_HexColorRGB =v .AsRGBString ();};};
 
 // SetNumberingLevel sets the numbering level of a paragraph.  If used, then the
 // NumberingDefinition must also be set via SetNumberingDefinition or
 // SetNumberingDefinitionByID.
 func (_eaadg Paragraph )SetNumberingLevel (listLevel int ){_eaadg .ensurePPr ();if _eaadg ._cfdb .PPr .NumPr ==nil {_eaadg ._cfdb .PPr .NumPr =_fgg .NewCT_NumPr ();};_faff :=_fgg .NewCT_DecimalNumber ();_faff .ValAttr =int64 (listLevel );_eaadg ._cfdb .PPr .NumPr .Ilvl =_faff ;};
 
+// SetRightBorder sets the right border of the paragraph.
+func (_fgdbd Paragraph )SetRightBorder (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_fgdbd .Properties ().Borders ().SetRight (t ,c ,thickness );};
+
 // AddStyle adds a new empty style.
 func (_abgf Styles )AddStyle (styleID string ,t _fgg .ST_StyleType ,isDefault bool )Style {_eaba :=_fgg .NewCT_Style ();_eaba .TypeAttr =t ;if isDefault {_eaba .DefaultAttr =&_fg .ST_OnOff {};_eaba .DefaultAttr .Bool =_c .Bool (isDefault );};_eaba .StyleIdAttr =_c .String (styleID );_abgf ._gee .Style =append (_abgf ._gee .Style ,_eaba );return Style {_eaba };};
 
+// MathArgument is a sequence of math elements, such as the content of an
+// equation or the numerator of a fraction, that content can be added to.
+type MathArgument struct{_bdeb *[]*_age .EG_OMathMathElements };
+
+// IsSimple returns true if the field is a simple field (w:fldSimple) rather
+// than a complex field made up of field characters.
+func (_fdga Field )IsSimple ()bool {return _fdga ._ffa !=nil };
+
 // EastAsiaFont returns the name of paragraph font family for East Asia.
 func (_fcce ParagraphProperties )EastAsiaFont ()string {if _bbcea :=_fcce ._fdfc .RPr .RFonts ;_bbcea !=nil {if _bbcea .EastAsiaAttr !=nil {return *_bbcea .EastAsiaAttr ;};};return "";};
 
 // SetRightPct sets the cell right margin
 func (_fc CellMargins )SetRightPct (pct float64 ){_fc ._bgg .Right =_fgg .NewCT_TblWidth ();_fe (_fc ._bgg .Right ,pct );};
 
+// TabNode is a tab character within a run.
+type TabNode struct{};
+
+// addAnchoredPicture adds an anchored drawing of the given size in EMU that
+// contains an empty picture, returning the drawing and the picture so that the
+// caller can fill in the image.
+func (_eeadb Run )addAnchoredPicture (_gffcg ,_addg int64 )(AnchoredDrawing ,*_cde .Pic ){_baff :=_eeadb .addAnchor (_gffcg ,_addg ,"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065");_bcbd :=_baff ._gd ;_cac :=_cde .NewPic ();_cac .NvPicPr .CNvPr .IdAttr =_bcbd .DocPr .IdAttr ;_bcbd .Graphic .GraphicData .Any =append (_bcbd .Graphic .GraphicData .Any ,_cac );_cac .BlipFill =_ed .NewCT_BlipFillProperties ();_cac .BlipFill .Stretch =_ed .NewCT_StretchInfoProperties ();_cac .BlipFill .Stretch .FillRect =_ed .NewCT_RelativeRect ();_cac .SpPr =_ed .NewCT_ShapeProperties ();_cac .SpPr .Xfrm =_ed .NewCT_Transform2D ();_cac .SpPr .Xfrm .Off =_ed .NewCT_Point2D ();_cac .SpPr .Xfrm .Off .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_cac .SpPr .Xfrm .Off .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_cac .SpPr .Xfrm .Ext =_ed .NewCT_PositiveSize2D ();_cac .SpPr .Xfrm .Ext .CxAttr =_gffcg ;_cac .SpPr .Xfrm .Ext .CyAttr =_addg ;_cac .SpPr .PrstGeom =_ed .NewCT_PresetGeometry2D ();_cac .SpPr .PrstGeom .PrstAttr =_ed .ST_ShapeTypeRect ;return _baff ,_cac ;};
+
+// SetScale sets the horizontal scaling of the run characters as a percentage
+// of their normal width (e.g. 150). Values above 600 are limited to 600, and a
+// value of 100 or zero removes the scaling.
+func (_egffc Run )SetScale (percent uint )Run {if percent ==0||percent ==100{if _egffc ._bfbb .RPr !=nil {_egffc ._bfbb .RPr .W =nil ;_egffc .removeEmptyRPr ();};return _egffc ;};if percent > 600{percent =600;};_aaef :=_egffc .Properties ().X ();_aaef .W =_fgg .NewCT_TextScale ();_aaef .W .ValAttr =&_fgg .ST_TextScale {ST_TextScaleDecimal :_c .Int64 (int64 (percent ))};return _egffc ;};
+
 // GetImage returns the ImageRef associated with an InlineDrawing.
 func (_cgee InlineDrawing )GetImage ()(_aeb .ImageRef ,bool ){_fcaa :=_cgee ._dafe .Graphic .GraphicData .Any ;if len (_fcaa )> 0{_ddgg ,_gded :=_fcaa [0].(*_cde .Pic );if _gded {if _ddgg .BlipFill !=nil &&_ddgg .BlipFill .Blip !=nil &&_ddgg .BlipFill .Blip .EmbedAttr !=nil {return _cgee ._febe .GetImageByRelID (*_ddgg .BlipFill .Blip .EmbedAttr );};};};return _aeb .ImageRef {},false ;};
 
+// IsStrikeThrough returns true if the run is directly formatted as struck
+// through.  A strike-through that is explicitly turned off with val="false"
+// returns false.
+func (_cdab Run )IsStrikeThrough ()bool {return _cdab ._bfbb .RPr !=nil &&_aeege (_cdab ._bfbb .RPr .Strike );};
+
+// bodyParagraphs returns the paragraphs of the document body in document
+// order, including those within tables, content controls, custom XML and text
+// boxes.
+func (_gcgdg *Document )bodyParagraphs ()[]Paragraph {if _gcgdg ._cdaa .Body ==nil {return nil ;};_bceg :=[]Paragraph {};_gcgdg .appendBlockParagraphs (&_bceg ,_gcgdg ._cdaa .Body .EG_BlockLevelElts );return _eefag (_bceg );};func (_dcaa *Document )appendBlockParagraphs (_gcfdd *[]Paragraph ,_ebfbg []*_fgg .EG_BlockLevelElts ){for _ ,_abcff :=range _ebfbg {_dcaa .appendContentParagraphs (_gcfdd ,_abcff .EG_ContentBlockContent );};};func (_gffc *Document )appendContentParagraphs (_eedbb *[]Paragraph ,_eaddc []*_fgg .EG_ContentBlockContent ){for _ ,_dggfg :=range _eaddc {if _dggfg .CustomXml !=nil {_gffc .appendContentParagraphs (_eedbb ,_dggfg .CustomXml .EG_ContentBlockContent );};if _dggfg .Sdt !=nil &&_dggfg .Sdt .SdtContent !=nil {_gffc .appendSdtParagraphs (_eedbb ,_dggfg .Sdt .SdtContent );};for _ ,_cbeaf :=range _dggfg .P {*_eedbb =append (*_eedbb ,Paragraph {_gffc ,_cbeaf });};for _ ,_fdfff :=range _dggfg .Tbl {_gffc .appendTableParagraphs (_eedbb ,_fdfff );};};};func (_eedge *Document )appendSdtParagraphs (_bbb *[]Paragraph ,_cbcc *_fgg .CT_SdtContentBlock ){if _cbcc .CustomXml !=nil {_eedge .appendContentParagraphs (_bbb ,_cbcc .CustomXml .EG_ContentBlockContent );};if _cbcc .Sdt !=nil &&_cbcc .Sdt .SdtContent !=nil {_eedge .appendSdtParagraphs (_bbb ,_cbcc .Sdt .SdtContent );};for _ ,_beedf :=range _cbcc .P {*_bbb =append (*_bbb ,Paragraph {_eedge ,_beedf });};for _ ,_dgbge :=range _cbcc .Tbl {_eedge .appendTableParagraphs (_bbb ,_dgbge );};};func (_deega *Document )appendTableParagraphs (_eddgb *[]Paragraph ,_cfddf *_fgg .CT_Tbl ){for _ ,_cedf :=range _cfddf .EG_ContentRowContent {for _ ,_eedec :=range _cedf .Tr {for _ ,_cbaaf :=range _eedec .EG_ContentCellContent {for _ ,_ccgdb :=range _cbaaf .Tc {_deega .appendBlockParagraphs (_eddgb ,_ccgdb .EG_BlockLevelElts );};};};};};
+
 // SetName sets the name of the bookmark. This is the name that is used to
 // reference the bookmark from hyperlinks.
 func (_eef Bookmark )SetName (name string ){_eef ._dac .NameAttr =name };
 
+// ClearFormatting removes all direct formatting from the run so that it
+// inherits its properties from the paragraph and character styles.
+func (_bdff Run )ClearFormatting (){_bdff ._bfbb .RPr =nil };
+
 // ComplexSizeValue returns the value of paragraph font size for complex fonts in points.
 func (_fbee ParagraphProperties )ComplexSizeValue ()float64 {if _aega :=_fbee ._fdfc .RPr .SzCs ;_aega !=nil {_gfgc :=_aega .ValAttr ;if _gfgc .ST_UnsignedDecimalNumber !=nil {return float64 (*_gfgc .ST_UnsignedDecimalNumber )/2;};};return 0.0;};
 
@@ -210,11 +376,14 @@ func (_ddada Styles )ParagraphStyles ()[]Style {_ggbf :=[]Style {};for _ ,_efeed
 // X returns the inner wrapped XML type.
 func (_ega Run )X ()*_fgg .CT_R {return _ega ._bfbb };
 
+// X returns the inner wrapped XML type.
+func (_fdcd ParagraphBorders )X ()*_fgg .CT_PBdr {return _fdcd ._x };
+
 // X returns the inner wrapped XML type.
 func (_gcbdf RunProperties )X ()*_fgg .CT_RPr {return _gcbdf ._bfbg };
 
 // SetStyle sets the font size.
-func (_bcfg RunProperties )SetStyle (style string ){if style ==""{_bcfg ._bfbg .RStyle =nil ;}else {_bcfg ._bfbg .RStyle =_fgg .NewCT_String ();_bcfg ._bfbg .RStyle .ValAttr =style ;};};
+func (_bcfg RunProperties )SetStyle (style string )RunProperties {if style ==""{_bcfg ._bfbg .RStyle =nil ;}else {_bcfg ._bfbg .RStyle =_fgg .NewCT_String ();_bcfg ._bfbg .RStyle .ValAttr =style ;};return _bcfg ;};
 
 // AddTable adds a new table to the document body.
 func (_bcd *Document )AddTable ()Table {_fdd :=_fgg .NewEG_BlockLevelElts ();_bcd ._cdaa .Body .EG_BlockLevelElts =append (_bcd ._cdaa .Body .EG_BlockLevelElts ,_fdd );_efa :=_fgg .NewEG_ContentBlockContent ();_fdd .EG_ContentBlockContent =append (_fdd .EG_ContentBlockContent ,_efa );_fbgd :=_fgg .NewCT_Tbl ();_efa .Tbl =append (_efa .Tbl ,_fbgd );return Table {_bcd ,_fbgd };};
@@ -233,11 +402,16 @@ func (_fdg *Document )Footnote (id int64 )Footnote {for _ ,_eedb :=range _fdg .F
 func (_ggb RunProperties )ComplexSizeMeasure ()string {if _ggadg :=_ggb ._bfbg .SzCs ;_ggadg !=nil {_eegb :=_ggadg .ValAttr ;if _eegb .ST_PositiveUniversalMeasure !=nil {return *_eegb .ST_PositiveUniversalMeasure ;};};return "";};
 
 // SetKerning sets the run's font kerning.
-func (_dbea RunProperties )SetKerning (size _ce .Distance ){_dbea ._bfbg .Kern =_fgg .NewCT_HpsMeasure ();_dbea ._bfbg .Kern .ValAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (size /_ce .HalfPoint ));};
+func (_dbea RunProperties )SetKerning (size _ce .Distance )RunProperties {_dbea ._bfbg .Kern =_fgg .NewCT_HpsMeasure ();_dbea ._bfbg .Kern .ValAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (size /_ce .HalfPoint ));return _dbea ;};
 
 // RightToLeft returns true if paragraph text goes from right to left.
 func (_gdcb ParagraphProperties )RightToLeft ()bool {return _aeege (_gdcb ._fdfc .RPr .Rtl )};
 
+// imageRelID returns the ID of a relationship in rels to a copy of img, adding
+// the image to the document and the relationship to rels if they don't exist
+// yet.
+func (_cdgeg *Document )imageRelID (_dbfgb _aeb .Relationships ,_fgfdf _aeb .ImageRef )(string ,error ){_gcgfe :=-1;for _cdccc ,_fbfc :=range _cdgeg .Images {if _fbfc .Data ()==_fgfdf .Data ()&&_fbfc .Path ()==_fgfdf .Path ()&&_fbfc .Format ()==_fgfdf .Format ()&&_fbfc .Size ()==_fgfdf .Size (){_gcgfe =_cdccc ;break ;};};if _gcgfe < 0{if _ ,_dcea :=_cdgeg .AddImage (_aeb .Image {Size :_fgfdf .Size (),Format :_fgfdf .Format (),Path :_fgfdf .Path (),Data :_fgfdf .Data ()});_dcea !=nil {return "",_dcea ;};_gcgfe =len (_cdgeg .Images )-1;};_ecfgf :=_cf .Sprintf ("me\u0064i\u0061\u002fi\u006d\u0061g\u0065\u0025\u0064\u002e\u0025\u0073",_gcgfe +1,_cdgeg .Images [_gcgfe ].Format ());for _ ,_dcda :=range _dbfgb .Relationships (){if _dcda .X ().TypeAttr ==_c .ImageType &&_a .EqualFold (_dcda .X ().TargetAttr ,_ecfgf ){return _dcda .ID (),nil ;};};return _dbfgb .AddRelationship (_ecfgf ,_c .ImageType ).ID (),nil ;};
+
 // Fonts allows manipulating a style or run's fonts.
 type Fonts struct{_ddg *_fgg .CT_Fonts };
 
@@ -245,6 +419,19 @@ type Fonts struct{_ddg *_fgg .CT_Fonts };
 // ST_RelFromVPage
 func (_dd AnchoredDrawing )SetOrigin (h _fgg .WdST_RelFromH ,v _fgg .WdST_RelFromV ){_dd ._gd .PositionH .RelativeFromAttr =h ;_dd ._gd .PositionV .RelativeFromAttr =v ;};
 
+// Validate returns an error if the spec contains values that can't be applied
+// to a run, such as a font size outside of the 1 to 1638 point range that Word
+// supports, a font family that is only whitespace, a color that isn't opaque,
+// a negative number of breaks or an unknown underline or highlight value.
+// NewRun doesn't validate the spec, so Validate should be called first when
+// the spec is built from user input.
+func (_fgafa RunSpec )Validate ()error {if _fgafa .Breaks < 0{return _ef .New ("\u006e\u0075\u006d\u0062\u0065r\u0020of\u0020\u0062\u0072\u0065\u0061\u006b\u0073\u0020m\u0075\u0073t\u0020\u006eo\u0074\u0020\u0062\u0065\u0020\u006e\u0065\u0067\u0061\u0074\u0069\u0076\u0065");};if _fgafa .Size !=0&&(_fgafa .Size < 1*_ce .Point ||_fgafa .Size > 1638*_ce .Point ){return _cf .Errorf ("\u0066\u006f\u006et\u0020\u0073\u0069\u007a\u0065\u0020\u0025\u0076\u0020\u006d\u0075\u0073\u0074\u0020\u0062\u0065\u0020b\u0065tw\u0065\u0065\u006e\u0020\u0031\u0020\u0061\u006e\u0064 \u0031\u0036\u00338\u0020\u0070o\u0069\u006e\u0074\u0073",float64 (_fgafa .Size ));};if _fgafa .FontFamily !=""&&_a .TrimSpace (_fgafa .FontFamily )==""{return _ef .New ("f\u006f\u006e\u0074\u0020\u0066\u0061\u006dil\u0079\u0020\u006du\u0073\u0074\u0020\u006e\u006f\u0074\u0020\u0062\u0065\u0020\u0062\u006c\u0061n\u006b");};if _fgafa .Color !=(_bbd .Color {})&&!_fgafa .Color .IsAuto (){if _dgfab ,_ :=_ebc .ParseUint ((*_fgafa .Color .AsRGBAString ())[0:2],16,8);_dgfab !=255{return _cf .Errorf ("\u0063\u006fl\u006f\u0072\u0020\u0025s\u0020\u006d\u0075\u0073\u0074\u0020\u0062\u0065\u0020o\u0070\u0061\u0071\u0075\u0065",*_fgafa .Color .AsRGBString ());};};if _fgafa .Underline .Validate ()!=nil {return _cf .Errorf ("\u0075\u006e\u0064\u0065\u0072\u006c\u0069\u006e\u0065\u0020\u0076\u0061\u006c\u0075\u0065\u0020\u0025\u0064\u0020\u0069\u0073\u0020n\u006f\u0074\u0020\u0076\u0061\u006c\u0069\u0064",_fgafa .Underline );};if _fgafa .Highlight .Validate ()!=nil {return _cf .Errorf ("h\u0069\u0067h\u006c\u0069\u0067\u0068\u0074\u0020\u0076\u0061\u006c\u0075\u0065\u0020\u0025\u0064\u0020i\u0073 n\u006f\u0074\u0020\u0076a\u006c\u0069\u0064",_fgafa .Highlight );};return nil ;};
+
+// SetDefaultRTL sets the default run direction of the document to right to
+// left in the style document defaults. Individual runs can still override
+// the default.
+func (_ecbff *Document )SetDefaultRTL (b bool ){_adcbc :=_ecbff .Styles .X ();if _adcbc .DocDefaults ==nil {if !b {return ;};_adcbc .DocDefaults =_fgg .NewCT_DocDefaults ();};if _adcbc .DocDefaults .RPrDefault ==nil {_adcbc .DocDefaults .RPrDefault =_fgg .NewCT_RPrDefault ();};if _adcbc .DocDefaults .RPrDefault .RPr ==nil {_adcbc .DocDefaults .RPrDefault .RPr =_fgg .NewCT_RPr ();};if b {_adcbc .DocDefaults .RPrDefault .RPr .Rtl =_fgg .NewCT_OnOff ();}else {_adcbc .DocDefaults .RPrDefault .RPr .Rtl =nil ;};};
+
 // IsEndnote returns a bool based on whether the run has a
 // footnote or not. Returns both a bool as to whether it has
 // a footnote as well as the ID of the footnote.
@@ -255,13 +442,13 @@ func (_gda Run )IsEndnote ()(bool ,int64 ){if _gda ._bfbb .EG_RunInnerContent !=
 func (_fecd Paragraph )SetNumberingDefinition (nd NumberingDefinition ){_fecd .ensurePPr ();if _fecd ._cfdb .PPr .NumPr ==nil {_fecd ._cfdb .PPr .NumPr =_fgg .NewCT_NumPr ();};_dead :=_fgg .NewCT_DecimalNumber ();_aeba :=int64 (-1);for _ ,_edgba :=range _fecd ._eecc .Numbering ._fdda .Num {if _edgba .AbstractNumId !=nil &&_edgba .AbstractNumId .ValAttr ==nd .AbstractNumberID (){_aeba =_edgba .NumIdAttr ;};};if _aeba ==-1{_ccga :=_fgg .NewCT_Num ();_fecd ._eecc .Numbering ._fdda .Num =append (_fecd ._eecc .Numbering ._fdda .Num ,_ccga );_ccga .NumIdAttr =int64 (len (_fecd ._eecc .Numbering ._fdda .Num ));_ccga .AbstractNumId =_fgg .NewCT_DecimalNumber ();_ccga .AbstractNumId .ValAttr =nd .AbstractNumberID ();};_dead .ValAttr =_aeba ;_fecd ._cfdb .PPr .NumPr .NumId =_dead ;};
 
 // SetBold sets the run to bold.
-func (_dad RunProperties )SetBold (b bool ){if !b {_dad ._bfbg .B =nil ;_dad ._bfbg .BCs =nil ;}else {_dad ._bfbg .B =_fgg .NewCT_OnOff ();_dad ._bfbg .BCs =_fgg .NewCT_OnOff ();};};
+func (_dad RunProperties )SetBold (b bool )RunProperties {if !b {_dad ._bfbg .B =nil ;_dad ._bfbg .BCs =nil ;}else {_dad ._bfbg .B =_fgg .NewCT_OnOff ();_dad ._bfbg .BCs =_fgg .NewCT_OnOff ();};return _dad ;};
 
 // Paragraphs returns the paragraphs defined in a footer.
 func (_edgdd Footer )Paragraphs ()[]Paragraph {_acfb :=[]Paragraph {};for _ ,_dgbfe :=range _edgdd ._baba .EG_ContentBlockContent {for _ ,_aca :=range _dgbfe .P {_acfb =append (_acfb ,Paragraph {_edgdd ._gbfg ,_aca });};};for _ ,_bcgc :=range _edgdd .Tables (){for _ ,_ggag :=range _bcgc .Rows (){for _ ,_daf :=range _ggag .Cells (){_acfb =append (_acfb ,_daf .Paragraphs ()...);};};};return _acfb ;};
 
-// SetOutline sets the run to outlined text.
-func (_cbgf RunProperties )SetOutline (b bool ){if !b {_cbgf ._bfbg .Outline =nil ;}else {_cbgf ._bfbg .Outline =_fgg .NewCT_OnOff ();};};
+// SetOutline sets the run to outlined text. Any emboss or imprint is cleared.
+func (_cbgf RunProperties )SetOutline (b bool )RunProperties {if !b {_cbgf ._bfbg .Outline =nil ;}else {_cbgf ._bfbg .Outline =_fgg .NewCT_OnOff ();_cbgf ._bfbg .Emboss =nil ;_cbgf ._bfbg .Imprint =nil ;};return _cbgf ;};
 
 // SetLastColumn controls the conditional formatting for the last column in a table.
 func (_fbfa TableLook )SetLastColumn (on bool ){if !on {_fbfa ._gagb .LastColumnAttr =&_fg .ST_OnOff {};_fbfa ._gagb .LastColumnAttr .ST_OnOff1 =_fg .ST_OnOff1Off ;}else {_fbfa ._gagb .LastColumnAttr =&_fg .ST_OnOff {};_fbfa ._gagb .LastColumnAttr .ST_OnOff1 =_fg .ST_OnOff1On ;};};
@@ -272,6 +459,11 @@ func (_bbeb Paragraph )RemoveRun (r Run ){for _ ,_bggf :=range _bbeb ._cfdb .EG_
 // SetAll sets all of the borders to a given value.
 func (_agdec TableBorders )SetAll (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_agdec .SetBottom (t ,c ,thickness );_agdec .SetLeft (t ,c ,thickness );_agdec .SetRight (t ,c ,thickness );_agdec .SetTop (t ,c ,thickness );_agdec .SetInsideHorizontal (t ,c ,thickness );_agdec .SetInsideVertical (t ,c ,thickness );};
 
+// validateImageRels checks that every image referenced by a drawing in the
+// document body, headers and footers resolves to a relationship of the part
+// that contains the drawing.
+func (_eaade *Document )validateImageRels ()error {_deac :=func (_cgfg string ,_beec []Paragraph ,_edaag _aeb .Relationships )error {for _ ,_fdefb :=range _beec {for _ ,_ffad :=range _fdefb .allRuns (){for _ ,_ebcee :=range _ffad ._bfbb .EG_RunInnerContent {if _ebcee .Drawing ==nil {continue ;};for _ ,_dfcf :=range _aeagf (_ebcee .Drawing ){_ffef :=false ;for _ ,_gfce :=range _edaag .Relationships (){if _gfce .ID ()==_dfcf {_ffef =true ;break ;};};if !_ffef {return _cf .Errorf ("\u0025\u0073\u003a\u0020\u0064\u0072\u0061w\u0069\u006e\u0067\u0020\u0072\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065\u0073\u0020\u0069\u006d\u0061\u0067\u0065\u0020r\u0065l\u0061\u0074\u0069o\u006e\u0073\u0068\u0069\u0070\u0020\u0025\u0073\u0020\u0077\u0068\u0069\u0063\u0068\u0020\u0064\u006f\u0065\u0073\u006e\u0027\u0074\u0020\u0065\u0078\u0069\u0073\u0074",_cgfg ,_dfcf );};};};};};return nil ;};if _aaacd :=_deac ("\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074",_eefag (_eaade .Paragraphs ()),_eaade ._efe );_aaacd !=nil {return _aaacd ;};for _fecee ,_fbd :=range _eaade .Headers (){if _gbdad :=_deac (_cf .Sprintf ("\u0068\u0065\u0061\u0064e\u0072\u0025\u0064",_fecee +1),_eefag (_fbd .Paragraphs ()),_eaade ._ff [_fecee ]);_gbdad !=nil {return _gbdad ;};};for _caac ,_eeace :=range _eaade .Footers (){if _beff :=_deac (_cf .Sprintf ("\u0066o\u006f\u0074\u0065\u0072%\u0064",_caac +1),_eefag (_eeace .Paragraphs ()),_eaade ._edgc [_caac ]);_beff !=nil {return _beff ;};};return nil ;};
+
 // X returns the inner wrapped XML type.
 func (_gcac HyperLink )X ()*_fgg .CT_Hyperlink {return _gcac ._efga };
 
@@ -285,17 +477,28 @@ func (_eddd FormField )Type ()FormFieldType {if _eddd ._edda .TextInput !=nil {r
 // SetRight sets the right border to a specified type, color and thickness.
 func (_gga CellBorders )SetRight (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_gga ._bff .Right =_fgg .NewCT_Border ();_cafa (_gga ._bff .Right ,t ,c ,thickness );};
 
+// AppendText adds text to the run like AddText, but appends it to the last text
+// segment of the run if the run ends with text instead of creating a new
+// segment.  This keeps runs that are built up from many small pieces of text
+// compact.
+func (_fgabf Run )AppendText (s string ){_aafgd :=_fgabf ._bfbb .EG_RunInnerContent ;if len (_aafgd )==0||_aafgd [len (_aafgd )-1].T ==nil {_fgabf .AddText (s );return ;};_bga ,_fccec :=s ,"";if _fegfc :=_a .IndexAny (s ,"\u000d\u000a");_fegfc >=0{_bga ,_fccec =s [:_fegfc ],s [_fegfc :];};_eedbe :=_aafgd [len (_aafgd )-1].T ;_eedbe .Content +=_bga ;_eedbe .SpaceAttr =nil ;if _c .NeedsSpacePreserve (_eedbe .Content ){_eedbe .SpaceAttr =_c .String ("\u0070\u0072e\u0073\u0065\u0072\u0076\u0065");};if _fccec !=""{_fgabf .AddText (_fccec );};};
+
 // SetVerticalMerge controls the vertical merging of cells.
 func (_df CellProperties )SetVerticalMerge (mergeVal _fgg .ST_Merge ){if mergeVal ==_fgg .ST_MergeUnset {_df ._egf .VMerge =nil ;}else {_df ._egf .VMerge =_fgg .NewCT_VMerge ();_df ._egf .VMerge .ValAttr =mergeVal ;};};
 
+func init (){_c .RegisterConstructor ("\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073c\u0068\u0065\u006d\u0061\u0073.\u006d\u0069\u0063\u0072o\u0073\u006f\u0066\u0074\u002e\u0063\u006f\u006d\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u002f\u0077o\u0072\u0064\u002f\u0032\u0030\u0031\u0030\u002f\u0077\u006frd\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u0053\u0068\u0061\u0070\u0065","\u0077\u0073\u0070",func ()*textBoxShape {return &textBoxShape {}});};
+
 // Rows returns the rows defined in the table.
 func (_cgag Table )Rows ()[]Row {_ffc :=[]Row {};for _ ,_caccg :=range _cgag ._gaec .EG_ContentRowContent {for _ ,_fcab :=range _caccg .Tr {_ffc =append (_ffc ,Row {_cgag ._gcfe ,_fcab });};if _caccg .Sdt !=nil &&_caccg .Sdt .SdtContent !=nil {for _ ,_gdccc :=range _caccg .Sdt .SdtContent .Tr {_ffc =append (_ffc ,Row {_cgag ._gcfe ,_gdccc });};};};return _ffc ;};
 
+// imageDefault returns the default content type registered for the extension.
+func (_abae *Document )imageDefault (_ecgc string )(string ,bool ){for _ ,_dbdca :=range _abae .ContentTypes .X ().Default {if _dbdca .ExtensionAttr ==_ecgc {return _dbdca .ContentTypeAttr ,true ;};};return "",false ;};
+
 // SetStartPct sets the cell start margin
 func (_adb CellMargins )SetStartPct (pct float64 ){_adb ._bgg .Start =_fgg .NewCT_TblWidth ();_fe (_adb ._bgg .Start ,pct );};
 
 // SetSize sets the size of the displayed image on the page.
-func (_efeeb InlineDrawing )SetSize (w ,h _ce .Distance ){_efeeb ._dafe .Extent .CxAttr =int64 (float64 (w *_ce .Pixel72 )/_ce .EMU );_efeeb ._dafe .Extent .CyAttr =int64 (float64 (h *_ce .Pixel72 )/_ce .EMU );};
+func (_efeeb InlineDrawing )SetSize (w ,h _ce .Distance ){_efeeb ._dafe .Extent .CxAttr =_ce .ToEMU (float64 (w ));_efeeb ._dafe .Extent .CyAttr =_ce .ToEMU (float64 (h ));};
 
 // AddEndnote will create a new endnote and attach it to the Paragraph in the
 // location at the end of the previous run (endnotes create their own run within
@@ -310,8 +513,19 @@ func (_caca Paragraph )AddBookmark (name string )Bookmark {_adge :=_fgg .NewEG_P
 // ParagraphSpacing controls the spacing for a paragraph and its lines.
 type ParagraphSpacing struct{_bged *_fgg .CT_Spacing };
 
+// IsItalicComplexScript returns true if the run has been set to italics for
+// complex script characters.
+func (_afab RunProperties )IsItalicComplexScript ()bool {return _afab .ItalicComplexScriptValue ()==OnOffValueOn ;};
+
+// SetOutlineLevel sets the outline level of a paragraph, which allows it to be
+// included in a table of contents without using a heading style.  Like
+// ParagraphStyleProperties.SetOutlineLevel the level is zero based, with 0 to 8
+// corresponding to levels 1 to 9 and 9 to body text.  A negative level removes
+// the outline level and levels above 9 return an error.
+func (_dddf Paragraph )SetOutlineLevel (lvl int )error {if lvl > 9{return ErrInvalidOutlineLevel ;};_dddf .ensurePPr ();if lvl < 0{_dddf ._cfdb .PPr .OutlineLvl =nil ;return nil ;};_dddf ._cfdb .PPr .OutlineLvl =_fgg .NewCT_DecimalNumber ();_dddf ._cfdb .PPr .OutlineLvl .ValAttr =int64 (lvl );return nil ;};
+
 // Text returns the underlying tet in the run.
-func (_fgca Run )Text ()string {if len (_fgca ._bfbb .EG_RunInnerContent )==0{return "";};_cegcf :=_d .Buffer {};for _ ,_eabe :=range _fgca ._bfbb .EG_RunInnerContent {if _eabe .T !=nil {_cegcf .WriteString (_eabe .T .Content );};if _eabe .Tab !=nil {_cegcf .WriteByte ('\t');};};return _cegcf .String ();};
+func (_fgca Run )Text ()string {if len (_fgca ._bfbb .EG_RunInnerContent )==0{return "";};if len (_fgca ._bfbb .EG_RunInnerContent )==1{if _gfbfd :=_fgca ._bfbb .EG_RunInnerContent [0];_gfbfd .T !=nil &&_gfbfd .Tab ==nil &&_gfbfd .NoBreakHyphen ==nil {return _gfbfd .T .Content ;};};_cegcf :=_d .Buffer {};for _ ,_eabe :=range _fgca ._bfbb .EG_RunInnerContent {if _eabe .T !=nil {_cegcf .WriteString (_eabe .T .Content );};if _eabe .Tab !=nil {_cegcf .WriteByte ('\t');};if _eabe .NoBreakHyphen !=nil {_cegcf .WriteByte ('-');};};return _cegcf .String ();};
 
 // NumberingDefinition defines a numbering definition for a list of pragraphs.
 type NumberingDefinition struct{_ddfb *_fgg .CT_AbstractNum };func (_fab *Document )createCustomProperties (){_fab .CustomProperties =_aeb .NewCustomProperties ();_fab .addCustomRelationships ();};
@@ -322,6 +536,9 @@ func (_abac Footer )AddParagraph ()Paragraph {_gfb :=_fgg .NewEG_ContentBlockCon
 // RemoveParagraph removes a paragraph from the endnote.
 func (_fec Endnote )RemoveParagraph (p Paragraph ){for _ ,_dgcb :=range _fec .content (){for _cdbe ,_aacd :=range _dgcb .P {if _aacd ==p ._cfdb {copy (_dgcb .P [_cdbe :],_dgcb .P [_cdbe +1:]);_dgcb .P =_dgcb .P [0:len (_dgcb .P )-1];return ;};};};};
 
+// Paragraphs returns the paragraphs within the text box.
+func (_eede TextBox )Paragraphs ()[]Paragraph {_adebg :=[]Paragraph {};for _ ,_degd :=range _eede ._dffb .EG_ContentBlockContent {for _ ,_dgbab :=range _degd .P {_adebg =append (_adebg ,Paragraph {_eede ._fegb ,_dgbab });};};return _adebg ;};
+
 // Clear resets the numbering.
 func (_aacge Numbering )Clear (){_aacge ._fdda .AbstractNum =nil ;_aacge ._fdda .Num =nil ;_aacge ._fdda .NumIdMacAtCleanup =nil ;_aacge ._fdda .NumPicBullet =nil ;};
 
@@ -329,24 +546,33 @@ func (_aacge Numbering )Clear (){_aacge ._fdda .AbstractNum =nil ;_aacge ._fdda
 func (_gegf Footnote )X ()*_fgg .CT_FtnEdn {return _gegf ._ceac };var _bgdb =false ;
 
 // SetSmallCaps sets the run to small caps.
-func (_cdae RunProperties )SetSmallCaps (b bool ){if !b {_cdae ._bfbg .SmallCaps =nil ;}else {_cdae ._bfbg .SmallCaps =_fgg .NewCT_OnOff ();};};
+func (_cdae RunProperties )SetSmallCaps (b bool )RunProperties {if !b {_cdae ._bfbg .SmallCaps =nil ;}else {_cdae ._bfbg .SmallCaps =_fgg .NewCT_OnOff ();};return _cdae ;};
 
 // TableProperties returns the table style properties.
 func (_fbce Style )TableProperties ()TableStyleProperties {if _fbce ._dedd .TblPr ==nil {_fbce ._dedd .TblPr =_fgg .NewCT_TblPrBase ();};return TableStyleProperties {_fbce ._dedd .TblPr };};
 
+// SetWrapDistance sets the minimum distance between the drawing and the text
+// that wraps around it on each side.  Negative distances are treated as zero.
+func (_bgc AnchoredDrawing )SetWrapDistance (top ,bottom ,left ,right _ce .Distance ){_bgc ._gd .DistTAttr =_dcfdd (top );_bgc ._gd .DistBAttr =_dcfdd (bottom );_bgc ._gd .DistLAttr =_dcfdd (left );_bgc ._gd .DistRAttr =_dcfdd (right );};
+
 // MultiLevelType returns the multilevel type, or ST_MultiLevelTypeUnset if not set.
 func (_cdce NumberingDefinition )MultiLevelType ()_fgg .ST_MultiLevelType {if _cdce ._ddfb .MultiLevelType !=nil {return _cdce ._ddfb .MultiLevelType .ValAttr ;}else {return _fgg .ST_MultiLevelTypeUnset ;};};
 
 // SetPageMargins sets the page margins for a section
 func (_cdba Section )SetPageMargins (top ,right ,bottom ,left ,header ,footer ,gutter _ce .Distance ){_fdcf :=_fgg .NewCT_PageMar ();_fdcf .TopAttr .Int64 =_c .Int64 (int64 (top /_ce .Twips ));_fdcf .BottomAttr .Int64 =_c .Int64 (int64 (bottom /_ce .Twips ));_fdcf .RightAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (right /_ce .Twips ));_fdcf .LeftAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (left /_ce .Twips ));_fdcf .HeaderAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (header /_ce .Twips ));_fdcf .FooterAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (footer /_ce .Twips ));_fdcf .GutterAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (gutter /_ce .Twips ));_cdba ._egcf .PgMar =_fdcf ;};
 
+// IsDoubleStrikeThrough returns true if the run is directly formatted as double
+// struck through.  A double strike-through that is explicitly turned off with
+// val="false" returns false.
+func (_agae Run )IsDoubleStrikeThrough ()bool {return _agae ._bfbb .RPr !=nil &&_aeege (_agae ._bfbb .RPr .Dstrike );};
+
 // AddHyperlink adds a hyperlink to a document. Adding the hyperlink to a document
 // and setting it on a cell is more efficient than setting hyperlinks directly
 // on a cell.
 func (_cgfd Document )AddHyperlink (url string )_aeb .Hyperlink {return _cgfd ._efe .AddHyperlink (url )};
 
 // SetCharacterSpacing sets the run's Character Spacing Adjustment.
-func (_gaeg RunProperties )SetCharacterSpacing (size _ce .Distance ){_gaeg ._bfbg .Spacing =_fgg .NewCT_SignedTwipsMeasure ();_gaeg ._bfbg .Spacing .ValAttr .Int64 =_c .Int64 (int64 (size /_ce .Twips ));};
+func (_gaeg RunProperties )SetCharacterSpacing (size _ce .Distance )RunProperties {_gaeg ._bfbg .Spacing =_fgg .NewCT_SignedTwipsMeasure ();_gaeg ._bfbg .Spacing .ValAttr .Int64 =_c .Int64 (int64 (size /_ce .Twips ));return _gaeg ;};
 
 // FormFieldType is the type of the form field.
 //go:generate stringer -type=FormFieldType
@@ -356,8 +582,15 @@ type FormFieldType byte ;
 // It is defined here http://officeopenxml.com/WPstyleCharStyles.php
 func (_aeaac RunProperties )RStyle ()string {if _aeaac ._bfbg .RStyle !=nil {return _aeaac ._bfbg .RStyle .ValAttr ;};return "";};
 
+// fontMetrics maps lower case font names to the metrics table used to
+// approximate them and a scale factor relative to that table.
+var _ebdfa =map[string ]struct{widths *[95]int16 ;scale float64 ;}{"a\u0072\u0069a\u006c":{&_ccbfe ,1},"\u0068\u0065\u006c\u0076\u0065\u0074\u0069\u0063\u0061":{&_ccbfe ,1},"\u006ci\u0062\u0065\u0072\u0061\u0074\u0069\u006f\u006e\u0020\u0073\u0061\u006e\u0073":{&_ccbfe ,1},"\u0063\u0061\u006c\u0069\u0062\u0072\u0069":{&_ccbfe ,0.9},"\u0063\u0061\u006c\u0069\u0062\u0072\u0069\u0020\u006c\u0069\u0067\u0068\u0074":{&_ccbfe ,0.88},"\u0061\u0070\u0074\u006f\u0073":{&_ccbfe ,0.93},"\u0073\u0065g\u006f\u0065\u0020\u0075\u0069":{&_ccbfe ,0.98},"\u0074\u0061\u0068\u006f\u006da":{&_ccbfe ,0.98},"\u0076\u0065\u0072\u0064\u0061\u006e\u0061":{&_ccbfe ,1.12},"\u0074i\u006d\u0065\u0073\u0020\u006e\u0065\u0077\u0020\u0072\u006f\u006d\u0061\u006e":{&_fadbe ,1},"\u0074\u0069\u006d\u0065\u0073":{&_fadbe ,1},"\u006c\u0069\u0062\u0065\u0072\u0061\u0074\u0069\u006f\u006e\u0020s\u0065r\u0069\u0066":{&_fadbe ,1},"\u0063a\u006d\u0062r\u0069\u0061":{&_fadbe ,1.08},"\u0067\u0065\u006f\u0072\u0067\u0069\u0061":{&_fadbe ,1.13},"\u0067\u0061\u0072\u0061\u006d\u006f\u006e\u0064":{&_fadbe ,0.95},"\u0063\u006fu\u0072\u0069\u0065\u0072\u0020\u006e\u0065\u0077":{nil ,1},"c\u006f\u0075\u0072\u0069\u0065\u0072":{nil ,1},"\u0063\u006f\u006e\u0073\u006f\u006c\u0061\u0073":{nil ,0.92},};
+
+// GetImage returns the ImageRef associated with the drawing.
+func (_eaceb Drawing )GetImage ()(_aeb .ImageRef ,bool ){if _eaab ,_eddg :=_eaceb .Anchored ();_eddg {return _eaab .GetImage ();};if _fegf ,_cccc :=_eaceb .Inline ();_cccc {return _fegf .GetImage ();};return _aeb .ImageRef {},false ;};
+
 // SetKeepWithNext controls if this paragraph should be kept with the next.
-func (_ecgd ParagraphProperties )SetKeepWithNext (b bool ){if !b {_ecgd ._fdfc .KeepNext =nil ;}else {_ecgd ._fdfc .KeepNext =_fgg .NewCT_OnOff ();};};func _aeege (_eag *_fgg .CT_OnOff )bool {return _eag !=nil };
+func (_ecgd ParagraphProperties )SetKeepWithNext (b bool ){if !b {_ecgd ._fdfc .KeepNext =nil ;}else {_ecgd ._fdfc .KeepNext =_fgg .NewCT_OnOff ();};};func _aeege (_eag *_fgg .CT_OnOff )bool {return _aafe (_eag )==OnOffValueOn };
 
 // ClearColor clears the text color.
 func (_eaadf RunProperties )ClearColor (){_eaadf ._bfbg .Color =nil };
@@ -398,6 +631,10 @@ func (_bcbe Paragraph )AddFootnote (text string )Footnote {var _accfd int64 ;if
 // Margins allows controlling individual cell margins.
 func (_fed CellProperties )Margins ()CellMargins {if _fed ._egf .TcMar ==nil {_fed ._egf .TcMar =_fgg .NewCT_TcMar ();};return CellMargins {_fed ._egf .TcMar };};
 
+// ErrInvalidRun is returned by Run.Edit if the run isn't backed by a run
+// element.
+var ErrInvalidRun =_ef .New ("\u0072\u0075\u006e\u0020\u0069\u0073\u0020\u006e\u006f\u0074\u0020\u0062\u0061c\u006b\u0065d\u0020\u0062\u0079\u0020\u0061\u0020\u0072u\u006e\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074");
+
 // Emboss returns true if run emboss is on.
 func (_aefde RunProperties )Emboss ()bool {return _aeege (_aefde ._bfbg .Emboss )};
 
@@ -407,6 +644,16 @@ func (_aeabc Paragraph )AddRun ()Run {_aeega :=_fgg .NewEG_PContent ();_aeabc ._
 // SetPrimaryStyle marks the style as a primary style.
 func (_cafb Style )SetPrimaryStyle (b bool ){if b {_cafb ._dedd .QFormat =_fgg .NewCT_OnOff ();}else {_cafb ._dedd .QFormat =nil ;};};
 
+// AddFieldRaw adds a field whose instruction is written exactly as given,
+// including any quoting, switches and surrounding spaces (e.g.
+// `HYPERLINK "http://example.com" \o "tip"`).  The field is marked dirty so
+// that its result is calculated when the document is opened.
+func (_ffccb Run )AddFieldRaw (instr string ){_ffccb .addField (instr ,true )};func (_abbfd Run )addField (_dbdfe string ,_gdgc bool ){_ggbdc :=_abbfd .newIC ();_ggbdc .FldChar =_fgg .NewCT_FldChar ();_ggbdc .FldChar .FldCharTypeAttr =_fgg .ST_FldCharTypeBegin ;if _gdgc {_ggbdc .FldChar .DirtyAttr =&_fg .ST_OnOff {};_ggbdc .FldChar .DirtyAttr .Bool =_c .Bool (true );};_ggbdc =_abbfd .newIC ();_ggbdc .InstrText =_fgg .NewCT_Text ();if _c .NeedsSpacePreserve (_dbdfe ){_fadeb :="\u0070\u0072\u0065\u0073\u0065\u0072\u0076\u0065";_ggbdc .InstrText .SpaceAttr =&_fadeb ;};_ggbdc .InstrText .Content =_dbdfe ;_ggbdc =_abbfd .newIC ();_ggbdc .FldChar =_fgg .NewCT_FldChar ();_ggbdc .FldChar .FldCharTypeAttr =_fgg .ST_FldCharTypeEnd ;if _gdgc {_ggbdc .FldChar .DirtyAttr =&_fg .ST_OnOff {};_ggbdc .FldChar .DirtyAttr .Bool =_c .Bool (true );};};
+
+// wrapDistance converts a wrap distance to EMUs, treating negative distances
+// as zero.
+func _dcfdd (_bbfcd _ce .Distance )*uint32 {if _bbfcd < 0{_bbfcd =0;};return _c .Uint32 (uint32 (_bbfcd /_ce .EMU ));};
+
 // Properties returns the table properties.
 func (_dccf Table )Properties ()TableProperties {if _dccf ._gaec .TblPr ==nil {_dccf ._gaec .TblPr =_fgg .NewCT_TblPr ();};return TableProperties {_dccf ._gaec .TblPr };};
 
@@ -416,28 +663,91 @@ func (_abg RowProperties )SetHeight (ht _ce .Distance ,rule _fgg .ST_HeightRule
 // PossibleValues returns the possible values for a FormFieldTypeDropDown.
 func (_faba FormField )PossibleValues ()[]string {if _faba ._edda .DdList ==nil {return nil ;};_cdedb :=[]string {};for _ ,_ggaaa :=range _faba ._edda .DdList .ListEntry {if _ggaaa ==nil {continue ;};_cdedb =append (_cdedb ,_ggaaa .ValAttr );};return _cdedb ;};
 
+// X returns the inner wrapped XML type.
+func (_bgac TextBox )X ()*_fgg .CT_TxbxContent {return _bgac ._dffb };
+
 // SetDoubleStrikeThrough sets the run to double strike-through.
-func (_gabd RunProperties )SetDoubleStrikeThrough (b bool ){if !b {_gabd ._bfbg .Dstrike =nil ;}else {_gabd ._bfbg .Dstrike =_fgg .NewCT_OnOff ();};};
+func (_gabd RunProperties )SetDoubleStrikeThrough (b bool )RunProperties {if !b {_gabd ._bfbg .Dstrike =nil ;}else {_gabd ._bfbg .Dstrike =_fgg .NewCT_OnOff ();};return _gabd ;};
+
+// AddText adds text, such as a variable name, number or operator, to the
+// argument.
+func (_egbgg MathArgument )AddText (s string ){_defed :=_age .NewCT_R ();_cbea :=_age .NewCT_Text ();_cbea .Content =s ;if _c .NeedsSpacePreserve (s ){_cbea .SpaceAttr =_c .String ("\u0070\u0072\u0065\u0073\u0065\u0072\u0076\u0065");};_defed .Choice =append (_defed .Choice ,&_age .CT_RChoice {T :[]*_age .CT_Text {_cbea }});_egbgg .add ().R =_defed ;};
 
 // SetRight sets the cell right margin
 func (_caf CellMargins )SetRight (d _ce .Distance ){_caf ._bgg .Right =_fgg .NewCT_TblWidth ();_eb (_caf ._bgg .Right ,d );};
 
+// ImageBytes returns the content of the image file displayed by the drawing,
+// resolving the image relationship of the part that contains the drawing.
+func (_cgfa AnchoredDrawing )ImageBytes ()([]byte ,error ){_ecadd :="";if _cgfa ._gd .Graphic !=nil &&_cgfa ._gd .Graphic .GraphicData !=nil {for _ ,_eabgd :=range _cgfa ._gd .Graphic .GraphicData .Any {if _becdd ,_gffb :=_eabgd .(*_cde .Pic );_gffb &&_becdd .BlipFill !=nil &&_becdd .BlipFill .Blip !=nil &&_becdd .BlipFill .Blip .EmbedAttr !=nil {_ecadd =*_becdd .BlipFill .Blip .EmbedAttr ;break ;};};};if _ecadd ==""{return nil ,_ef .New ("\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u0020\u0064\u006f\u0065\u0073\u006e\u0027\u0074\u0020\u0064\u0069\u0073\u0070\u006c\u0061\u0079\u0020a\u006e\u0020\u0069\u006d\u0061\u0067\u0065");};_gbaf :=_cgfa ._da ;if _gbaf ==nil {return nil ,ErrImageNotFound ;};_fgaf :=_gbaf ._efe ;for _ ,_fffaa :=range _gbaf .allParagraphs (){for _ ,_eccfb :=range _fffaa .allRuns (){for _ ,_gfceb :=range _eccfb .DrawingAnchored (){if _gfceb ._gd ==_cgfa ._gd {_fgaf =_gbaf .relsOfRun (_eccfb );};};};};var _afeec *_bf .Relationship ;for _ ,_ffec :=range _fgaf .Relationships (){if _ffec .ID ()==_ecadd {_afeec =_ffec .X ();break ;};};if _afeec ==nil {return nil ,ErrImageRelationMissing ;};if _afeec .TargetModeAttr ==_bf .ST_TargetModeExternal {return nil ,_ef .New ("\u0069\u006d\u0061g\u0065\u0020\u0069\u0073\u0020\u006c\u0069\u006e\u006b\u0065\u0064\u0020\u0074\u006f\u0020\u0061n\u0020\u0065\u0078t\u0065\u0072\u006e\u0061\u006c\u0020\u0066\u0069l\u0065");};_cdced :=_a .TrimPrefix (_afeec .TargetAttr ,"\u002f");if !_a .HasPrefix (_afeec .TargetAttr ,"\u002f"){_cdced ="\u0077\u006f\u0072\u0064\u002f"+_cdced ;};for _dcecb ,_cdeda :=range _gbaf .Images {if !_a .EqualFold (_cdced ,_c .AbsoluteImageFilename (_c .DocTypeDocument ,_dcecb +1,_a .ToLower (_cdeda .Format ()))){continue ;};if _cdeda .Data ()!=nil {return *_cdeda .Data (),nil ;};return _dcbgf (_cdeda .Path ());};for _ ,_eeeda :=range _gbaf .ExtraFiles {if _a .EqualFold (_cdced ,_eeeda .ZipPath ){return _dcbgf (_eeeda .DiskPath );};};return nil ,ErrImageNotFound ;};
+
+// readTempFile returns the content of a file in temporary storage.
+func _dcbgf (_acbb string )([]byte ,error ){_fdgdg ,_dgcce :=_aebc .Open (_acbb );if _dgcce !=nil {return nil ,_dgcce ;};defer _fdgdg .Close ();return _e .ReadAll (_fdgdg );};
+
+// MoveRun moves a run within the paragraph so that it becomes the content item
+// at toIndex.  Items are counted in the order they appear in the paragraph; a
+// hyperlink, simple field or content control counts as a single item and is
+// moved along with the run it contains.  toIndex is clamped to the valid range
+// and nothing is changed if the run isn't part of the paragraph.
+func (_gbc Paragraph )MoveRun (r Run ,toIndex int ){_dceab :=[]*_fgg .EG_PContent {};for _ ,_fcaf :=range _gbc ._cfdb .EG_PContent {for _ ,_agec :=range _fcaf .FldSimple {_dceab =append (_dceab ,&_fgg .EG_PContent {FldSimple :[]*_fgg .CT_SimpleField {_agec }});};if _fcaf .Hyperlink !=nil {_dceab =append (_dceab ,&_fgg .EG_PContent {Hyperlink :_fcaf .Hyperlink });};if _fcaf .SubDoc !=nil {_dceab =append (_dceab ,&_fgg .EG_PContent {SubDoc :_fcaf .SubDoc });};for _ ,_eecdf :=range _fcaf .EG_ContentRunContent {_dceab =append (_dceab ,&_fgg .EG_PContent {EG_ContentRunContent :[]*_fgg .EG_ContentRunContent {_eecdf }});};};_faf :=-1;for _cade ,_dfgca :=range _dceab {for _ ,_cbfag :=range (Paragraph {_gbc ._eecc ,&_fgg .CT_P {EG_PContent :[]*_fgg .EG_PContent {_dfgca }}}).allRuns (){if _cbfag ._bfbb ==r ._bfbb {_faf =_cade ;break ;};};if _faf !=-1{break ;};};if _faf ==-1{return ;};_gcaad :=_dceab [_faf ];_dceab =append (_dceab [:_faf ],_dceab [_faf +1:]...);if toIndex < 0{toIndex =0;};if toIndex > len (_dceab ){toIndex =len (_dceab );};_dceab =append (_dceab ,nil );copy (_dceab [toIndex +1:],_dceab [toIndex :]);_dceab [toIndex ]=_gcaad ;_gbc ._cfdb .EG_PContent =_dceab ;};
+
+// TextLossy returns the text in the run like Text along with a flag that is
+// true if the run contains content that isn't represented in the text such as
+// breaks, drawings, objects, symbols or fields.
+func (_fefde Run )TextLossy ()(string ,bool ){_bcdd :=false ;for _ ,_bfbge :=range _fefde ._bfbb .EG_RunInnerContent {_dgaaf :=*_bfbge ;_dgaaf .T =nil ;_dgaaf .Tab =nil ;_dgaaf .NoBreakHyphen =nil ;_dgaaf .SoftHyphen =nil ;_dgaaf .LastRenderedPageBreak =nil ;if _dgaaf !=(_fgg .EG_RunInnerContent {}){_bcdd =true ;break ;};};return _fefde .Text (),_bcdd ;};
+
+// DrawingNode is an anchored or inline drawing within a run.
+type DrawingNode struct{Drawing Drawing };
+
+// TruncateText shortens the text of the run to at most maxRunes characters,
+// including the ellipsis that is appended to show that the text was shortened,
+// e.g. "..." or "…".  Tabs and non breaking hyphens count as a single
+// character, and other run content such as breaks and drawings is kept.  Text
+// that already fits isn't modified.
+func (_ggda Run )TruncateText (maxRunes int ,ellipsis string ){if maxRunes < 0{maxRunes =0;};if _afg .RuneCountInString (_ggda .Text ())<=maxRunes {return ;};_ggfb :=[]rune (ellipsis );if len (_ggfb )> maxRunes {_ggfb =_ggfb [:maxRunes ];};_aaegg :=maxRunes -len (_ggfb );_fgab :=make ([]*_fgg .EG_RunInnerContent ,0,len (_ggda ._bfbb .EG_RunInnerContent ));_deege :=false ;for _ ,_gggcd :=range _ggda ._bfbb .EG_RunInnerContent {if _gggcd .T ==nil &&_gggcd .Tab ==nil &&_gggcd .NoBreakHyphen ==nil {_fgab =append (_fgab ,_gggcd );continue ;};if _deege {continue ;};_eggf :=1;if _gggcd .T !=nil {_eggf =_afg .RuneCountInString (_gggcd .T .Content );};if _eggf <=_aaegg {_aaegg -=_eggf ;_fgab =append (_fgab ,_gggcd );continue ;};_deege =true ;_bdfaa :=_gggcd .T ;if _bdfaa !=nil {_bdfaa .Content =string ([]rune (_bdfaa .Content )[:_aaegg ])+string (_ggfb );}else {_bdfaa =_fgg .NewCT_Text ();_bdfaa .Content =string (_ggfb );};if _bdfaa .Content ==""{continue ;};_bdfaa .SpaceAttr =nil ;if _c .NeedsSpacePreserve (_bdfaa .Content ){_bdfaa .SpaceAttr =_c .String ("\u0070\u0072\u0065\u0073\u0065\u0072\u0076\u0065");};_aabdc :=_fgg .NewEG_RunInnerContent ();_aabdc .T =_bdfaa ;_fgab =append (_fgab ,_aabdc );};_ggda ._bfbb .EG_RunInnerContent =_fgab ;};
+
 // AddRow adds a row to a table.
 func (_bagab Table )AddRow ()Row {_gcae :=_fgg .NewEG_ContentRowContent ();_bagab ._gaec .EG_ContentRowContent =append (_bagab ._gaec .EG_ContentRowContent ,_gcae );_ggec :=_fgg .NewCT_Row ();_gcae .Tr =append (_gcae .Tr ,_ggec );return Row {_bagab ._gcfe ,_ggec };};
 
+// ExtractTextOptions controls which text is returned by
+// ExtractTextWithOptions.
+type ExtractTextOptions struct{IncludeHidden bool ;};
+
+// Fields returns the simple and complex fields in the document body, headers
+// and footers.  Fields are returned paragraph by paragraph, with a paragraph's
+// simple fields preceding its complex fields.  Complex fields that are missing
+// their end field character within the same part are not returned.
+func (_cdgfb *Document )Fields ()[]Field {_gfcfc :=[]Field {};_bbfae :=[]bool {};type openField struct{idx int ;separated bool ;};_beeba :=[]openField {};var _cgff func (_effe Paragraph ,_fffg []*_fgg .EG_PContent );_cgff =func (_dfea Paragraph ,_ccdbd []*_fgg .EG_PContent ){for _ ,_fcde :=range _ccdbd {for _ ,_bagbe :=range _fcde .FldSimple {_bgeaa :=Paragraph {_cdgfb ,&_fgg .CT_P {EG_PContent :_bagbe .EG_PContent }}.allRuns ();_gfcfc =append (_gfcfc ,Field {_dfea ,_bagbe ,_bgeaa ,_bagbe .InstrAttr });_bbfae =append (_bbfae ,true );_cgff (_dfea ,_bagbe .EG_PContent );};};};for _ ,_ebcgf :=range _cdgfb .partParagraphs (){_beeba =_beeba [:0];for _ ,_gdaaa :=range _ebcgf {_cgff (_gdaaa ,_gdaaa ._cfdb .EG_PContent );for _ ,_afbae :=range _gdaaa .allRuns (){for _ ,_fdee :=range _beeba {_gfcfc [_fdee .idx ]._dgeb =append (_gfcfc [_fdee .idx ]._dgeb ,_afbae );};for _ ,_eaee :=range _afbae ._bfbb .EG_RunInnerContent {switch {case _eaee .FldChar !=nil :switch _eaee .FldChar .FldCharTypeAttr {case _fgg .ST_FldCharTypeBegin :_beeba =append (_beeba ,openField {idx :len (_gfcfc )});_gfcfc =append (_gfcfc ,Field {_ffcf :_gdaaa ,_dgeb :[]Run {_afbae }});_bbfae =append (_bbfae ,false );case _fgg .ST_FldCharTypeSeparate :if len (_beeba )> 0{_beeba [len (_beeba )-1].separated =true ;};case _fgg .ST_FldCharTypeEnd :if len (_beeba )> 0{_bbfae [_beeba [len (_beeba )-1].idx ]=true ;_beeba =_beeba [:len (_beeba )-1];};};case _eaee .InstrText !=nil :if len (_beeba )> 0&&!_beeba [len (_beeba )-1].separated {_gfcfc [_beeba [len (_beeba )-1].idx ]._dbfe +=_eaee .InstrText .Content ;};};};};};};_ebfdf :=_gfcfc [:0];for _ggcbd ,_ffdg :=range _gfcfc {if _bbfae [_ggcbd ]{_ebfdf =append (_ebfdf ,_ffdg );};};return _ebfdf ;};
+
+// serifWidths are the widths of the printable ASCII characters, starting with
+// the space, in a Times style serif font in 1/1000 em.
+var _fadbe =[95]int16 {250,333,408,500,500,833,778,180,333,333,500,564,250,333,250,278,500,500,500,500,500,500,500,500,500,500,278,278,564,564,564,444,921,722,667,667,722,611,556,722,722,333,389,722,611,889,722,722,556,722,667,556,611,722,722,944,722,722,611,333,278,333,469,500,333,444,500,444,500,444,333,500,500,278,278,500,278,778,500,500,500,500,333,389,278,500,500,722,500,500,444,480,200,480,541,};
+
 // Close closes the document, removing any temporary files that might have been
 // created when opening a document.
 func (_acff *Document )Close ()error {if _acff .TmpPath !=""{return _aebc .RemoveAll (_acff .TmpPath );};return nil ;};
 
+// Document returns the document that the run belongs to.
+func (_aabfc Run )Document ()*Document {return _aabfc ._adbf };
+
 // Tables returns the tables defined in the header.
 func (_eeae Header )Tables ()[]Table {_ddfc :=[]Table {};if _eeae ._fcad ==nil {return nil ;};for _ ,_cfcb :=range _eeae ._fcad .EG_ContentBlockContent {for _ ,_efee :=range _eeae ._gdd .tables (_cfcb ){_ddfc =append (_ddfc ,_efee );};};return _ddfc ;};
 
+// FieldNode is part of a complex field within a run.  It is either a field
+// character that begins the field, separates the instruction from the result or
+// ends the field, or it is text of the field instruction, in which case Char is
+// wml.ST_FldCharTypeUnset.
+type FieldNode struct{Char _fgg .ST_FldCharType ;Instruction string ;};func (TextNode )isRunNode (){};func (TabNode )isRunNode (){};func (BreakNode )isRunNode (){};func (DrawingNode )isRunNode (){};func (FieldNode )isRunNode (){};
+
 // ComplexSizeMeasure returns font with its measure which can be mm, cm, in, pt, pc or pi.
 func (_gab ParagraphProperties )ComplexSizeMeasure ()string {if _geb :=_gab ._fdfc .RPr .SzCs ;_geb !=nil {_bebb :=_geb .ValAttr ;if _bebb .ST_PositiveUniversalMeasure !=nil {return *_bebb .ST_PositiveUniversalMeasure ;};};return "";};
 
 // SetText sets the text to be used in bullet mode.
 func (_efdd NumberingLevel )SetText (t string ){if t ==""{_efdd ._cbf .LvlText =nil ;}else {_efdd ._cbf .LvlText =_fgg .NewCT_LevelText ();_efdd ._cbf .LvlText .ValAttr =_c .String (t );};};
 
+// Type returns the upper cased field type, which is the first word of the
+// instruction (e.g. "PAGE" or "TOC").
+func (_ecbfc Field )Type ()string {_dgdaf :=_a .Fields (_ecbfc ._dbfe );if len (_dgdaf )==0{return "";};return _a .ToUpper (_dgdaf [0]);};
+
 // SetTop sets the cell top margin
 func (_fad CellMargins )SetTop (d _ce .Distance ){_fad ._bgg .Top =_fgg .NewCT_TblWidth ();_eb (_fad ._bgg .Top ,d );};
 
@@ -451,7 +761,11 @@ func (_ddda Style )SetSemiHidden (b bool ){if b {_ddda ._dedd .SemiHidden =_fgg
 func (_adbg *Document )GetOrCreateCustomProperties ()_aeb .CustomProperties {if _adbg .CustomProperties .X ()==nil {_adbg .createCustomProperties ();};return _adbg .CustomProperties ;};
 
 // SetItalic sets the run to italic.
-func (_dfadc RunProperties )SetItalic (b bool ){if !b {_dfadc ._bfbg .I =nil ;_dfadc ._bfbg .ICs =nil ;}else {_dfadc ._bfbg .I =_fgg .NewCT_OnOff ();_dfadc ._bfbg .ICs =_fgg .NewCT_OnOff ();};};
+func (_dfadc RunProperties )SetItalic (b bool )RunProperties {if !b {_dfadc ._bfbg .I =nil ;_dfadc ._bfbg .ICs =nil ;}else {_dfadc ._bfbg .I =_fgg .NewCT_OnOff ();_dfadc ._bfbg .ICs =_fgg .NewCT_OnOff ();};return _dfadc ;};
+
+// TextWithBreaks returns the text in the run like Text, but renders line
+// breaks as '\n', page breaks as '\f' and tabs as '\t'.
+func (_adg Run )TextWithBreaks ()string {_aeaf :=_d .Buffer {};for _ ,_feeg :=range _adg ._bfbb .EG_RunInnerContent {switch {case _feeg .T !=nil :_aeaf .WriteString (_feeg .T .Content );case _feeg .Tab !=nil ,_feeg .Ptab !=nil :_aeaf .WriteByte ('\t');case _feeg .NoBreakHyphen !=nil :_aeaf .WriteByte ('-');case _feeg .Br !=nil :if _feeg .Br .TypeAttr ==_fgg .ST_BrTypePage {_aeaf .WriteByte ('\f');}else {_aeaf .WriteByte ('\n');};case _feeg .Cr !=nil :_aeaf .WriteByte ('\n');};};return _aeaf .String ();};
 
 // SetASCIITheme sets the font ASCII Theme.
 func (_gadfe Fonts )SetASCIITheme (t _fgg .ST_Theme ){_gadfe ._ddg .AsciiThemeAttr =t };
@@ -459,24 +773,59 @@ func (_gadfe Fonts )SetASCIITheme (t _fgg .ST_Theme ){_gadfe ._ddg .AsciiThemeAt
 // AddRun adds a run of text to a hyperlink. This is the text that will be linked.
 func (_begea HyperLink )AddRun ()Run {_eaf :=_fgg .NewEG_ContentRunContent ();_begea ._efga .EG_ContentRunContent =append (_begea ._efga .EG_ContentRunContent ,_eaf );_begf :=_fgg .NewCT_R ();_eaf .R =_begf ;return Run {_begea ._bggd ,_begf };};
 
+// SetLeftBorder sets the left border of the paragraph.
+func (_bgedc Paragraph )SetLeftBorder (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_bgedc .Properties ().Borders ().SetLeft (t ,c ,thickness );};
+
 // X returns the inner wrapped XML type.
 func (_efda Numbering )X ()*_fgg .Numbering {return _efda ._fdda };
 
+// ClearHighlights removes the highlighting from every run in the document
+// body, headers and footers, returning the number of runs that were cleared.
+func (_geac *Document )ClearHighlights ()int {_ffgef :=0;for _ ,_efcfd :=range _geac .allRuns (){if _cabb :=_efcfd ._bfbb .RPr ;_cabb !=nil &&_cabb .Highlight !=nil {_cabb .Highlight =nil ;_efcfd .removeEmptyRPr ();_ffgef ++;};};return _ffgef ;};
+
+// SetShadingHighlight approximates a highlight in an arbitrary color rather
+// than one of the sixteen ST_HighlightColor values. WordprocessingML has no
+// element for highlights in other colors, so this is a workaround that writes
+// clear character shading filled with c and removes any legacy highlight,
+// which would be drawn over the shading. Word displays the result as shading,
+// not as a highlight. Passing color.Auto removes shading written by this
+// method but leaves any other shading of the run in place.
+func (_gbda Run )SetShadingHighlight (c _bbd .Color )Run {if c .IsAuto (){if _gbda ._bfbb .RPr !=nil &&_efbga (_gbda ._bfbb .RPr .Shd ){_gbda ._bfbb .RPr .Shd =nil ;_gbda .removeEmptyRPr ();};return _gbda ;};_ecfcc :=_gbda .Properties ().X ();_ecfcc .Highlight =nil ;_ecfcc .Shd =_fgg .NewCT_Shd ();_ecfcc .Shd .ValAttr =_fgg .ST_ShdClear ;_ecfcc .Shd .ColorAttr =&_fgg .ST_HexColor {};_ecfcc .Shd .ColorAttr .ST_HexColorAuto =_fgg .ST_HexColorAutoAuto ;_ecfcc .Shd .FillAttr =&_fgg .ST_HexColor {};_ecfcc .Shd .FillAttr .ST_HexColorRGB =c .AsRGBString ();return _gbda ;};
+
 // X returns the inner wrapped XML type.
 func (_cae Color )X ()*_fgg .CT_Color {return _cae ._aaf };
 
 // VerticalAlign returns the value of paragraph vertical align.
 func (_gcbf ParagraphProperties )VerticalAlignment ()_fg .ST_VerticalAlignRun {if _cedc :=_gcbf ._fdfc .RPr .VertAlign ;_cedc !=nil {return _cedc .ValAttr ;};return 0;};
 
+// Inline returns the drawing as an InlineDrawing if it is inline.
+func (_ecgb Drawing )Inline ()(InlineDrawing ,bool ){if _ecgb ._eff ==nil {return InlineDrawing {},false ;};return InlineDrawing {_ecgb ._gbce ,_ecgb ._eff },true ;};
+
+// Paragraph returns the paragraph in the document body, headers or footers
+// that contains the run. The boolean is false if the run isn't part of the
+// document (e.g. it has been removed).
+func (_ebge Run )Paragraph ()(Paragraph ,bool ){if _ebge ._adbf ==nil {return Paragraph {},false ;};return _ebge ._adbf .paragraphOfRun (_ebge ._bfbb );};
+
 // ParagraphProperties are the properties for a paragraph.
 type ParagraphProperties struct{_dfag *Document ;_fdfc *_fgg .CT_PPr ;};
 
+// TextEqualsIgnoreSpace returns true if the text of the run and other is the
+// same after normalizing whitespace.  Leading and trailing whitespace is
+// ignored and any other sequence of whitespace characters, including tabs and
+// non breaking spaces, compares equal to a single space.
+func (_geede Run )TextEqualsIgnoreSpace (other Run )bool {return _a .Join (_a .Fields (_geede .Text ()),"\u0020")==_a .Join (_a .Fields (other .Text ()),"\u0020");};
+
 // SetSize sets the size of the displayed image on the page.
-func (_ea AnchoredDrawing )SetSize (w ,h _ce .Distance ){_ea ._gd .Extent .CxAttr =int64 (float64 (w *_ce .Pixel72 )/_ce .EMU );_ea ._gd .Extent .CyAttr =int64 (float64 (h *_ce .Pixel72 )/_ce .EMU );};
+func (_ea AnchoredDrawing )SetSize (w ,h _ce .Distance ){_ea ._gd .Extent .CxAttr =_ce .ToEMU (float64 (w ));_ea ._gd .Extent .CyAttr =_ce .ToEMU (float64 (h ));};
+
+// AddNonBreakingText adds text to the run that will be kept together on a
+// single line. Spaces are converted to non-breaking spaces and hyphens to
+// non-breaking hyphens.
+func (_deafb Run )AddNonBreakingText (s string ){s =_a .Replace (s ,"\u0020","\u00a0",-1);for _babfd ,_cebec :=range _a .Split (s ,"\u002d"){if _babfd > 0{_deafb .AddNoBreakHyphen ();};if _cebec !=""{_deafb .AddText (_cebec );};};};
 
 // AddImage adds an image to the document package, returning a reference that
 // can be used to add the image to a run and place it in the document contents.
-func (_dgc *Document )AddImage (i _aeb .Image )(_aeb .ImageRef ,error ){_bag :=_aeb .MakeImageRef (i ,&_dgc .DocBase ,_dgc ._efe );if i .Data ==nil &&i .Path ==""{return _bag ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0064\u0061t\u0061\u0020\u006f\u0072\u0020\u0061\u0020\u0070\u0061\u0074\u0068");};if i .Format ==""{return _bag ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0061\u0020v\u0061\u006c\u0069\u0064\u0020\u0066\u006f\u0072\u006d\u0061\u0074");};if i .Size .X ==0||i .Size .Y ==0{return _bag ,_ef .New ("\u0069\u006d\u0061\u0067e\u0020\u006d\u0075\u0073\u0074\u0020\u0068\u0061\u0076\u0065 \u0061 \u0076\u0061\u006c\u0069\u0064\u0020\u0073i\u007a\u0065");};if i .Path !=""{_bgcd :=_aebc .Add (i .Path );if _bgcd !=nil {return _bag ,_bgcd ;};};_dgc .Images =append (_dgc .Images ,_bag );_adf :=_cf .Sprintf ("\u006d\u0065d\u0069\u0061\u002fi\u006d\u0061\u0067\u0065\u0025\u0064\u002e\u0025\u0073",len (_dgc .Images ),i .Format );_afbgc :=_dgc ._efe .AddRelationship (_adf ,_c .ImageType );_dgc .ContentTypes .EnsureDefault ("\u0070\u006e\u0067","\u0069m\u0061\u0067\u0065\u002f\u0070\u006eg");_dgc .ContentTypes .EnsureDefault ("\u006a\u0070\u0065\u0067","\u0069\u006d\u0061\u0067\u0065\u002f\u006a\u0070\u0065\u0067");_dgc .ContentTypes .EnsureDefault ("\u006a\u0070\u0067","\u0069\u006d\u0061\u0067\u0065\u002f\u006a\u0070\u0065\u0067");_dgc .ContentTypes .EnsureDefault ("\u0077\u006d\u0066","i\u006d\u0061\u0067\u0065\u002f\u0078\u002d\u0077\u006d\u0066");_dgc .ContentTypes .EnsureDefault (i .Format ,"\u0069\u006d\u0061\u0067\u0065\u002f"+i .Format );_bag .SetRelID (_afbgc .X ().IdAttr );return _bag ,nil ;};
+func (_dgc *Document )AddImage (i _aeb .Image )(_aeb .ImageRef ,error ){_bag :=_aeb .MakeImageRef (i ,&_dgc .DocBase ,_dgc ._efe );if i .Data ==nil &&i .Path ==""{return _bag ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0064\u0061t\u0061\u0020\u006f\u0072\u0020\u0061\u0020\u0070\u0061\u0074\u0068");};if i .Format ==""{return _bag ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0061\u0020v\u0061\u006c\u0069\u0064\u0020\u0066\u006f\u0072\u006d\u0061\u0074");};if i .Size .X ==0||i .Size .Y ==0{return _bag ,_ef .New ("\u0069\u006d\u0061\u0067e\u0020\u006d\u0075\u0073\u0074\u0020\u0068\u0061\u0076\u0065 \u0061 \u0076\u0061\u006c\u0069\u0064\u0020\u0073i\u007a\u0065");};if i .Path !=""{_bgcd :=_aebc .Add (i .Path );if _bgcd !=nil {return _bag ,_bgcd ;};};_dgc .Images =append (_dgc .Images ,_bag );_adf :=_cf .Sprintf ("\u006d\u0065d\u0069\u0061\u002fi\u006d\u0061\u0067\u0065\u0025\u0064\u002e\u0025\u0073",len (_dgc .Images ),i .Format );_afbgc :=_dgc ._efe .AddRelationship (_adf ,_c .ImageType );_dgc .ensureImageDefault ("\u0070\u006e\u0067","\u0069m\u0061\u0067\u0065\u002f\u0070\u006eg");_dgc .ensureImageDefault ("\u006a\u0070\u0065\u0067","\u0069\u006d\u0061\u0067\u0065\u002f\u006a\u0070\u0065\u0067");_dgc .ensureImageDefault ("\u006a\u0070\u0067","\u0069\u006d\u0061\u0067\u0065\u002f\u006a\u0070\u0065\u0067");_dgc .ensureImageDefault ("\u0077\u006d\u0066","i\u006d\u0061\u0067\u0065\u002f\u0078\u002d\u0077\u006d\u0066");if i .Format =="\u0073\u0076\u0067"{_dgc .ensureImageDefault (i .Format ,"\u0069\u006d\u0061\u0067\u0065\u002f\u0073\u0076\u0067\u002b\u0078\u006d\u006c");}else {_dgc .ensureImageDefault (i .Format ,"\u0069\u006d\u0061\u0067\u0065\u002f"+i .Format );};_bag .SetRelID (_afbgc .X ().IdAttr );_dgc .Images [len (_dgc .Images )-1]=_bag ;return _bag ,nil ;};
 
 // TableWidth controls width values in table settings.
 type TableWidth struct{_eegef *_fgg .CT_TblWidth };
@@ -496,6 +845,10 @@ func (_aded Row )AddCell ()Cell {_gage :=_fgg .NewEG_ContentCellContent ();_aded
 // Style returns the style for a paragraph, or an empty string if it is unset.
 func (_bddb Paragraph )Style ()string {if _bddb ._cfdb .PPr !=nil &&_bddb ._cfdb .PPr .PStyle !=nil {return _bddb ._cfdb .PPr .PStyle .ValAttr ;};return "";};
 
+// relsOfRun returns the relationships of the part that contains the run,
+// defaulting to the document relationships.
+func (_afaa *Document )relsOfRun (_gggb Run )_aeb .Relationships {_ffg :=func (_dbbdd []Paragraph )bool {for _ ,_bfege :=range _dbbdd {for _ ,_dfece :=range _bfege .allRuns (){if _dfece ._bfbb ==_gggb ._bfbb {return true ;};};};return false ;};for _efbbb ,_gabc :=range _afaa ._fbc {if _efbbb < len (_afaa ._ff )&&_ffg (_eefag (Header {_afaa ,_gabc }.Paragraphs ())){return _afaa ._ff [_efbbb ];};};for _dafec ,_efcdc :=range _afaa ._eefb {if _dafec < len (_afaa ._edgc )&&_ffg (_eefag (Footer {_afaa ,_efcdc }.Paragraphs ())){return _afaa ._edgc [_dafec ];};};return _afaa ._efe ;};
+
 // SetCellSpacingPercent sets the cell spacing within a table to a percent width.
 func (_fffd TableStyleProperties )SetCellSpacingPercent (pct float64 ){_fffd ._fbbc .TblCellSpacing =_fgg .NewCT_TblWidth ();_fffd ._fbbc .TblCellSpacing .TypeAttr =_fgg .ST_TblWidthPct ;_fffd ._fbbc .TblCellSpacing .WAttr =&_fgg .ST_MeasurementOrPercent {};_fffd ._fbbc .TblCellSpacing .WAttr .ST_DecimalNumberOrPercent =&_fgg .ST_DecimalNumberOrPercent {};_fffd ._fbbc .TblCellSpacing .WAttr .ST_DecimalNumberOrPercent .ST_UnqualifiedPercentage =_c .Int64 (int64 (pct *50));};
 
@@ -518,12 +871,30 @@ func (_cfeb ParagraphSpacing )SetAfterAuto (b bool ){if b {_cfeb ._bged .AfterAu
 // ParagraphProperties returns the paragraph style properties.
 func (_bagg Style )ParagraphProperties ()ParagraphStyleProperties {if _bagg ._dedd .PPr ==nil {_bagg ._dedd .PPr =_fgg .NewCT_PPrGeneral ();};return ParagraphStyleProperties {_bagg ._dedd .PPr };};
 
+// EstimatedSize returns the approximate number of bytes the run occupies when
+// the document is serialized, before compression.  The run is encoded to
+// determine its size, but the output isn't retained.
+func (_fgbc Run )EstimatedSize ()int {var _fdbcb byteCounter ;_cddgc :=_fda .NewEncoder (&_fdbcb );if _gggf :=_cddgc .EncodeElement (_fgbc ._bfbb ,_fda .StartElement {Name :_fda .Name {Local :"\u0077\u003a\u0072"}});_gggf !=nil {return 0;};_cddgc .Flush ();return int (_fdbcb );};
+
+// partParagraphs returns the paragraphs of the document body, headers and
+// footers, including those within text boxes, grouped by part.
+func (_aaegb *Document )partParagraphs ()[][]Paragraph {_bcge :=[][]Paragraph {_eefag (_aaegb .Paragraphs ())};for _ ,_cffge :=range _aaegb .Headers (){_bcge =append (_bcge ,_eefag (_cffge .Paragraphs ()));};for _ ,_gcdda :=range _aaegb .Footers (){_bcge =append (_bcge ,_eefag (_gcdda .Paragraphs ()));};return _bcge ;};
+
 // Properties returns the row properties.
 func (_accc Row )Properties ()RowProperties {if _accc ._edag .TrPr ==nil {_accc ._edag .TrPr =_fgg .NewCT_TrPr ();};return RowProperties {_accc ._edag .TrPr };};func (_defca Run )newIC ()*_fgg .EG_RunInnerContent {_caaf :=_fgg .NewEG_RunInnerContent ();_defca ._bfbb .EG_RunInnerContent =append (_defca ._bfbb .EG_RunInnerContent ,_caaf );return _caaf ;};
 
 // AddField adds a field (automatically computed text) to the document.
 func (_ggab Run )AddField (code string ){_ggab .AddFieldWithFormatting (code ,"",true )};
 
+// AddAltChunk adds an alternative format import part (e.g. HTML or RTF
+// content) to the end of the document body. The content is stored as a
+// separate part in the package and is converted by Word when the document is
+// opened.
+func (_ccbea *Document )AddAltChunk (content []byte ,contentType string )error {if contentType ==""{return _cf .Errorf ("\u0063\u006f\u006e\u0074\u0065\u006e\u0074 \u0074\u0079\u0070e\u0020\u0069s\u0020\u0072\u0065\u0071\u0075\u0069\u0072\u0065\u0064\u0020\u0066\u006f\u0072\u0020\u0061\u006c\u0074\u0043\u0068\u0075n\u006b");};_efbdd ,_beed :=_ccbea .writeTempPart (content ,"\u0061\u0066\u0063\u0068u\u006e\u006b");if _beed !=nil {return _beed ;};_cdgab :="\u0062\u0069\u006e";switch _a .ToLower (contentType ){case "\u0074\u0065x\u0074\u002f\u0068\u0074\u006d\u006c":_cdgab ="\u0068\u0074\u006d";case "\u0061\u0070p\u006c\u0069c\u0061\u0074\u0069\u006fn\u002f\u0078\u0068t\u006d\u006c\u002b\u0078\u006d\u006c":_cdgab ="\u0078\u0068\u0074\u006d\u006c";case "ap\u0070\u006c\u0069\u0063a\u0074\u0069\u006f\u006e\u002f\u0072\u0074\u0066","\u0074\u0065\u0078\u0074/\u0072\u0074\u0066":_cdgab ="\u0072\u0074\u0066";case "\u0074\u0065x\u0074\u002f\u0070\u006c\u0061\u0069\u006e":_cdgab ="\u0074x\u0074";case "\u006d\u0065\u0073\u0073a\u0067\u0065\u002f\u0072\u0066\u0063\u0038\u0032\u0032":_cdgab ="\u006d\u0068\u0074";case "a\u0070\u0070\u006c\u0069\u0063\u0061\u0074\u0069\u006f\u006e\u002f\u0078\u006dl","\u0074\u0065\u0078\u0074\u002f\u0078\u006d\u006c":_cdgab ="x\u006d\u006c";};_agedd :=1;for _ ,_dfegb :=range _ccbea .ExtraFiles {if _a .HasPrefix (_dfegb .ZipPath ,"\u0077\u006f\u0072d\u002f\u0061\u0066\u0063\u0068\u0075\u006e\u006b"){_agedd ++;};};_fceag :=_cf .Sprintf ("\u0061\u0066\u0063\u0068u\u006e\u006b\u0025\u0064\u002e\u0025\u0073",_agedd ,_cdgab );_ccbea .ExtraFiles =append (_ccbea .ExtraFiles ,_aeb .ExtraFile {ZipPath :"\u0077o\u0072\u0064\u002f"+_fceag ,DiskPath :_efbdd });_ccbea .ContentTypes .AddOverride ("\u002f\u0077\u006fr\u0064/"+_fceag ,contentType );_ggabg :=_ccbea ._efe .AddRelationship (_fceag ,_aged );_adacf :=_fgg .NewCT_AltChunk ();_adacf .IdAttr =_c .String (_ggabg .ID ());_ccaa :=_fgg .NewEG_BlockLevelElts ();_ccaa .AltChunk =append (_ccaa .AltChunk ,_adacf );_ccbea ._cdaa .Body .EG_BlockLevelElts =append (_ccbea ._cdaa .Body .EG_BlockLevelElts ,_ccaa );return nil ;};
+
+// w14RPrOrder is the order of the Word 2010 run property extensions.
+var _babd =[]string {"\u0067\u006co\u0077","\u0073\u0068\u0061\u0064\u006f\u0077","\u0072e\u0066\u006c\u0065\u0063\u0074i\u006f\u006e","\u0074\u0065\u0078\u0074O\u0075\u0074\u006c\u0069\u006e\u0065","\u0074\u0065\u0078\u0074F\u0069\u006cl","\u0073\u0063\u0065n\u0065\u0033d","p\u0072\u006f\u0070\u0073\u0033\u0064","\u006c\u0069\u0067\u0061\u0074\u0075\u0072\u0065\u0073","\u006e\u0075m\u0046\u006f\u0072\u006d","\u006e\u0075\u006d\u0053p\u0061\u0063\u0069\u006e\u0067","s\u0074\u0079\u006c\u0069s\u0074\u0069\u0063\u0053\u0065\u0074\u0073","\u0063\u006e\u0074\u0078t\u0041\u006c\u0074\u0073"};func _gbbdg (_acebe _c .Any )string {_gbggg ,_fegdg :=_acebe .(*_c .XSDAny );if !_fegdg {return "";};_dacdg :=_gbggg .XMLName .Local ;if _cfbff :=_a .IndexByte (_dacdg ,':');_cfbff !=-1{_dacdg =_dacdg [_cfbff +1:];};return _dacdg ;};func _fagef (_gedg string )int {for _bgdad ,_afgd :=range _babd {if _afgd ==_gedg {return _bgdad ;};};return len (_babd );};
+
 // Emboss returns true if paragraph emboss is on.
 func (_ecbc ParagraphProperties )Emboss ()bool {return _aeege (_ecbc ._fdfc .RPr .Emboss )};func (_eea *Document )insertTable (_cafe Paragraph ,_ag bool )Table {_gba :=_eea ._cdaa .Body ;if _gba ==nil {return _eea .AddTable ();};_ddab :=_cafe .X ();for _bbce ,_dab :=range _gba .EG_BlockLevelElts {for _ ,_afbg :=range _dab .EG_ContentBlockContent {for _bggc ,_cdg :=range _afbg .P {if _cdg ==_ddab {_gfae :=_fgg .NewCT_Tbl ();_eeea :=_fgg .NewEG_BlockLevelElts ();_bab :=_fgg .NewEG_ContentBlockContent ();_eeea .EG_ContentBlockContent =append (_eeea .EG_ContentBlockContent ,_bab );_bab .Tbl =append (_bab .Tbl ,_gfae );_gba .EG_BlockLevelElts =append (_gba .EG_BlockLevelElts ,nil );if _ag {copy (_gba .EG_BlockLevelElts [_bbce +1:],_gba .EG_BlockLevelElts [_bbce :]);_gba .EG_BlockLevelElts [_bbce ]=_eeea ;if _bggc !=0{_ccg :=_fgg .NewEG_BlockLevelElts ();_efbb :=_fgg .NewEG_ContentBlockContent ();_ccg .EG_ContentBlockContent =append (_ccg .EG_ContentBlockContent ,_efbb );_efbb .P =_afbg .P [:_bggc ];_gba .EG_BlockLevelElts =append (_gba .EG_BlockLevelElts ,nil );copy (_gba .EG_BlockLevelElts [_bbce +1:],_gba .EG_BlockLevelElts [_bbce :]);_gba .EG_BlockLevelElts [_bbce ]=_ccg ;};_afbg .P =_afbg .P [_bggc :];}else {copy (_gba .EG_BlockLevelElts [_bbce +2:],_gba .EG_BlockLevelElts [_bbce +1:]);_gba .EG_BlockLevelElts [_bbce +1]=_eeea ;if _bggc !=len (_afbg .P )-1{_fcd :=_fgg .NewEG_BlockLevelElts ();_afba :=_fgg .NewEG_ContentBlockContent ();_fcd .EG_ContentBlockContent =append (_fcd .EG_ContentBlockContent ,_afba );_afba .P =_afbg .P [_bggc +1:];_gba .EG_BlockLevelElts =append (_gba .EG_BlockLevelElts ,nil );copy (_gba .EG_BlockLevelElts [_bbce +3:],_gba .EG_BlockLevelElts [_bbce +2:]);_gba .EG_BlockLevelElts [_bbce +2]=_fcd ;};_afbg .P =_afbg .P [:_bggc +1];};return Table {_eea ,_gfae };};};for _ ,_fbac :=range _afbg .Tbl {for _ ,_ecf :=range _fbac .EG_ContentRowContent {for _ ,_abcc :=range _ecf .Tr {for _ ,_adbe :=range _abcc .EG_ContentCellContent {for _ ,_agd :=range _adbe .Tc {for _bfb ,_cec :=range _agd .EG_BlockLevelElts {for _ ,_egfb :=range _cec .EG_ContentBlockContent {for _cfe ,_dde :=range _egfb .P {if _dde ==_ddab {_ggf :=_fgg .NewEG_BlockLevelElts ();_ddf :=_fgg .NewEG_ContentBlockContent ();_ggf .EG_ContentBlockContent =append (_ggf .EG_ContentBlockContent ,_ddf );_cgge :=_fgg .NewCT_Tbl ();_ddf .Tbl =append (_ddf .Tbl ,_cgge );_agd .EG_BlockLevelElts =append (_agd .EG_BlockLevelElts ,nil );if _ag {copy (_agd .EG_BlockLevelElts [_bfb +1:],_agd .EG_BlockLevelElts [_bfb :]);_agd .EG_BlockLevelElts [_bfb ]=_ggf ;if _cfe !=0{_aeeg :=_fgg .NewEG_BlockLevelElts ();_babg :=_fgg .NewEG_ContentBlockContent ();_aeeg .EG_ContentBlockContent =append (_aeeg .EG_ContentBlockContent ,_babg );_babg .P =_egfb .P [:_cfe ];_agd .EG_BlockLevelElts =append (_agd .EG_BlockLevelElts ,nil );copy (_agd .EG_BlockLevelElts [_bfb +1:],_agd .EG_BlockLevelElts [_bfb :]);_agd .EG_BlockLevelElts [_bfb ]=_aeeg ;};_egfb .P =_egfb .P [_cfe :];}else {copy (_agd .EG_BlockLevelElts [_bfb +2:],_agd .EG_BlockLevelElts [_bfb +1:]);_agd .EG_BlockLevelElts [_bfb +1]=_ggf ;if _cfe !=len (_afbg .P )-1{_febb :=_fgg .NewEG_BlockLevelElts ();_dbca :=_fgg .NewEG_ContentBlockContent ();_febb .EG_ContentBlockContent =append (_febb .EG_ContentBlockContent ,_dbca );_dbca .P =_egfb .P [_cfe +1:];_agd .EG_BlockLevelElts =append (_agd .EG_BlockLevelElts ,nil );copy (_agd .EG_BlockLevelElts [_bfb +3:],_agd .EG_BlockLevelElts [_bfb +2:]);_agd .EG_BlockLevelElts [_bfb +2]=_febb ;};_egfb .P =_egfb .P [:_cfe +1];};return Table {_eea ,_cgge };};};};};};};};};};};};return _eea .AddTable ();};
 
@@ -533,6 +904,13 @@ func (_bcdeb ParagraphStyleProperties )SetHangingIndent (m _ce .Distance ){if _b
 // RowProperties are the properties for a row within a table
 type RowProperties struct{_fbgac *_fgg .CT_TrPr };
 
+// Anchored returns the drawing as an AnchoredDrawing if it is anchored.
+func (_ggcg Drawing )Anchored ()(AnchoredDrawing ,bool ){if _ggcg ._cfga ==nil {return AnchoredDrawing {},false ;};return AnchoredDrawing {_ggcg ._gbce ,_ggcg ._cfga },true ;};
+
+// AddDateField adds a DATE field to the run. If format is not empty, it is
+// used as the date-picture switch (e.g. "MMMM d, yyyy").
+func (_baecc Run )AddDateField (format string ){_baecc .AddFieldWithFormatting (FieldDate ,_dfcfe (format ),true );};
+
 // Color returns the style's Color.
 func (_gdfe RunProperties )Color ()Color {if _gdfe ._bfbg .Color ==nil {_gdfe ._bfbg .Color =_fgg .NewCT_Color ();};return Color {_gdfe ._bfbg .Color };};
 
@@ -542,6 +920,14 @@ func (_gdcc RunProperties )Outline ()bool {return _aeege (_gdcc ._bfbg .Outline
 // Settings controls the document settings.
 type Settings struct{_efag *_fgg .Settings };
 
+// SetTextWrapBehindText places the drawing behind the text without wrapping
+// the text around it, as used for watermarks and background images.
+func (_bedfc AnchoredDrawing )SetTextWrapBehindText (){_bedfc ._gd .Choice =&_fgg .WdEG_WrapTypeChoice {};_bedfc ._gd .Choice .WrapNone =_fgg .NewWdCT_WrapNone ();_bedfc ._gd .BehindDocAttr =true ;};
+
+// setRPrExtra replaces the extension element named name in extra with el,
+// keeping the elements in schema order.  A nil el removes the element.
+func _afced (_dfebf []_c .Any ,_acag string ,_ccebc _c .Any )[]_c .Any {_cbcfd :=[]_c .Any {};for _ ,_egac :=range _dfebf {if _gbbdg (_egac )!=_acag {_cbcfd =append (_cbcfd ,_egac );};};if _ccebc ==nil {return _cbcfd ;};_gegaa :=len (_cbcfd );for _aadda ,_abcef :=range _cbcfd {if _fagef (_gbbdg (_abcef ))> _fagef (_acag ){_gegaa =_aadda ;break ;};};_cbcfd =append (_cbcfd ,nil );copy (_cbcfd [_gegaa +1:],_cbcfd [_gegaa :]);_cbcfd [_gegaa ]=_ccebc ;return _cbcfd ;};const _cdcb ="\u0068\u0074\u0074p\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006d\u0069\u0063r\u006f\u0073\u006f\u0066\u0074\u002e\u0063o\u006d\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u002f\u0077\u006f\u0072\u0064\u002f\u0032\u0030\u0031\u0030/\u0077\u006fr\u0064\u006d\u006c";func _fbae (_gdbf string ,_fedgf ...string )*_c .XSDAny {_fagcb :=&_c .XSDAny {XMLName :_fda .Name {Local :"\u0077\u0031\u0034\u003a"+_gdbf }};for _bgeff :=0;_bgeff +1< len (_fedgf );_bgeff +=2{_fagcb .Attrs =append (_fagcb .Attrs ,_fda .Attr {Name :_fda .Name {Local :"\u0077\u0031\u0034\u003a"+_fedgf [_bgeff ]},Value :_fedgf [_bgeff +1]});};return _fagcb ;};func (_edba Run )setW14 (_cef string ,_adgg *_c .XSDAny ){if _adgg ==nil {if _edba ._bfbb .RPr !=nil {_edba ._bfbb .RPr .Extra =_afced (_edba ._bfbb .RPr .Extra ,_cef ,nil );_edba .removeEmptyRPr ();};return ;};_adgg .Attrs =append ([]_fda .Attr {{Name :_fda .Name {Local :"\u0078\u006d\u006c\u006e\u0073:\u0077\u0031\u0034"},Value :_cdcb }},_adgg .Attrs ...);_cfgc :=_edba .Properties ().X ();_cfgc .Extra =_afced (_cfgc .Extra ,_cef ,_adgg );};
+
 // SetAlignment sets the alignment of a table within the page.
 func (_ceafd TableProperties )SetAlignment (align _fgg .ST_JcTable ){if align ==_fgg .ST_JcTableUnset {_ceafd ._caea .Jc =nil ;}else {_ceafd ._caea .Jc =_fgg .NewCT_JcTable ();_ceafd ._caea .Jc .ValAttr =align ;};};
 
@@ -557,6 +943,12 @@ func (_cdaeb Styles )Clear (){_cdaeb ._gee .DocDefaults =nil ;_cdaeb ._gee .Late
 // InsertRowAfter inserts a row after another row
 func (_fdbe Table )InsertRowAfter (r Row )Row {for _aegf ,_gbedg :=range _fdbe ._gaec .EG_ContentRowContent {if len (_gbedg .Tr )> 0&&r .X ()==_gbedg .Tr [0]{_dee :=_fgg .NewEG_ContentRowContent ();if len (_fdbe ._gaec .EG_ContentRowContent )< _aegf +2{return _fdbe .AddRow ();};_fdbe ._gaec .EG_ContentRowContent =append (_fdbe ._gaec .EG_ContentRowContent ,nil );copy (_fdbe ._gaec .EG_ContentRowContent [_aegf +2:],_fdbe ._gaec .EG_ContentRowContent [_aegf +1:]);_fdbe ._gaec .EG_ContentRowContent [_aegf +1]=_dee ;_feee :=_fgg .NewCT_Row ();_dee .Tr =append (_dee .Tr ,_feee );return Row {_fdbe ._gcfe ,_feee };};};return _fdbe .AddRow ();};
 
+// AddDrawingAnchoredSVG adds an anchored (floating) drawing that displays the
+// SVG image svg. The raster image fallback is displayed by applications that
+// don't support SVG. The SVG image must be added to the document with
+// AddImage using the format "svg" and its size in pixels.
+func (_fdgg Run )AddDrawingAnchoredSVG (svg ,fallback _aeb .ImageRef )(AnchoredDrawing ,error ){if svg .Format ()!="\u0073\u0076\u0067"{return AnchoredDrawing {},_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020i\u0073 no\u0074\u0020\u0061\u006e\u0020\u0053\u0056G\u0020\u0069\u006d\u0061g\u0065");};if _fdgg ._adbf ==nil {return AnchoredDrawing {},_ef .New ("\u0072\u0075\u006e\u0020m\u0075\u0073\u0074\u0020\u0062\u0065\u006c\u006fn\u0067\u0020\u0074\u006f\u0020\u0061\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074") ;};if !_fdgg ._adbf .hasImage (svg ){return AnchoredDrawing {},ErrImageNotFound ;};if svg .RelID ()==""{return AnchoredDrawing {},ErrImageRelationMissing ;};_ddbag ,_cebfb :=_fdgg .AddDrawingAnchored (fallback );if _cebfb !=nil {return _ddbag ,_cebfb ;};for _ ,_dbdf :=range _ddbag ._gd .Graphic .GraphicData .Any {if _cbdgc ,_bfbf :=_dbdf .(*_cde .Pic );_bfbf &&_cbdgc .BlipFill !=nil &&_cbdgc .BlipFill .Blip !=nil {_dadff (_cbdgc .BlipFill .Blip ,svg .RelID ());};};return _ddbag ,nil ;};func _dadff (_cecf *_ed .CT_Blip ,_fffc string ){if _cecf .ExtLst ==nil {_cecf .ExtLst =_ed .NewCT_OfficeArtExtensionList ();};_fccc :=_ed .NewCT_OfficeArtExtension ();_fccc .UriAttr ="\u007b\u0039\u0036\u0044\u0041\u0043\u0035\u0034\u0031\u002d\u0037\u0042\u0037A\u002d\u0034\u0033\u0044\u0033\u002d\u0038\u0042\u0037\u0039\u002d\u0033\u0037\u0044\u0036\u0033\u0033\u0042\u00384\u0036\u0046\u0031\u007d";_fccc .Any =append (_fccc .Any ,&_c .XSDAny {XMLName :_fda .Name {Local :"\u0061\u0073\u0076\u0067\u003a\u0073\u0076\u0067\u0042\u006c\u0069\u0070"},Attrs :[]_fda .Attr {{Name :_fda .Name {Local :"\u0078\u006dl\u006e\u0073\u003a\u0061\u0073\u0076\u0067"},Value :"\u0068\u0074tp\u003a\u002f\u002f\u0073\u0063h\u0065\u006d\u0061s\u002e\u006d\u0069\u0063\u0072\u006f\u0073\u006f\u0066t\u002e\u0063\u006f\u006d\u002f\u006f\u0066fi\u0063\u0065\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u002f\u0032\u0030\u00316\u002f\u0053\u0056\u0047\u002f\u006d\u0061i\u006e"},{Name :_fda .Name {Local :"\u0072\u003a\u0065\u006d\u0062\u0065\u0064"},Value :_fffc },},});_cecf .ExtLst .Ext =append (_cecf .ExtLst .Ext ,_fccc );};
+
 // DoubleStrike returns true if paragraph is double striked.
 func (_fcaae ParagraphProperties )DoubleStrike ()bool {return _aeege (_fcaae ._fdfc .RPr .Dstrike )};
 
@@ -566,15 +958,50 @@ type Cell struct{_bcc *Document ;_gf *_fgg .CT_Tc ;};
 // AddLevel adds a new numbering level to a NumberingDefinition.
 func (_fcdf NumberingDefinition )AddLevel ()NumberingLevel {_bcfbc :=_fgg .NewCT_Lvl ();_bcfbc .Start =&_fgg .CT_DecimalNumber {ValAttr :1};_bcfbc .IlvlAttr =int64 (len (_fcdf ._ddfb .Lvl ));_fcdf ._ddfb .Lvl =append (_fcdf ._ddfb .Lvl ,_bcfbc );return NumberingLevel {_bcfbc };};
 
+// toggleProperties returns the toggle properties of rpr, whose values from
+// different style types are combined instead of overriding each other.
+func _ecade (_egacf *_fgg .CT_RPr )[]**_fgg .CT_OnOff {return []**_fgg .CT_OnOff {&_egacf .B ,&_egacf .BCs ,&_egacf .Caps ,&_egacf .Emboss ,&_egacf .I ,&_egacf .ICs ,&_egacf .Imprint ,&_egacf .Outline ,&_egacf .Shadow ,&_egacf .SmallCaps ,&_egacf .Strike ,&_egacf .Vanish };};
+
+// ErrImageRelationMissing is returned when a drawing is added for an image
+// that has no relationship ID within the document relations.
+var ErrImageRelationMissing =_ef .New ("\u0063\u006f\u0075\u006c\u0064\u006e\u0027\u0074\u0020\u0066\u0069\u006e\u0064\u0020\u0072\u0065\u0066\u0065\u0072\u0065n\u0063\u0065\u0020\u0074\u006f\u0020\u0069\u006d\u0061g\u0065\u0020\u0077\u0069\u0074\u0068\u0069\u006e\u0020\u0064\u006f\u0063\u0075m\u0065\u006e\u0074\u0020\u0072\u0065l\u0061\u0074\u0069o\u006e\u0073");func (_fgadf *Document )hasImage (_baf _aeb .ImageRef )bool {return _fgadf .imageIndex (_baf )>=0;};
+
 // Validate validates the structure and in cases where it't possible, the ranges
 // of elements within a document. A validation error dones't mean that the
 // document won't work in MS Word or LibreOffice, but it's worth checking into.
-func (_gec *Document )Validate ()error {if _gec ==nil ||_gec ._cdaa ==nil {return _ef .New ("\u0064o\u0063\u0075m\u0065\u006e\u0074\u0020n\u006f\u0074\u0020i\u006e\u0069\u0074\u0069\u0061\u006c\u0069\u007a\u0065d \u0063\u006f\u0072r\u0065\u0063t\u006c\u0079\u002c\u0020\u006e\u0069l\u0020\u0062a\u0073\u0065");};for _ ,_bfbc :=range []func ()error {_gec .validateTableCells ,_gec .validateBookmarks }{if _agg :=_bfbc ();_agg !=nil {return _agg ;};};if _fbgg :=_gec ._cdaa .Validate ();_fbgg !=nil {return _fbgg ;};return nil ;};func (_fba *Document )addCustomRelationships (){_fba .ContentTypes .AddOverride ("/\u0064o\u0063\u0050\u0072\u006f\u0070\u0073\u002f\u0063u\u0073\u0074\u006f\u006d.x\u006d\u006c","\u0061\u0070\u0070\u006c\u0069\u0063a\u0074\u0069\u006f\u006e\u002fv\u006e\u0064\u002e\u006f\u0070\u0065n\u0078\u006d\u006c\u0066\u006fr\u006d\u0061\u0074\u0073\u002d\u006f\u0066\u0066\u0069\u0063\u0065\u0064o\u0063\u0075\u006d\u0065\u006e\u0074\u002e\u0063\u0075\u0073\u0074\u006f\u006d\u002d\u0070r\u006f\u0070\u0065\u0072\u0074\u0069\u0065\u0073+\u0078\u006d\u006c");_fba .Rels .AddRelationship ("\u0064\u006f\u0063\u0050ro\u0070\u0073\u002f\u0063\u0075\u0073\u0074\u006f\u006d\u002e\u0078\u006d\u006c",_c .CustomPropertiesType );};
+func (_gec *Document )Validate ()error {if _gec ==nil ||_gec ._cdaa ==nil {return _ef .New ("\u0064o\u0063\u0075m\u0065\u006e\u0074\u0020n\u006f\u0074\u0020i\u006e\u0069\u0074\u0069\u0061\u006c\u0069\u007a\u0065d \u0063\u006f\u0072r\u0065\u0063t\u006c\u0079\u002c\u0020\u006e\u0069l\u0020\u0062a\u0073\u0065");};for _ ,_bfbc :=range []func ()error {_gec .validateTableCells ,_gec .validateBookmarks ,_gec .validateImageRels }{if _agg :=_bfbc ();_agg !=nil {return _agg ;};};if _fbgg :=_gec ._cdaa .Validate ();_fbgg !=nil {return _fbgg ;};return nil ;};func (_fba *Document )addCustomRelationships (){_fba .ContentTypes .AddOverride ("/\u0064o\u0063\u0050\u0072\u006f\u0070\u0073\u002f\u0063u\u0073\u0074\u006f\u006d.x\u006d\u006c","\u0061\u0070\u0070\u006c\u0069\u0063a\u0074\u0069\u006f\u006e\u002fv\u006e\u0064\u002e\u006f\u0070\u0065n\u0078\u006d\u006c\u0066\u006fr\u006d\u0061\u0074\u0073\u002d\u006f\u0066\u0066\u0069\u0063\u0065\u0064o\u0063\u0075\u006d\u0065\u006e\u0074\u002e\u0063\u0075\u0073\u0074\u006f\u006d\u002d\u0070r\u006f\u0070\u0065\u0072\u0074\u0069\u0065\u0073+\u0078\u006d\u006c");_fba .Rels .AddRelationship ("\u0064\u006f\u0063\u0050ro\u0070\u0073\u002f\u0063\u0075\u0073\u0074\u006f\u006d\u002e\u0078\u006d\u006c",_c .CustomPropertiesType );};
+
+// AddEmbeddedObject embeds the OLE object data (e.g. the content of a
+// spreadsheet or a PDF packaged as an OLE object) in the run.  The object is
+// displayed as the icon image, which must have been added to the document with
+// AddImage, or with Header.AddImage or Footer.AddImage if the run is part of
+// a header or footer.  The progID identifies the application that handles the
+// object (e.g. "Excel.Sheet.12" or "Package").
+func (_befae Run )AddEmbeddedObject (data []byte ,progID string ,icon _aeb .ImageRef )error {if len (data )==0{return _cf .Errorf ("\u0065\u006d\u0062e\u0064\u0064e\u0064 \u006f\u0062\u006a\u0065\u0063\u0074 \u0064\u0061\u0074\u0061\u0020\u006d\u0075\u0073\u0074\u0020\u006e\u006f\u0074\u0020b\u0065\u0020\u0065\u006d\u0070\u0074\u0079");};if progID ==""{return _cf .Errorf ("\u0065\u006d\u0062\u0065\u0064\u0064\u0065\u0064\u0020ob\u006a\u0065\u0063\u0074\u0020\u0072\u0065\u0071\u0075\u0069\u0072\u0065\u0073\u0020\u0061\u0020\u0050\u0072\u006fg\u0049D");};_bdfd :=Run {_befae ._adbf ,_fgg .NewCT_R ()};if _ ,_acac :=_bdfd .AddDrawingInline (icon );_acac !=nil {return _acac ;};_gea ,_eaece :=_befae ._adbf .writeTempPart (data ,"\u006f\u006c\u0065\u004f\u0062j\u0065\u0063\u0074");if _eaece !=nil {return _eaece ;};_ffde :=1;for _ ,_cecdg :=range _befae ._adbf .ExtraFiles {if _a .HasPrefix (_cecdg .ZipPath ,"\u0077\u006frd\u002fe\u006d\u0062\u0065\u0064\u0064\u0069\u006e\u0067\u0073\u002f\u006f\u006c\u0065\u004f\u0062\u006a\u0065\u0063\u0074"){_ffde ++;};};_bacgd :=_cf .Sprintf ("\u0065m\u0062\u0065\u0064\u0064\u0069n\u0067\u0073\u002f\u006f\u006c\u0065\u004f\u0062\u006a\u0065\u0063\u0074\u0025\u0064\u002e\u0062\u0069\u006e",_ffde );_befae ._adbf .ExtraFiles =append (_befae ._adbf .ExtraFiles ,_aeb .ExtraFile {ZipPath :"\u0077\u006fr\u0064\u002f"+_bacgd ,DiskPath :_gea });_befae ._adbf .ContentTypes .EnsureDefault ("\u0062\u0069\u006e","\u0061\u0070\u0070\u006c\u0069\u0063\u0061\u0074\u0069\u006f\u006e\u002f\u0076\u006e\u0064\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002d\u006f\u0066\u0066\u0069\u0063\u0065\u0064\u006f\u0063\u0075m\u0065\u006e\u0074\u002e\u006f\u006c\u0065\u004f\u0062\u006a\u0065\u0063\u0074");_fgcf :=_befae ._adbf .relsOfRun (_befae ).AddRelationship (_bacgd ,_egaa );_ffeg :=_befae .newIC ();_ffeg .Object =_fgg .NewCT_Object ();_ffeg .Object .Drawing =_bdfd ._bfbb .EG_RunInnerContent [0].Drawing ;_ffeg .Object .Choice =_fgg .NewCT_ObjectChoice ();_ffeg .Object .Choice .ObjectEmbed =_fgg .NewCT_ObjectEmbed ();_ffeg .Object .Choice .ObjectEmbed .DrawAspectAttr =_fgg .ST_ObjectDrawAspectIcon ;_ffeg .Object .Choice .ObjectEmbed .IdAttr =_fgcf .ID ();_ffeg .Object .Choice .ObjectEmbed .ProgIdAttr =_c .String (progID );return nil ;};
+
+// runFontName returns the name of the Latin font of the run properties,
+// resolving theme fonts using the document theme.
+func (_gdcf *Document )runFontName (_fabe RunProperties )string {if _agcbc :=_fabe .Font ();_agcbc !=""{return _agcbc ;};_ddgad :=_fabe .X ().RFonts ;if _ddgad ==nil {return "";};_badba :=_ddgad .AsciiThemeAttr ==_fgg .ST_ThemeMajorAscii ||_ddgad .AsciiThemeAttr ==_fgg .ST_ThemeMajorHAnsi ;_cedbb :=_ddgad .AsciiThemeAttr ==_fgg .ST_ThemeMinorAscii ||_ddgad .AsciiThemeAttr ==_fgg .ST_ThemeMinorHAnsi ;if !_badba &&!_cedbb {return "";};if _gdcf !=nil {for _ ,_febeg :=range _gdcf ._fae {if _febeg .ThemeElements ==nil ||_febeg .ThemeElements .FontScheme ==nil {continue ;};_cbce :=_febeg .ThemeElements .FontScheme .MinorFont ;if _badba {_cbce =_febeg .ThemeElements .FontScheme .MajorFont ;};if _cbce !=nil &&_cbce .Latin !=nil &&_cbce .Latin .TypefaceAttr !=""{return _cbce .Latin .TypefaceAttr ;};};};if _badba {return "\u0043\u0061lib\u0072\u0069\u0020L\u0069\u0067h\u0074";};return "\u0043\u0061\u006c\u0069\u0062\u0072\u0069";};
+
+// AddTabs adds n tabs to the run, e.g. to move text past several of the
+// paragraph's tab stops.  Nothing is added if n isn't positive.
+func (_cbfed Run )AddTabs (n int ){for _baegb :=0;_baegb < n ;_baegb ++{_cbfed .AddTab ();};};
 
 // SetStyle sets the style of a paragraph and is identical to setting it on the
 // paragraph's Properties()
 func (_cacd Paragraph )SetStyle (s string ){_cacd .ensurePPr ();if s ==""{_cacd ._cfdb .PPr .PStyle =nil ;}else {_cacd ._cfdb .PPr .PStyle =_fgg .NewCT_String ();_cacd ._cfdb .PPr .PStyle .ValAttr =s ;};};
 
+// SetFontSizePoints sets the font size of the run in points, e.g. 12 for a 12pt
+// font.  Word stores font sizes in half points, so the size is rounded down to
+// the nearest half point.  A size of zero removes the font size so that the
+// run inherits its size.
+func (_gaggc Run )SetFontSizePoints (pts float64 )Run {_gaggc .Properties ().SetSize (_ce .Distance (pts )*_ce .Point );return _gaggc ;};
+
+// SetFontFamilyAll sets the Ascii, HAnsi, EastAsia and complex script font
+// family of the run. Unlike SetFontFamily, right-to-left and other complex
+// script text uses family too.
+func (_bdfc Run )SetFontFamilyAll (family string )Run {_bdfc .Properties ().SetFontFamilyAll (family );return _bdfc ;};
+
 // Style returns the style for a paragraph, or an empty string if it is unset.
 func (_bccb ParagraphProperties )Style ()string {if _bccb ._fdfc .PStyle !=nil {return _bccb ._fdfc .PStyle .ValAttr ;};return "";};
 
@@ -587,6 +1014,10 @@ func (_edbf Footnote )Paragraphs ()[]Paragraph {_dagf :=[]Paragraph {};for _ ,_c
 // Endnotes returns the endnotes defined in the document.
 func (_gdee *Document )Endnotes ()[]Endnote {_cgda :=[]Endnote {};for _ ,_caabg :=range _gdee ._acd .CT_Endnotes .Endnote {_cgda =append (_cgda ,Endnote {_gdee ,_caabg });};return _cgda ;};
 
+// paragraphOfRun returns the paragraph in the document body, headers or
+// footers that contains the run.
+func (_abbfg *Document )paragraphOfRun (_dfdbg *_fgg .CT_R )(Paragraph ,bool ){for _ ,_cfcd :=range _abbfg .allParagraphs (){for _ ,_abag :=range _cfcd .allRuns (){if _abag ._bfbb ==_dfdbg {return _cfcd ,true ;};};};return Paragraph {},false ;};
+
 // SetContextualSpacing controls whether to Ignore Spacing Above and Below When
 // Using Identical Styles
 func (_fbb ParagraphStyleProperties )SetContextualSpacing (b bool ){if !b {_fbb ._bgca .ContextualSpacing =nil ;}else {_fbb ._bgca .ContextualSpacing =_fgg .NewCT_OnOff ();};};
@@ -594,28 +1025,69 @@ func (_fbb ParagraphStyleProperties )SetContextualSpacing (b bool ){if !b {_fbb
 // SetSpacing sets the spacing that comes before and after the paragraph.
 func (_ccfbd ParagraphStyleProperties )SetSpacing (before ,after _ce .Distance ){if _ccfbd ._bgca .Spacing ==nil {_ccfbd ._bgca .Spacing =_fgg .NewCT_Spacing ();};if before ==_ce .Zero {_ccfbd ._bgca .Spacing .BeforeAttr =nil ;}else {_ccfbd ._bgca .Spacing .BeforeAttr =&_fg .ST_TwipsMeasure {};_ccfbd ._bgca .Spacing .BeforeAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (before /_ce .Twips ));};if after ==_ce .Zero {_ccfbd ._bgca .Spacing .AfterAttr =nil ;}else {_ccfbd ._bgca .Spacing .AfterAttr =&_fg .ST_TwipsMeasure {};_ccfbd ._bgca .Spacing .AfterAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (after /_ce .Twips ));};};
 
+// relsOfHeader returns the relationships of the header part.
+func (_cbcdb *Document )relsOfHeader (_cdgg Header )_aeb .Relationships {for _ddca ,_caebg :=range _cbcdb ._fbc {if _caebg ==_cdgg ._fcad &&_ddca < len (_cbcdb ._ff ){return _cbcdb ._ff [_ddca ];};};return _aeb .NewRelationships ();};
+
 // Endnote returns the endnote based on the ID; this can be used nicely with
 // the run.IsEndnote() functionality.
 func (_geg *Document )Endnote (id int64 )Endnote {for _ ,_babe :=range _geg .Endnotes (){if _babe .id ()==id {return _babe ;};};return Endnote {};};func (_eadg Styles )initializeStyleDefaults (){_cecg :=_eadg .AddStyle ("\u004e\u006f\u0072\u006d\u0061\u006c",_fgg .ST_StyleTypeParagraph ,true );_cecg .SetName ("\u004e\u006f\u0072\u006d\u0061\u006c");_cecg .SetPrimaryStyle (true );_fgae :=_eadg .AddStyle ("D\u0065f\u0061\u0075\u006c\u0074\u0050\u0061\u0072\u0061g\u0072\u0061\u0070\u0068Fo\u006e\u0074",_fgg .ST_StyleTypeCharacter ,true );_fgae .SetName ("\u0044\u0065\u0066\u0061ul\u0074\u0020\u0050\u0061\u0072\u0061\u0067\u0072\u0061\u0070\u0068\u0020\u0046\u006fn\u0074");_fgae .SetUISortOrder (1);_fgae .SetSemiHidden (true );_fgae .SetUnhideWhenUsed (true );_cdcgf :=_eadg .AddStyle ("\u0054i\u0074\u006c\u0065\u0043\u0068\u0061r",_fgg .ST_StyleTypeCharacter ,false );_cdcgf .SetName ("\u0054\u0069\u0074\u006c\u0065\u0020\u0043\u0068\u0061\u0072");_cdcgf .SetBasedOn (_fgae .StyleID ());_cdcgf .SetLinkedStyle ("\u0054\u0069\u0074l\u0065");_cdcgf .SetUISortOrder (10);_cdcgf .RunProperties ().Fonts ().SetASCIITheme (_fgg .ST_ThemeMajorAscii );_cdcgf .RunProperties ().Fonts ().SetEastAsiaTheme (_fgg .ST_ThemeMajorEastAsia );_cdcgf .RunProperties ().Fonts ().SetHANSITheme (_fgg .ST_ThemeMajorHAnsi );_cdcgf .RunProperties ().Fonts ().SetCSTheme (_fgg .ST_ThemeMajorBidi );_cdcgf .RunProperties ().SetSize (28*_ce .Point );_cdcgf .RunProperties ().SetKerning (14*_ce .Point );_cdcgf .RunProperties ().SetCharacterSpacing (-10*_ce .Twips );_eebf :=_eadg .AddStyle ("\u0054\u0069\u0074l\u0065",_fgg .ST_StyleTypeParagraph ,false );_eebf .SetName ("\u0054\u0069\u0074l\u0065");_eebf .SetBasedOn (_cecg .StyleID ());_eebf .SetNextStyle (_cecg .StyleID ());_eebf .SetLinkedStyle (_cdcgf .StyleID ());_eebf .SetUISortOrder (10);_eebf .SetPrimaryStyle (true );_eebf .ParagraphProperties ().SetContextualSpacing (true );_eebf .RunProperties ().Fonts ().SetASCIITheme (_fgg .ST_ThemeMajorAscii );_eebf .RunProperties ().Fonts ().SetEastAsiaTheme (_fgg .ST_ThemeMajorEastAsia );_eebf .RunProperties ().Fonts ().SetHANSITheme (_fgg .ST_ThemeMajorHAnsi );_eebf .RunProperties ().Fonts ().SetCSTheme (_fgg .ST_ThemeMajorBidi );_eebf .RunProperties ().SetSize (28*_ce .Point );_eebf .RunProperties ().SetKerning (14*_ce .Point );_eebf .RunProperties ().SetCharacterSpacing (-10*_ce .Twips );_ccge :=_eadg .AddStyle ("T\u0061\u0062\u006c\u0065\u004e\u006f\u0072\u006d\u0061\u006c",_fgg .ST_StyleTypeTable ,false );_ccge .SetName ("\u004e\u006f\u0072m\u0061\u006c\u0020\u0054\u0061\u0062\u006c\u0065");_ccge .SetUISortOrder (99);_ccge .SetSemiHidden (true );_ccge .SetUnhideWhenUsed (true );_ccge .X ().TblPr =_fgg .NewCT_TblPrBase ();_bfbee :=NewTableWidth ();_ccge .X ().TblPr .TblInd =_bfbee .X ();_bfbee .SetValue (0*_ce .Dxa );_ccge .X ().TblPr .TblCellMar =_fgg .NewCT_TblCellMar ();_bfbee =NewTableWidth ();_ccge .X ().TblPr .TblCellMar .Top =_bfbee .X ();_bfbee .SetValue (0*_ce .Dxa );_bfbee =NewTableWidth ();_ccge .X ().TblPr .TblCellMar .Bottom =_bfbee .X ();_bfbee .SetValue (0*_ce .Dxa );_bfbee =NewTableWidth ();_ccge .X ().TblPr .TblCellMar .Left =_bfbee .X ();_bfbee .SetValue (108*_ce .Dxa );_bfbee =NewTableWidth ();_ccge .X ().TblPr .TblCellMar .Right =_bfbee .X ();_bfbee .SetValue (108*_ce .Dxa );_abfce :=_eadg .AddStyle ("\u004e\u006f\u004c\u0069\u0073\u0074",_fgg .ST_StyleTypeNumbering ,false );_abfce .SetName ("\u004eo\u0020\u004c\u0069\u0073\u0074");_abfce .SetUISortOrder (1);_abfce .SetSemiHidden (true );_abfce .SetUnhideWhenUsed (true );_bffg :=[]_ce .Distance {16,13,12,11,11,11,11,11,11};_gcca :=[]_ce .Distance {240,40,40,40,40,40,40,40,40};for _bggdb :=0;_bggdb < 9;_bggdb ++{_fabd :=_cf .Sprintf ("\u0048e\u0061\u0064\u0069\u006e\u0067\u0025d",_bggdb +1);_abga :=_eadg .AddStyle (_fabd +"\u0043\u0068\u0061\u0072",_fgg .ST_StyleTypeCharacter ,false );_abga .SetName (_cf .Sprintf ("\u0048e\u0061d\u0069\u006e\u0067\u0020\u0025\u0064\u0020\u0043\u0068\u0061\u0072",_bggdb +1));_abga .SetBasedOn (_fgae .StyleID ());_abga .SetLinkedStyle (_fabd );_abga .SetUISortOrder (9+_bggdb );_abga .RunProperties ().SetSize (_bffg [_bggdb ]*_ce .Point );_gceb :=_eadg .AddStyle (_fabd ,_fgg .ST_StyleTypeParagraph ,false );_gceb .SetName (_cf .Sprintf ("\u0068\u0065\u0061\u0064\u0069\u006e\u0067\u0020\u0025\u0064",_bggdb +1));_gceb .SetNextStyle (_cecg .StyleID ());_gceb .SetLinkedStyle (_gceb .StyleID ());_gceb .SetUISortOrder (9+_bggdb );_gceb .SetPrimaryStyle (true );_gceb .ParagraphProperties ().SetKeepNext (true );_gceb .ParagraphProperties ().SetSpacing (_gcca [_bggdb ]*_ce .Twips ,0);_gceb .ParagraphProperties ().SetOutlineLevel (_bggdb );_gceb .RunProperties ().SetSize (_bffg [_bggdb ]*_ce .Point );};};
 
+// isRunHidden returns true if the run within the paragraph is hidden, either
+// by direct formatting or through the document defaults and styles.
+func (_fcfac *Document )isRunHidden (_cgbce Paragraph ,_dabcd Run )bool {if _dabcd ._bfbb .RPr !=nil &&_dabcd ._bfbb .RPr .Vanish !=nil {return _aeege (_dabcd ._bfbb .RPr .Vanish );};_eebdd :=_fcfac .Styles ._gee ;if _eebdd ==nil {return false ;};_beafc :=_fgg .NewCT_RPr ();if _eebdd .DocDefaults !=nil &&_eebdd .DocDefaults .RPrDefault !=nil &&_eebdd .DocDefaults .RPrDefault .RPr !=nil {_cbbc (_beafc ,_eebdd .DocDefaults .RPrDefault .RPr );};_eagde ,_ecebc :="","";if _cgbce ._cfdb .PPr !=nil &&_cgbce ._cfdb .PPr .PStyle !=nil {_eagde =_cgbce ._cfdb .PPr .PStyle .ValAttr ;};if _dabcd ._bfbb .RPr !=nil &&_dabcd ._bfbb .RPr .RStyle !=nil {_ecebc =_dabcd ._bfbb .RPr .RStyle .ValAttr ;};_abdec (_beafc ,_eebdd ,_eagde ,_fgg .ST_StyleTypeParagraph );_abdec (_beafc ,_eebdd ,_ecebc ,_fgg .ST_StyleTypeCharacter );return _aeege (_beafc .Vanish );};
+
 // InlineDrawing is an inlined image within a run.
 type InlineDrawing struct{_febe *Document ;_dafe *_fgg .WdInline ;};
 
+// Size returns the displayed size of the drawing.
+func (_bfefa Drawing )Size ()(w ,h _ce .Distance ){var _gddea ,_egbc int64 ;if _bfefa ._cfga !=nil &&_bfefa ._cfga .Extent !=nil {_gddea ,_egbc =_bfefa ._cfga .Extent .CxAttr ,_bfefa ._cfga .Extent .CyAttr ;}else if _bfefa ._eff !=nil &&_bfefa ._eff .Extent !=nil {_gddea ,_egbc =_bfefa ._eff .Extent .CxAttr ,_bfefa ._eff .Extent .CyAttr ;};return _ce .Distance (_gddea )*_ce .EMU ,_ce .Distance (_egbc )*_ce .EMU ;};
+
 // Styles is the document wide styles contained in styles.xml.
 type Styles struct{_gee *_fgg .Styles };
 
+// AddPlainRun adds a run containing text without any run properties to the
+// paragraph. It is equivalent to calling AddRun followed by AddText, but
+// avoids extra allocations when generating large numbers of unformatted runs.
+func (_eadfc Paragraph )AddPlainRun (text string )Run {_bfa :=&_fgg .CT_R {EG_RunInnerContent :make ([]*_fgg .EG_RunInnerContent ,0,1)};_eadfc ._cfdb .EG_PContent =append (_eadfc ._cfdb .EG_PContent ,&_fgg .EG_PContent {EG_ContentRunContent :[]*_fgg .EG_ContentRunContent {{R :_bfa }}});_eaeb :=Run {_eadfc ._eecc ,_bfa };_eaeb .AddText (text );return _eaeb ;};
+
+// SetMarkRunProperties sets the formatting of the paragraph mark to a copy of
+// the given run properties.  Matching the paragraph mark formatting to the
+// surrounding text keeps line heights consistent.
+func (_fcbg Paragraph )SetMarkRunProperties (rp RunProperties ){_fcbg .ensurePPr ();if rp ._bfbg ==nil {_fcbg ._cfdb .PPr .RPr =nil ;return ;};_cfgba :=_fgg .NewCT_ParaRPr ();if _dggba :=_eedf (_cfgba ,rp ._bfbg ,"\u0077\u003a\u0072\u0050\u0072");_dggba !=nil {return ;};_fcbg ._cfdb .PPr .RPr =_cfgba ;};
+
+// AddSquareRoot adds a square root, which is a radical without a displayed
+// degree, returning the expression under the radical sign.
+func (_cgbe MathArgument )AddSquareRoot ()MathArgument {_ ,_bgbca :=_cgbe .AddRadical ();_gcd :=(*_cgbe ._bdeb )[len (*_cgbe ._bdeb )-1].Rad ;_gcd .RadPr =_age .NewCT_RadPr ();_gcd .RadPr .DegHide =_age .NewCT_OnOff ();return _bgbca ;};
+
 // AddImage adds an image to the document package, returning a reference that
 // can be used to add the image to a run and place it in the document contents.
 func (_dea Header )AddImage (i _aeb .Image )(_aeb .ImageRef ,error ){var _egdg _aeb .Relationships ;for _gbab ,_fegcc :=range _dea ._gdd ._fbc {if _fegcc ==_dea ._fcad {_egdg =_dea ._gdd ._ff [_gbab ];};};_ggad :=_aeb .MakeImageRef (i ,&_dea ._gdd .DocBase ,_egdg );if i .Data ==nil &&i .Path ==""{return _ggad ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0064\u0061t\u0061\u0020\u006f\u0072\u0020\u0061\u0020\u0070\u0061\u0074\u0068");};if i .Format ==""{return _ggad ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0061\u0020v\u0061\u006c\u0069\u0064\u0020\u0066\u006f\u0072\u006d\u0061\u0074");};if i .Size .X ==0||i .Size .Y ==0{return _ggad ,_ef .New ("\u0069\u006d\u0061\u0067e\u0020\u006d\u0075\u0073\u0074\u0020\u0068\u0061\u0076\u0065 \u0061 \u0076\u0061\u006c\u0069\u0064\u0020\u0073i\u007a\u0065");};_dea ._gdd .Images =append (_dea ._gdd .Images ,_ggad );_dbga :=_cf .Sprintf ("\u006d\u0065d\u0069\u0061\u002fi\u006d\u0061\u0067\u0065\u0025\u0064\u002e\u0025\u0073",len (_dea ._gdd .Images ),i .Format );_bege :=_egdg .AddRelationship (_dbga ,_c .ImageType );_ggad .SetRelID (_bege .X ().IdAttr );return _ggad ,nil ;};
 
+// AddTextAutoLink adds text to the run, converting any URLs and email
+// addresses found in the text into hyperlinks. The hyperlinks and any text
+// following them are added to the paragraph as new runs directly after this
+// run, copying its formatting. If the run is not within the document body, the
+// text is added without hyperlinks.
+func (_agad Run )AddTextAutoLink (s string ){_afegb :=_gddgf .FindAllStringIndex (s ,-1);if _agad ._adbf ==nil {_agad .AddText (s );return ;};_egedf ,_aaag ,_agb ,_bcfda :=_agad ._adbf .findRun (_agad ._bfbb );if len (_afegb )==0||!_bcfda {_agad .AddText (s );return ;};_fggce :=func ()Run {_gfe :=Run {_agad ._adbf ,_fgg .NewCT_R ()};_gfe ._bfbb .RPr =_edfga (_agad ._bfbb .RPr );return _gfe ;};_ccbg :=[]*_fgg .EG_PContent {};_befd :=func (_gacgf string ){if _gacgf ==""{return ;};if len (_ccbg )==0{_agad .AddText (_gacgf );return ;};_eafb :=_fgg .NewEG_PContent ();_defdf :=_fgg .NewEG_ContentRunContent ();_gggd :=_fggce ();_defdf .R =_gggd ._bfbb ;_gggd .AddText (_gacgf );_eafb .EG_ContentRunContent =append (_eafb .EG_ContentRunContent ,_defdf );_ccbg =append (_ccbg ,_eafb );};_abff :=0;for _ ,_dbfa :=range _afegb {_acde :=_a .TrimRight (s [_dbfa [0]:_dbfa [1]],"\u002e,\u003b\u003a\u0021\u003f\u0029\u0027");_befd (s [_abff :_dbfa [0]]);_abff =_dbfa [0]+len (_acde );_faaf :=_acde ;_ebdgb :=_a .ToLower (_acde );if _a .HasPrefix (_ebdgb ,"w\u0077\u0077\u002e"){_faaf ="\u0068\u0074\u0074\u0070\u003a\u002f\u002f"+_acde ;}else if !_a .HasPrefix (_ebdgb ,"\u0068\u0074\u0074\u0070"){_faaf ="\u006d\u0061\u0069\u006c\u0074\u006f\u003a"+_acde ;};_fbcc :=_fgg .NewEG_PContent ();_fbcc .Hyperlink =_fgg .NewCT_Hyperlink ();_cb :=HyperLink {_agad ._adbf ,_fbcc .Hyperlink };_cb .SetTarget (_faaf );_gagdd :=_fgg .NewEG_ContentRunContent ();_cbbab :=_fggce ();_gagdd .R =_cbbab ._bfbb ;_cbbab .AddText (_acde );_fbcc .Hyperlink .EG_ContentRunContent =append (_fbcc .Hyperlink .EG_ContentRunContent ,_gagdd );_ccbg =append (_ccbg ,_fbcc );};_befd (s [_abff :]);_cbff :=_egedf .EG_PContent [_aaag ];if _agb +1< len (_cbff .EG_ContentRunContent ){_egbb :=_fgg .NewEG_PContent ();_egbb .EG_ContentRunContent =append (_egbb .EG_ContentRunContent ,_cbff .EG_ContentRunContent [_agb +1:]...);_cbff .EG_ContentRunContent =_cbff .EG_ContentRunContent [:_agb +1];_ccbg =append (_ccbg ,_egbb );};_cddff :=append ([]*_fgg .EG_PContent {},_egedf .EG_PContent [_aaag +1:]...);_egedf .EG_PContent =append (append (_egedf .EG_PContent [:_aaag +1],_ccbg ...),_cddff ...);};
+
 // SetTop sets the top border to a specified type, color and thickness.
 func (_baae TableBorders )SetTop (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_baae ._efaad .Top =_fgg .NewCT_Border ();_cafa (_baae ._efaad .Top ,t ,c ,thickness );};
 
 // CellProperties are a table cells properties within a document.
 type CellProperties struct{_egf *_fgg .CT_TcPr };
 
-// SetAllCaps sets the run to all caps.
-func (_fagc RunProperties )SetAllCaps (b bool ){if !b {_fagc ._bfbg .Caps =nil ;}else {_fagc ._bfbg .Caps =_fgg .NewCT_OnOff ();};};
+// SetAllCaps sets the run to all caps. Passing false writes an explicit
+// w:val="false" so that all caps inherited from a style is turned off.
+func (_fagc RunProperties )SetAllCaps (b bool )RunProperties {_fagc ._bfbg .Caps =_fgg .NewCT_OnOff ();if !b {_fagc ._bfbg .Caps .ValAttr =&_fg .ST_OnOff {Bool :_c .Bool (false )};};return _fagc ;};
+
+// removeEmptyRPr removes the run properties if no properties remain set so
+// that an empty <w:rPr/> element isn't written.
+func (_ebeag Run )removeEmptyRPr (){if _ebeag ._bfbb .RPr ==nil ||len (_ebeag ._bfbb .RPr .Extra )!=0{return ;};_bfd :=_ebeag ._bfbb .RPr ;if _bfd .RStyle ==nil &&_bfd .RFonts ==nil &&_bfd .B ==nil &&_bfd .BCs ==nil &&_bfd .I ==nil &&_bfd .ICs ==nil &&_bfd .Caps ==nil &&_bfd .SmallCaps ==nil &&_bfd .Strike ==nil &&_bfd .Dstrike ==nil &&_bfd .Outline ==nil &&_bfd .Shadow ==nil &&_bfd .Emboss ==nil &&_bfd .Imprint ==nil &&_bfd .NoProof ==nil &&_bfd .SnapToGrid ==nil &&_bfd .Vanish ==nil &&_bfd .WebHidden ==nil &&_bfd .Color ==nil &&_bfd .Spacing ==nil &&_bfd .W ==nil &&_bfd .Kern ==nil &&_bfd .Position ==nil &&_bfd .Sz ==nil &&_bfd .SzCs ==nil &&_bfd .Highlight ==nil &&_bfd .U ==nil &&_bfd .Effect ==nil &&_bfd .Bdr ==nil &&_bfd .Shd ==nil &&_bfd .FitText ==nil &&_bfd .VertAlign ==nil &&_bfd .Rtl ==nil &&_bfd .Cs ==nil &&_bfd .Em ==nil &&_bfd .Lang ==nil &&_bfd .EastAsianLayout ==nil &&_bfd .SpecVanish ==nil &&_bfd .OMath ==nil &&_bfd .RPrChange ==nil {_ebeag ._bfbb .RPr =nil ;};};
+
+// SetAutoSpaceDN controls if Word automatically adjusts the spacing between
+// East Asian text and numbers in the paragraph.  The setting is written even
+// when enabled so that it overrides the paragraph style.
+func (_cfgfc Paragraph )SetAutoSpaceDN (b bool ){_cfgfc .ensurePPr ();_cfgfc ._cfdb .PPr .AutoSpaceDN =_fgg .NewCT_OnOff ();if !b {_cfgfc ._cfdb .PPr .AutoSpaceDN .ValAttr =&_fg .ST_OnOff {Bool :_c .Bool (false )};};};
 
 // Tables returns the tables defined in the document.
 func (_ceee *Document )Tables ()[]Table {_dgd :=[]Table {};if _ceee ._cdaa .Body ==nil {return nil ;};for _ ,_afc :=range _ceee ._cdaa .Body .EG_BlockLevelElts {for _ ,_eca :=range _afc .EG_ContentBlockContent {for _ ,_ddd :=range _ceee .tables (_eca ){_dgd =append (_dgd ,_ddd );};};};return _dgd ;};
@@ -623,21 +1095,41 @@ func (_ceee *Document )Tables ()[]Table {_dgd :=[]Table {};if _ceee ._cdaa .Body
 // SetFormat sets the numbering format.
 func (_ddae NumberingLevel )SetFormat (f _fgg .ST_NumberFormat ){if _ddae ._cbf .NumFmt ==nil {_ddae ._cbf .NumFmt =_fgg .NewCT_NumFmt ();};_ddae ._cbf .NumFmt .ValAttr =f ;};
 
+// SetTwoLinesInOne lays out the run text on two lines within a single line,
+// enclosed by the brackets begin and end.  Supported brackets are (), [], {}
+// and <>. Any other pair, including a mismatched pair such as ( and ] or
+// zero, isn't reported as an error and results in no brackets.
+func (_gbfaa Run )SetTwoLinesInOne (begin ,end rune )Run {_gbfaa .SetCombineCharacters (true );_ffcb :=_fgg .ST_CombineBracketsNone ;switch {case begin =='('&&end ==')':_ffcb =_fgg .ST_CombineBracketsRound ;case begin =='['&&end ==']':_ffcb =_fgg .ST_CombineBracketsSquare ;case begin =='{'&&end =='}':_ffcb =_fgg .ST_CombineBracketsCurly ;case begin =='<'&&end =='>':_ffcb =_fgg .ST_CombineBracketsAngle ;};_gbfaa ._bfbb .RPr .EastAsianLayout .CombineBracketsAttr =_ffcb ;return _gbfaa ;};
+
+// AddSuperscript adds a superscript, returning its base and superscript.
+func (_dbaca MathArgument )AddSuperscript ()(base ,sup MathArgument ){_cdgb :=_age .NewCT_SSup ();_dbaca .add ().SSup =_cdgb ;return MathArgument {&_cdgb .E .EG_OMathMathElements },MathArgument {&_cdgb .Sup .EG_OMathMathElements };};
+
+// AddRadical adds a radical, returning its degree and the expression under the
+// radical sign.
+func (_fcgab MathArgument )AddRadical ()(deg ,e MathArgument ){_bbaab :=_age .NewCT_Rad ();_fcgab .add ().Rad =_bbaab ;return MathArgument {&_bbaab .Deg .EG_OMathMathElements },MathArgument {&_bbaab .E .EG_OMathMathElements };};
+
 // IsFootnote returns a bool based on whether the run has a
 // footnote or not. Returns both a bool as to whether it has
 // a footnote as well as the ID of the footnote.
 func (_bded Run )IsFootnote ()(bool ,int64 ){if _bded ._bfbb .EG_RunInnerContent !=nil {if _bded ._bfbb .EG_RunInnerContent [0].FootnoteReference !=nil {return true ,_bded ._bfbb .EG_RunInnerContent [0].FootnoteReference .IdAttr ;};};return false ,0;};
 
+// SetTopBorder sets the top border of the paragraph.
+func (_cagee Paragraph )SetTopBorder (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_cagee .Properties ().Borders ().SetTop (t ,c ,thickness );};
+
 // Style is a style within the styles.xml file.
 type Style struct{_dedd *_fgg .CT_Style };
 
+// SetCombineCharacters controls whether the run text is combined into two
+// lines within a single line without brackets.
+func (_bbfbe Run )SetCombineCharacters (b bool )Run {if !b {if _bbfbe ._bfbb .RPr !=nil &&_bbfbe ._bfbb .RPr .EastAsianLayout !=nil {_bbfbe ._bfbb .RPr .EastAsianLayout .CombineAttr =nil ;_bbfbe ._bfbb .RPr .EastAsianLayout .CombineBracketsAttr =_fgg .ST_CombineBracketsUnset ;if _bbfbe ._bfbb .RPr .EastAsianLayout .VertAttr ==nil &&_bbfbe ._bfbb .RPr .EastAsianLayout .VertCompressAttr ==nil {_bbfbe ._bfbb .RPr .EastAsianLayout =nil ;};_bbfbe .removeEmptyRPr ();};return _bbfbe ;};_dcec :=_bbfbe .Properties ().X ();if _dcec .EastAsianLayout ==nil {_dcec .EastAsianLayout =_fgg .NewCT_EastAsianLayout ();};if _dcec .EastAsianLayout .IdAttr ==nil {_ggc :=int64 (0x7FFFFFFF&_g .Uint32 ());_dcec .EastAsianLayout .IdAttr =&_ggc ;};_dcec .EastAsianLayout .CombineAttr =&_fg .ST_OnOff {Bool :_c .Bool (true )};_dcec .EastAsianLayout .CombineBracketsAttr =_fgg .ST_CombineBracketsNone ;return _bbfbe ;};
+
 // SetChecked marks a FormFieldTypeCheckBox as checked or unchecked.
 func (_bbcee FormField )SetChecked (b bool ){if _bbcee ._edda .CheckBox ==nil {return ;};if !b {_bbcee ._edda .CheckBox .Checked =nil ;}else {_bbcee ._edda .CheckBox .Checked =_fgg .NewCT_OnOff ();};};
 
 // SetOffset sets the offset of the image relative to the origin, which by
 // default this is the top-left corner of the page. Offset is incompatible with
 // SetAlignment, whichever is called last is applied.
-func (_ac AnchoredDrawing )SetOffset (x ,y _ce .Distance ){_ac .SetXOffset (x );_ac .SetYOffset (y )};func _aafe (_ggfe *_fgg .CT_OnOff )OnOffValue {if _ggfe ==nil {return OnOffValueUnset ;};if _ggfe .ValAttr !=nil &&_ggfe .ValAttr .Bool !=nil &&*_ggfe .ValAttr .Bool ==false {return OnOffValueOff ;};return OnOffValueOn ;};
+func (_ac AnchoredDrawing )SetOffset (x ,y _ce .Distance ){_ac .SetXOffset (x );_ac .SetYOffset (y )};func _aafe (_ggfe *_fgg .CT_OnOff )OnOffValue {if _ggfe ==nil {return OnOffValueUnset ;};if _gagee :=_ggfe .ValAttr ;_gagee !=nil {if _gagee .Bool !=nil &&*_gagee .Bool ==false {return OnOffValueOff ;};if _gagee .ST_OnOff1 ==_fg .ST_OnOff1Off {return OnOffValueOff ;};};return OnOffValueOn ;};
 
 // MergeFields returns the list of all mail merge fields found in the document.
 func (_cegc Document )MergeFields ()[]string {_eebbc :=map[string ]struct{}{};for _ ,_dbcb :=range _cegc .mergeFields (){_eebbc [_dbcb ._bgcb ]=struct{}{};};_aeab :=[]string {};for _fga :=range _eebbc {_aeab =append (_aeab ,_fga );};return _aeab ;};
@@ -654,6 +1146,11 @@ func (_fdf Header )X ()*_fgg .Hdr {return _fdf ._fcad };
 // InsertRunAfter inserts a run in the paragraph after the relative run.
 func (_fge Paragraph )InsertRunAfter (relativeTo Run )Run {return _fge .insertRun (relativeTo ,false )};
 
+// SetRsidR sets the revision identifier of the editing session that added the
+// run.  The rsid must be eight hexadecimal digits (e.g. "00A1B2C3") and is
+// registered in the document settings. An empty rsid removes the identifier.
+func (_edcd Run )SetRsidR (rsid string )error {if rsid ==""{_edcd ._bfbb .RsidRAttr =nil ;return nil ;};rsid =_a .ToUpper (rsid );if len (rsid )!=8||_a .Trim (rsid ,"\u0030\u00312\u00334\u0035\u0036\u0037\u0038\u0039\u0041B\u0043\u0044\u0045\u0046")!=""{return _cf .Errorf ("\u0069\u006e\u0076\u0061\u006c\u0069\u0064\u0020\u0072\u0073\u0069\u0064\u0020\u0025\u0071,\u0020e\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0065\u0069\u0067\u0068\u0074 \u0068\u0065\u0078\u0061\u0064\u0065\u0063\u0069m\u0061\u006c\u0020\u0064\u0069\u0067\u0069\u0074\u0073",rsid );};_edcd ._bfbb .RsidRAttr =&rsid ;if _edcd ._adbf !=nil {_edcd ._adbf .Settings .addRsid (rsid );};return nil ;};
+
 // Read reads a document from an io.Reader.
 func Read (r _ae .ReaderAt ,size int64 )(*Document ,error ){_efc :=New ();_efc .Numbering ._fdda =nil ;_bgad ,_fafd :=_aebc .TempDir ("\u0075\u006e\u0069\u006f\u0066\u0066\u0069\u0063\u0065-\u0064\u006f\u0063\u0078");if _fafd !=nil {return nil ,_fafd ;};_efc .TmpPath =_bgad ;_acdb ,_fafd :=_f .NewReader (r ,size );if _fafd !=nil {return nil ,_cf .Errorf ("\u0070a\u0072s\u0069\u006e\u0067\u0020\u007a\u0069\u0070\u003a\u0020\u0025\u0073",_fafd );};_dgeeb :=[]*_f .File {};_dgeeb =append (_dgeeb ,_acdb .File ...);_fgb :=false ;for _ ,_deg :=range _dgeeb {if _deg .FileHeader .Name =="\u0064\u006f\u0063\u0050ro\u0070\u0073\u002f\u0063\u0075\u0073\u0074\u006f\u006d\u002e\u0078\u006d\u006c"{_fgb =true ;break ;};};if _fgb {_efc .createCustomProperties ();};_ggg :=_efc ._cdaa .ConformanceAttr ;_ade :=_ca .DecodeMap {};_ade .SetOnNewRelationshipFunc (_efc .onNewRelationship );_ade .AddTarget (_c .ContentTypesFilename ,_efc .ContentTypes .X (),"",0);_ade .AddTarget (_c .BaseRelsFilename ,_efc .Rels .X (),"",0);if _fdce :=_ade .Decode (_dgeeb );_fdce !=nil {return nil ,_fdce ;};_efc ._cdaa .ConformanceAttr =_ggg ;for _ ,_feg :=range _dgeeb {if _feg ==nil {continue ;};if _dcf :=_efc .AddExtraFileFromZip (_feg );_dcf !=nil {return nil ,_dcf ;};};if _fgb {_bfc :=false ;for _ ,_gcb :=range _efc .Rels .X ().Relationship {if _gcb .TargetAttr =="\u0064\u006f\u0063\u0050ro\u0070\u0073\u002f\u0063\u0075\u0073\u0074\u006f\u006d\u002e\u0078\u006d\u006c"{_bfc =true ;break ;};};if !_bfc {_efc .addCustomRelationships ();};};return _efc ,nil ;};
 
@@ -667,13 +1164,23 @@ func NewStyles ()Styles {return Styles {_fgg .NewStyles ()}};
 // RunProperties returns the run style properties.
 func (_efaa Style )RunProperties ()RunProperties {if _efaa ._dedd .RPr ==nil {_efaa ._dedd .RPr =_fgg .NewCT_RPr ();};return RunProperties {_efaa ._dedd .RPr };};
 
+// EnableSpellcheck re-enables spelling and grammar checking for the run. If
+// lang is not empty (e.g. "en-US"), it is used as the language of the run.
+func (_bgfab Run )EnableSpellcheck (lang string )Run {if _bgfab ._bfbb .RPr ==nil &&lang ==""{return _bgfab ;};_faga :=_bgfab .Properties ().X ();_faga .NoProof =nil ;if lang !=""{if _faga .Lang ==nil {_faga .Lang =_fgg .NewCT_Language ();};_faga .Lang .ValAttr =_c .String (lang );};_bgfab .removeEmptyRPr ();return _bgfab ;};
+
 // TableConditionalFormatting returns a conditional formatting object of a given
 // type.  Calling this method repeatedly will return the same object.
 func (_bdcg Style )TableConditionalFormatting (typ _fgg .ST_TblStyleOverrideType )TableConditionalFormatting {for _ ,_edde :=range _bdcg ._dedd .TblStylePr {if _edde .TypeAttr ==typ {return TableConditionalFormatting {_edde };};};_feab :=_fgg .NewCT_TblStylePr ();_feab .TypeAttr =typ ;_bdcg ._dedd .TblStylePr =append (_bdcg ._dedd .TblStylePr ,_feab );return TableConditionalFormatting {_feab };};
 
+// IsHidden returns true if the run is hidden text (w:vanish).
+func (_fabda Run )IsHidden ()bool {return _fabda ._bfbb .RPr !=nil &&_aeege (_fabda ._bfbb .RPr .Vanish )};
+
 // IsItalic returns true if the run has been set to italics.
 func (_acccc RunProperties )IsItalic ()bool {return _acccc .ItalicValue ()==OnOffValueOn };
 
+// removeImageDefault removes the default content type of the extension.
+func (_gcega *Document )removeImageDefault (_fceg string ){_bgdec :=_gcega .ContentTypes .X ().Default ;for _ebda ,_febfc :=range _bgdec {if _febfc .ExtensionAttr ==_fceg {_gcega .ContentTypes .X ().Default =append (_bgdec [:_ebda ],_bgdec [_ebda +1:]...);return ;};};};
+
 // HasFootnotes returns a bool based on the presence or abscence of footnotes within
 // the document.
 func (_fdcc *Document )HasFootnotes ()bool {return _fdcc ._begd !=nil };
@@ -696,7 +1203,7 @@ func (_gad CellProperties )SetWidthAuto (){_gad ._egf .TcW =_fgg .NewCT_TblWidth
 // Document is a text document that can be written out in the OOXML .docx
 // format. It can be opened from a file on disk and modified, or created from
 // scratch.
-type Document struct{_aeb .DocBase ;_cdaa *_fgg .Document ;Settings Settings ;Numbering Numbering ;Styles Styles ;_fbc []*_fgg .Hdr ;_ff []_aeb .Relationships ;_eefb []*_fgg .Ftr ;_edgc []_aeb .Relationships ;_efe _aeb .Relationships ;_fae []*_ed .Theme ;_egb *_fgg .WebSettings ;_fbg *_fgg .Fonts ;_acd *_fgg .Endnotes ;_begd *_fgg .Footnotes ;};
+type Document struct{_aeb .DocBase ;_cdaa *_fgg .Document ;Settings Settings ;Numbering Numbering ;Styles Styles ;_fbc []*_fgg .Hdr ;_ff []_aeb .Relationships ;_eefb []*_fgg .Ftr ;_edgc []_aeb .Relationships ;_efe _aeb .Relationships ;_fae []*_ed .Theme ;_egb *_fgg .WebSettings ;_fbg *_fgg .Fonts ;_acd *_fgg .Endnotes ;_begd *_fgg .Footnotes ;_fcbge *bool ;_gcagf []*_dcd .ChartSpace ;_ebgfc []string ;_fbcgd *int64 ;};
 
 // X returns the inner wrapped XML type.
 func (_aeee NumberingDefinition )X ()*_fgg .CT_AbstractNum {return _aeee ._ddfb };
@@ -720,10 +1227,16 @@ func NewNumbering ()Numbering {_gcacc :=_fgg .NewNumbering ();return Numbering {
 // GetColor returns the color.Color object representing the run color.
 func (_dfdc ParagraphProperties )GetColor ()_bbd .Color {if _afaf :=_dfdc ._fdfc .RPr .Color ;_afaf !=nil {_ddefa :=_afaf .ValAttr ;if _ddefa .ST_HexColorRGB !=nil {return _bbd .FromHex (*_ddefa .ST_HexColorRGB );};};return _bbd .Color {};};
 
+// SetRight sets the right border to a specified type, color and thickness.
+func (_fedd ParagraphBorders )SetRight (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_fedd ._x .Right =_fgg .NewCT_Border ();_cafa (_fedd ._x .Right ,t ,c ,thickness );};
+
 // TableConditionalFormatting controls the conditional formatting within a table
 // style.
 type TableConditionalFormatting struct{_abace *_fgg .CT_TblStylePr };
 
+// Drawing is a drawing within a run that is either anchored or inline.
+type Drawing struct{_gbce *Document ;_cfga *_fgg .WdAnchor ;_eff *_fgg .WdInline ;};
+
 // SetAfter sets the spacing that comes after the paragraph.
 func (_ebcf ParagraphSpacing )SetAfter (after _ce .Distance ){_ebcf ._bged .AfterAttr =&_fg .ST_TwipsMeasure {};_ebcf ._bged .AfterAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (after /_ce .Twips ));};
 
@@ -734,35 +1247,82 @@ func (_gfg NumberingDefinition )AbstractNumberID ()int64 {return _gfg ._ddfb .Ab
 // SetMultiLevelType sets the multilevel type.
 func (_fgga NumberingDefinition )SetMultiLevelType (t _fgg .ST_MultiLevelType ){if t ==_fgg .ST_MultiLevelTypeUnset {_fgga ._ddfb .MultiLevelType =nil ;}else {_fgga ._ddfb .MultiLevelType =_fgg .NewCT_MultiLevelType ();_fgga ._ddfb .MultiLevelType .ValAttr =t ;};};
 
+// AddEquation adds an empty inline equation to the end of the paragraph.
+func (_fdeg Paragraph )AddEquation ()Equation {_edgeb :=_fgg .NewEG_PContent ();_fdeg ._cfdb .EG_PContent =append (_fdeg ._cfdb .EG_PContent ,_edgeb );_gdgfb :=_fgg .NewEG_ContentRunContent ();_edgeb .EG_ContentRunContent =append (_edgeb .EG_ContentRunContent ,_gdgfb );_gecb :=_fgg .NewEG_RunLevelElts ();_gdgfb .EG_RunLevelElts =append (_gdgfb .EG_RunLevelElts ,_gecb );_begca :=_fgg .NewEG_MathContent ();_gecb .EG_MathContent =append (_gecb .EG_MathContent ,_begca );_begca .OMath =_age .NewOMath ();return Equation {MathArgument {&_begca .OMath .EG_OMathMathElements },_begca .OMath };};
+
 // UnderlineColor returns the hex color value of run underline.
 func (_gdb RunProperties )UnderlineColor ()string {if _acba :=_gdb ._bfbg .U ;_acba !=nil {_cegb :=_acba .ColorAttr ;if _cegb !=nil &&_cegb .ST_HexColorRGB !=nil {return *_cegb .ST_HexColorRGB ;};};return "";};
 
 // SetKeepNext controls if the paragraph is kept with the next paragraph.
 func (_fgef ParagraphStyleProperties )SetKeepNext (b bool ){if !b {_fgef ._bgca .KeepNext =nil ;}else {_fgef ._bgca .KeepNext =_fgg .NewCT_OnOff ();};};
 
+func _cbbfa (_gaf []*_fgg .CT_TabStop ,_gdafb *_fgg .CT_TabStop )[]*_fgg .CT_TabStop {_afgf :=len (_gaf );for _afgf > 0&&_gaf [_afgf -1].PosAttr .Int64 !=nil &&*_gaf [_afgf -1].PosAttr .Int64 > *_gdafb .PosAttr .Int64 {_afgf --;};_gaf =append (_gaf ,nil );copy (_gaf [_afgf +1:],_gaf [_afgf :]);_gaf [_afgf ]=_gdafb ;return _gaf ;};
+
+// SetFontFamilyAll sets the Ascii, HAnsi, EastAsia and complex script font
+// family for a run, for the common case where one font covers all of the text.
+// Any theme fonts on the run are removed since they would take precedence over
+// family.
+func (_dcefb RunProperties )SetFontFamilyAll (family string )RunProperties {if _dcefb ._bfbg .RFonts ==nil {_dcefb ._bfbg .RFonts =_fgg .NewCT_Fonts ();};_dcefb ._bfbg .RFonts .AsciiAttr =_c .String (family );_dcefb ._bfbg .RFonts .HAnsiAttr =_c .String (family );_dcefb ._bfbg .RFonts .EastAsiaAttr =_c .String (family );_dcefb ._bfbg .RFonts .CsAttr =_c .String (family );_dcefb ._bfbg .RFonts .AsciiThemeAttr =_fgg .ST_ThemeUnset ;_dcefb ._bfbg .RFonts .HAnsiThemeAttr =_fgg .ST_ThemeUnset ;_dcefb ._bfbg .RFonts .EastAsiaThemeAttr =_fgg .ST_ThemeUnset ;_dcefb ._bfbg .RFonts .CsthemeAttr =_fgg .ST_ThemeUnset ;return _dcefb ;};
+
 // DoubleStrike returns true if run is double striked.
 func (_bgcfe RunProperties )DoubleStrike ()bool {return _aeege (_bgcfe ._bfbg .Dstrike )};
 
 // X returns the inner wrapped XML type.
 func (_bcfc TableProperties )X ()*_fgg .CT_TblPr {return _bcfc ._caea };
 
+// SetSpacingAfter sets the spacing that comes after the paragraph.
+func (_abba Paragraph )SetSpacingAfter (d _ce .Distance ){_abba .Properties ().Spacing ().SetAfter (d );};
+
 // Name returns the name of the field.
 func (_affg FormField )Name ()string {return *_affg ._edda .Name [0].ValAttr };
 
+func (_adaeb *Document )allParagraphs ()[]Paragraph {_fbba :=_adaeb .Paragraphs ();for _ ,_ddeca :=range _adaeb .Headers (){_fbba =append (_fbba ,_ddeca .Paragraphs ()...);};for _ ,_fagge :=range _adaeb .Footers (){_fbba =append (_fbba ,_fagge .Paragraphs ()...);};return _eefag (_fbba );};func (_cace Paragraph )allRuns ()[]Run {_effaf :=[]Run {};var _aeea func (_ecag []*_fgg .EG_ContentRunContent );_aeea =func (_badfg []*_fgg .EG_ContentRunContent ){for _ ,_gadda :=range _badfg {if _gadda .R !=nil {_effaf =append (_effaf ,Run {_cace ._eecc ,_gadda .R });};if _gadda .Sdt !=nil &&_gadda .Sdt .SdtContent !=nil {_aeea (_gadda .Sdt .SdtContent .EG_ContentRunContent );};};};var _geed func (_abbag []*_fgg .EG_PContent );_geed =func (_feeec []*_fgg .EG_PContent ){for _ ,_cbee :=range _feeec {_aeea (_cbee .EG_ContentRunContent );if _cbee .Hyperlink !=nil {_aeea (_cbee .Hyperlink .EG_ContentRunContent );};for _ ,_bfca :=range _cbee .FldSimple {_geed (_bfca .EG_PContent );};};};_geed (_cace ._cfdb .EG_PContent );return _effaf ;};func (_bccf *Document )allRuns ()[]Run {_dgcga :=[]Run {};for _ ,_bfebb :=range _bccf .allParagraphs (){_dgcga =append (_dgcga ,_bfebb .allRuns ()...);};return _dgcga ;};
+
 // SetName sets the name of the image, visible in the properties of the image
 // within Word.
 func (_cda AnchoredDrawing )SetName (name string ){_cda ._gd .DocPr .NameAttr =name ;for _ ,_eg :=range _cda ._gd .Graphic .GraphicData .Any {if _cg ,_ad :=_eg .(*_cde .Pic );_ad {_cg .NvPicPr .CNvPr .DescrAttr =_c .String (name );};};};
 
+type byteCounter int ;func (_gcdgb *byteCounter )Write (b []byte )(int ,error ){*_gcdgb +=byteCounter (len (b ));return len (b ),nil ;};
+
+// mapText replaces each text element of the run with the result of fn.
+func (_fgeb Run )mapText (_bffbb func (string )string ){_cedge :=make ([]*_fgg .EG_RunInnerContent ,0,len (_fgeb ._bfbb .EG_RunInnerContent ));for _ ,_ddba :=range _fgeb ._bfbb .EG_RunInnerContent {if _ddba .T ==nil ||_ddba .T .Content ==""{_cedge =append (_cedge ,_ddba );continue ;};_gbgge :=_bffbb (_ddba .T .Content );switch {case _gbgge ==_ddba .T .Content :_cedge =append (_cedge ,_ddba );case _gbgge =="":case !_a .ContainsAny (_gbgge ,"\u0009\u000d\u000a"):_ddba .T .Content =_gbgge ;_ddba .T .SpaceAttr =nil ;if _c .NeedsSpacePreserve (_gbgge ){_cedd :="p\u0072\u0065\u0073\u0065\u0072\u0076\u0065";_ddba .T .SpaceAttr =&_cedd ;};_cedge =append (_cedge ,_ddba );default:_gdba :=Run {_fgeb ._adbf ,_fgg .NewCT_R ()};_gdba .replaceText (_gbgge );_cedge =append (_cedge ,_gdba ._bfbb .EG_RunInnerContent ...);};};_fgeb ._bfbb .EG_RunInnerContent =_cedge ;};
+
 // SetWindowControl controls if the first or last line of the paragraph is
 // allowed to dispay on a separate page.
 func (_dgdf ParagraphProperties )SetWindowControl (b bool ){if !b {_dgdf ._fdfc .WidowControl =nil ;}else {_dgdf ._fdfc .WidowControl =_fgg .NewCT_OnOff ();};};
 
+// SaveWithImageOptions writes the document to a file, recompressing the PNG
+// and JPEG images according to opts to reduce the size of the file.  The images
+// stored in the document are not modified. Images that can't be decoded or
+// that wouldn't become smaller are written unchanged.
+func (_fdbag *Document )SaveWithImageOptions (path string ,opts ImageSaveOptions )error {_bfgdc ,_fcfgg :=_cd .Create (path );if _fcfgg !=nil {return _fcfgg ;};defer _bfgdc .Close ();return _fdbag .save (_bfgdc ,&opts );};
+
+// SaveToWriterWithImageOptions writes the document to an io.Writer in the Zip
+// package format, recompressing the images like SaveWithImageOptions.
+func (_cbbgf *Document )SaveToWriterWithImageOptions (w _ae .Writer ,opts ImageSaveOptions )error {return _cbbgf .save (w ,&opts )};func (_eeag *Document )recompressImage (_ddgee _aeb .ImageRef ,_fadb ImageSaveOptions )(_aeb .ImageRef ,bool ){_dfcgc :=_a .ToLower (_ddgee .Format ());if _dfcgc !="\u0070\u006eg"&&_dfcgc !="\u006a\u0070\u0065\u0067"&&_dfcgc !="\u006ap\u0067"{return _ddgee ,false ;};var _eaae []byte ;if _ddgee .Data ()!=nil {_eaae =*_ddgee .Data ();}else if _ddgee .Path ()!=""{_gcccd ,_befgg :=_aebc .Open (_ddgee .Path ());if _befgg !=nil {return _ddgee ,false ;};_eaae ,_befgg =_e .ReadAll (_gcccd );_gcccd .Close ();if _befgg !=nil {return _ddgee ,false ;};};if len (_eaae )==0{return _ddgee ,false ;};_dcee ,_ ,_bade :=_bb .Decode (_d .NewReader (_eaae ));if _bade !=nil {return _ddgee ,false ;};_bcdfc :=false ;if _cdgcd :=_dcee .Bounds ();_fadb .MaxDimension > 0&&(_cdgcd .Dx ()> _fadb .MaxDimension ||_cdgcd .Dy ()> _fadb .MaxDimension ){_dcee =_fcfef (_dcee ,_fadb .MaxDimension );_bcdfc =true ;};if !_bcdfc &&(_dfcgc =="\u0070\u006e\u0067"||_fadb .JPEGQuality <=0){return _ddgee ,false ;};_effce :=_d .Buffer {};var _ffdbg _ae .Writer =&_effce ;if _dfcgc =="\u0070\u006e\u0067"{_ccbca :=_dec .Encoder {CompressionLevel :_dec .BestCompression };_bade =_ccbca .Encode (_ffdbg ,_dcee );}else {_addfb :=_fadb .JPEGQuality ;if _addfb <=0||_addfb > 100{_addfb =_dg .DefaultQuality ;};_bade =_dg .Encode (_ffdbg ,_dcee ,&_dg .Options {Quality :_addfb });};if _bade !=nil ||_effce .Len ()>=len (_eaae ){return _ddgee ,false ;};_adadb :=_effce .Bytes ();_gecab :=_aeb .MakeImageRef (_aeb .Image {Data :&_adadb ,Format :_ddgee .Format (),Size :_ddgee .Size ()},&_eeag .DocBase ,_eeag ._efe );_gecab .SetRelID (_ddgee .RelID ());return _gecab ,true ;};
+
 // X returns the inner wrapped XML type.
 func (_dgfb ParagraphStyleProperties )X ()*_fgg .CT_PPrGeneral {return _dgfb ._bgca };
 
 // X returns the inner wrapped XML type.
 func (_aefgb Style )X ()*_fgg .CT_Style {return _aefgb ._dedd };
 
+// SetSpacingBefore sets the spacing that comes before the paragraph.
+func (_agfaa Paragraph )SetSpacingBefore (d _ce .Distance ){_agfaa .Properties ().Spacing ().SetBefore (d );};
+
+// ensureImageDefault registers a default content type for the extension
+// unless one is already registered, so that a type given by the caller of
+// AddImageWithContentType isn't replaced by later images.
+func (_bgdg *Document )ensureImageDefault (_begee ,_cafff string ){if _ ,_eace :=_bgdg .imageDefault (_begee );!_eace {_bgdg .ContentTypes .EnsureDefault (_begee ,_cafff );};};
+
+// downscaleImage scales src so that neither dimension exceeds max, averaging
+// the source pixels covered by each destination pixel.
+func _fcfef (_faaed _bb .Image ,_fcgde int )_bb .Image {_ebed :=_faaed .Bounds ();_gfef ,_ddbd :=_ebed .Dx (),_ebed .Dy ();if _gfef >=_ddbd {_ddbd =_ddbd *_fcgde /_gfef ;_gfef =_fcgde ;}else {_gfef =_gfef *_fcgde /_ddbd ;_ddbd =_fcgde ;};if _gfef < 1{_gfef =1;};if _ddbd < 1{_ddbd =1;};_gfba :=_bb .NewNRGBA (_bb .Rect (0,0,_gfef ,_ddbd ));for _egca :=0;_egca < _ddbd ;_egca ++{_eage :=_ebed .Min .Y +_egca *_ebed .Dy ()/_ddbd ;_bdgf :=_ebed .Min .Y +(_egca +1)*_ebed .Dy ()/_ddbd ;if _bdgf <=_eage {_bdgf =_eage +1;};for _ffege :=0;_ffege < _gfef ;_ffege ++{_becf :=_ebed .Min .X +_ffege *_ebed .Dx ()/_gfef ;_defg :=_ebed .Min .X +(_ffege +1)*_ebed .Dx ()/_gfef ;if _defg <=_becf {_defg =_becf +1;};var _aac ,_gfcb ,_bdfda ,_cacge ,_gdfa uint64 ;for _bcgff :=_eage ;_bcgff < _bdgf ;_bcgff ++{for _ggbbg :=_becf ;_ggbbg < _defg ;_ggbbg ++{_gcec ,_begbc ,_cgdc ,_abgad :=_faaed .At (_ggbbg ,_bcgff ).RGBA ();_aac +=uint64 (_gcec );_gfcb +=uint64 (_begbc );_bdfda +=uint64 (_cgdc );_cacge +=uint64 (_abgad );_gdfa ++;};};_cecb :=_gfba .PixOffset (_ffege ,_egca );if _cacge ==0{continue ;};_gfba .Pix [_cecb +0]=uint8 (_aac *0xff/_cacge );_gfba .Pix [_cecb +1]=uint8 (_gfcb *0xff/_cacge );_gfba .Pix [_cecb +2]=uint8 (_bdfda *0xff/_cacge );_gfba .Pix [_cecb +3]=uint8 (_cacge /_gdfa >>8);};};return _gfba ;};
+
+// isShadingHighlight returns true if shd is shading as written by
+// SetShadingHighlight: clear, with an automatic color and an RGB fill.
+func _efbga (_ddaec *_fgg .CT_Shd )bool {return _ddaec !=nil &&_ddaec .ValAttr ==_fgg .ST_ShdClear &&_ddaec .ColorAttr !=nil &&_ddaec .ColorAttr .ST_HexColorAuto ==_fgg .ST_HexColorAutoAuto &&_ddaec .FillAttr !=nil &&_ddaec .FillAttr .ST_HexColorRGB !=nil &&_ddaec .ThemeColorAttr ==_fgg .ST_ThemeColorUnset &&_ddaec .ThemeFillAttr ==_fgg .ST_ThemeColorUnset ;};
+
 // Type returns the type of the style.
 func (_bddgca Style )Type ()_fgg .ST_StyleType {return _bddgca ._dedd .TypeAttr };
 
@@ -774,30 +1334,57 @@ func (_fegg RunProperties )SizeValue ()float64 {if _ece :=_fegg ._bfbg .Sz ;_ece
 // ID.
 func (_bceb Footer )Index ()int {for _dcgg ,_badb :=range _bceb ._gbfg ._eefb {if _badb ==_bceb ._baba {return _dcgg ;};};return -1;};
 
+// mergeFonts returns a copy of dst with the attributes set in src applied.
+func _fefg (_fbbfg ,_fege *_fgg .CT_Fonts )*_fgg .CT_Fonts {if _fege ==nil {return _fbbfg ;};_faedd :=_fgg .NewCT_Fonts ();if _fbbfg !=nil {*_faedd =*_fbbfg ;};if _fege .HintAttr !=_fgg .ST_HintUnset {_faedd .HintAttr =_fege .HintAttr ;};if _fege .AsciiAttr !=nil {_faedd .AsciiAttr =_fege .AsciiAttr ;};if _fege .HAnsiAttr !=nil {_faedd .HAnsiAttr =_fege .HAnsiAttr ;};if _fege .EastAsiaAttr !=nil {_faedd .EastAsiaAttr =_fege .EastAsiaAttr ;};if _fege .CsAttr !=nil {_faedd .CsAttr =_fege .CsAttr ;};if _fege .AsciiThemeAttr !=_fgg .ST_ThemeUnset {_faedd .AsciiThemeAttr =_fege .AsciiThemeAttr ;};if _fege .HAnsiThemeAttr !=_fgg .ST_ThemeUnset {_faedd .HAnsiThemeAttr =_fege .HAnsiThemeAttr ;};if _fege .EastAsiaThemeAttr !=_fgg .ST_ThemeUnset {_faedd .EastAsiaThemeAttr =_fege .EastAsiaThemeAttr ;};if _fege .CsthemeAttr !=_fgg .ST_ThemeUnset {_faedd .CsthemeAttr =_fege .CsthemeAttr ;};return _faedd ;};
+
 // Row is a row within a table within a document.
 type Row struct{_aade *Document ;_edag *_fgg .CT_Row ;};
 
 // SetAlignment controls the paragraph alignment
 func (_dbfd ParagraphStyleProperties )SetAlignment (align _fgg .ST_Jc ){if align ==_fgg .ST_JcUnset {_dbfd ._bgca .Jc =nil ;}else {_dbfd ._bgca .Jc =_fgg .NewCT_Jc ();_dbfd ._bgca .Jc .ValAttr =align ;};};func _eb (_feb *_fgg .CT_TblWidth ,_ccb _ce .Distance ){_feb .TypeAttr =_fgg .ST_TblWidthDxa ;_feb .WAttr =&_fgg .ST_MeasurementOrPercent {};_feb .WAttr .ST_DecimalNumberOrPercent =&_fgg .ST_DecimalNumberOrPercent {};_feb .WAttr .ST_DecimalNumberOrPercent .ST_UnqualifiedPercentage =_c .Int64 (int64 (_ccb /_ce .Dxa ));};
 
+// SetGlow adds a glow of the given radius and color around the run's text.
+// The glow is a Word 2010 text effect and is ignored by earlier versions.  The
+// transparency of the glow is taken from the alpha of c, and a zero radius
+// removes the glow.
+func (_ffdge Run )SetGlow (radius _ce .Distance ,c _bbd .Color )Run {if radius <=0{_ffdge .setW14 ("\u0067\u006c\u006f\u0077",nil );return _ffdge ;};_eafee :=_fbae ("\u0073\u0072\u0067\u0062\u0043\u006c\u0072","\u0076\u0061\u006c",*c .AsRGBString ());if _gbd ,_edgad :=_ebc .ParseUint ((*c .AsRGBAString ())[0:2],16,8);_edgad ==nil &&_gbd < 255&&!c .IsAuto (){_eafee .Nodes =append (_eafee .Nodes ,_fbae ("\u0061\u006c\u0070\u0068\u0061","\u0076\u0061\u006c",_cf .Sprintf ("\u0025\u0064",(255-_gbd )*100000/255)));};_gfbfc :=_fbae ("\u0067l\u006f\u0077","\u0072\u0061\u0064",_cf .Sprintf ("\u0025\u0064",_ce .ToEMU (float64 (radius ))));_gfbfc .Nodes =append (_gfbfc .Nodes ,_eafee );_ffdge .setW14 ("\u0067\u006c\u006f\u0077",_gfbfc );return _ffdge ;};
+
+// SetDefaultLanguage sets the default language of the document text, e.g.
+// "en-GB", in the style document defaults.  Word uses it for spelling and
+// grammar checking of runs that don't specify a language.  The East Asian and
+// complex script languages are left unchanged.  An empty language removes the
+// default.
+func (_fdbcg *Document )SetDefaultLanguage (lang string ){_ebffg :=_fdbcg .Styles .X ();if _ebffg .DocDefaults ==nil {if lang ==""{return ;};_ebffg .DocDefaults =_fgg .NewCT_DocDefaults ();};if _ebffg .DocDefaults .RPrDefault ==nil {_ebffg .DocDefaults .RPrDefault =_fgg .NewCT_RPrDefault ();};if _ebffg .DocDefaults .RPrDefault .RPr ==nil {_ebffg .DocDefaults .RPrDefault .RPr =_fgg .NewCT_RPr ();};_cccf :=_ebffg .DocDefaults .RPrDefault .RPr ;if lang ==""{if _cccf .Lang !=nil {_cccf .Lang .ValAttr =nil ;if _cccf .Lang .EastAsiaAttr ==nil &&_cccf .Lang .BidiAttr ==nil {_cccf .Lang =nil ;};};return ;};if _cccf .Lang ==nil {_cccf .Lang =_fgg .NewCT_Language ();};_cccf .Lang .ValAttr =_c .String (lang );};
+
 // Footer is a footer for a document section.
 type Footer struct{_gbfg *Document ;_baba *_fgg .Ftr ;};
 
 // SetInsideVertical sets the interior vertical borders to a specified type, color and thickness.
 func (_gefb TableBorders )SetInsideVertical (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_gefb ._efaad .InsideV =_fgg .NewCT_Border ();_cafa (_gefb ._efaad .InsideV ,t ,c ,thickness );};
 
+// SetReflection adds a reflection of the run's text below it.  size is the
+// fraction (0-1) of the text that is reflected and dist is the gap between
+// the text and its reflection.  The reflection is a Word 2010 text effect and is
+// ignored by earlier versions.  A size of zero removes the reflection.
+func (_dcaf Run )SetReflection (size float64 ,dist _ce .Distance )Run {if size <=0{_dcaf .setW14 ("\u0072\u0065\u0066\u006c\u0065\u0063t\u0069\u006f\u006e",nil );return _dcaf ;};if size > 1{size =1;};_dcaf .setW14 ("\u0072\u0065\u0066\u006c\u0065\u0063\u0074\u0069\u006fn",_fbae ("\u0072\u0065f\u006c\u0065\u0063\u0074\u0069\u006f\u006e","b\u006c\u0075\u0072\u0052\u0061\u0064","6\u0033\u0035\u0030","\u0073\u0074\u0041","\u0035\u0035\u0030\u00300","\u0073\u0074\u0050\u006f\u0073","\u0030","\u0065\u006e\u0064\u0041","\u0033\u0030\u0030","\u0065\u006e\u0064\u0050\u006f\u0073",_cf .Sprintf ("\u0025\u0064",int64 (size *100000)),"\u0064\u0069\u0073\u0074",_cf .Sprintf ("%\u0064",_ce .ToEMU (float64 (dist ))),"\u0064ir","5\u00340\u0030\u0030\u0030\u0030","\u0066\u0061d\u0065\u0044\u0069\u0072","\u0035\u0034\u0030\u0030\u0030\u0030\u0030","\u0073\u0078","\u00310\u00300\u0030\u0030","s\u0079","\u002d\u0031\u0030\u0030\u0030\u0030\u0030","\u006b\u0078","0","\u006b\u0079","\u0030","\u0061l\u0067\u006e","b\u006c"));return _dcaf ;};
+
 // SetStyle sets the style of a paragraph.
 func (_egega ParagraphProperties )SetStyle (s string ){if s ==""{_egega ._fdfc .PStyle =nil ;}else {_egega ._fdfc .PStyle =_fgg .NewCT_String ();_egega ._fdfc .PStyle .ValAttr =s ;};};
 
 // SetCellSpacing sets the cell spacing within a table.
 func (_gdccg TableProperties )SetCellSpacing (m _ce .Distance ){_gdccg ._caea .TblCellSpacing =_fgg .NewCT_TblWidth ();_gdccg ._caea .TblCellSpacing .TypeAttr =_fgg .ST_TblWidthDxa ;_gdccg ._caea .TblCellSpacing .WAttr =&_fgg .ST_MeasurementOrPercent {};_gdccg ._caea .TblCellSpacing .WAttr .ST_DecimalNumberOrPercent =&_fgg .ST_DecimalNumberOrPercent {};_gdccg ._caea .TblCellSpacing .WAttr .ST_DecimalNumberOrPercent .ST_UnqualifiedPercentage =_c .Int64 (int64 (m /_ce .Dxa ));};
 
+// Instruction returns the field instruction, e.g. `PAGE \* MERGEFORMAT`.
+func (_gaedf Field )Instruction ()string {return _a .TrimSpace (_gaedf ._dbfe )};
+
 // InsertRunBefore inserts a run in the paragraph before the relative run.
 func (_cfad Paragraph )InsertRunBefore (relativeTo Run )Run {return _cfad .insertRun (relativeTo ,true )};
 
 // AddParagraph adds a new paragraph to the document body.
 func (_abd *Document )AddParagraph ()Paragraph {_fgf :=_fgg .NewEG_BlockLevelElts ();_abd ._cdaa .Body .EG_BlockLevelElts =append (_abd ._cdaa .Body .EG_BlockLevelElts ,_fgf );_eega :=_fgg .NewEG_ContentBlockContent ();_fgf .EG_ContentBlockContent =append (_fgf .EG_ContentBlockContent ,_eega );_ffd :=_fgg .NewCT_P ();_eega .P =append (_eega .P ,_ffd );return Paragraph {_abd ,_ffd };};
 
+func _eedf (_deca ,_bfadb interface{},_dffe string )error {_ggebc :=_d .Buffer {};_bgfb :=_fda .NewEncoder (&_ggebc );_eecd :=_fda .StartElement {Name :_fda .Name {Local :_dffe }};_eecd .Attr =append (_eecd .Attr ,_fda .Attr {Name :_fda .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u0077"},Value :"\u0068t\u0074p\u003a\u002f\u002f\u0073\u0063\u0068e\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072d\u0070\u0072\u006fc\u0065\u0073\u0073\u0069n\u0067\u006d\u006c\u002f\u0032\u00300\u0036\u002f\u006d\u0061\u0069\u006e"});if _dacb :=_bgfb .EncodeElement (_bfadb ,_eecd );_dacb !=nil {return _dacb ;};if _ceded :=_bgfb .Flush ();_ceded !=nil {return _ceded ;};return _fda .Unmarshal (_ggebc .Bytes (),_deca );};
+
 // Bookmark is a bookmarked location within a document that can be referenced
 // with a hyperlink.
 type Bookmark struct{_dac *_fgg .CT_Bookmark };
@@ -805,6 +1392,11 @@ type Bookmark struct{_dac *_fgg .CT_Bookmark };
 // SetLeftPct sets the cell left margin
 func (_cfd CellMargins )SetLeftPct (pct float64 ){_cfd ._bgg .Left =_fgg .NewCT_TblWidth ();_fe (_cfd ._bgg .Left ,pct );};
 
+// CloneIntoParagraph copies the run like CloneInto and appends the copy to p.
+// The images and charts referenced by the run are added to the part
+// containing p, which may be a header or footer.
+func (_aggd Run )CloneIntoParagraph (p Paragraph )(Run ,error ){if p ._eecc ==nil {return Run {},_ef .New ("\u0070\u0061\u0072\u0061\u0067r\u0061\u0070\u0068\u0020\u006d\u0075\u0073\u0074\u0020\u0062\u0065\u006c\u006f\u006e\u0067\u0020\u0074\u006f\u0020\u0061\u0020do\u0063\u0075\u006d\u0065\u006e\u0074");};_ecfc :=p .AddRun ();_bggfd ,_gade :=_aggd .cloneInto (p ._eecc ,p ._eecc .relsOfRun (_ecfc ));if _gade !=nil {p .RemoveRun (_ecfc );return Run {},_gade ;};*_ecfc ._bfbb =*_bggfd ._bfbb ;return _ecfc ,nil ;};
+
 // DrawingAnchored returns a slice of AnchoredDrawings.
 func (_adfe Run )DrawingAnchored ()[]AnchoredDrawing {_eeac :=[]AnchoredDrawing {};for _ ,_fede :=range _adfe ._bfbb .EG_RunInnerContent {if _fede .Drawing ==nil {continue ;};for _ ,_dbba :=range _fede .Drawing .Anchor {_eeac =append (_eeac ,AnchoredDrawing {_adfe ._adbf ,_dbba });};};return _eeac ;};
 
@@ -818,8 +1410,52 @@ func (_fecg RunProperties )GetColor ()_bbd .Color {if _bggg :=_fecg ._bfbg .Colo
 // SetXOffset sets the X offset for an image relative to the origin.
 func (_bc AnchoredDrawing )SetXOffset (x _ce .Distance ){_bc ._gd .PositionH .Choice =&_fgg .WdCT_PosHChoice {};_bc ._gd .PositionH .Choice .PosOffset =_c .Int32 (int32 (x /_ce .EMU ));};
 
-// SetImprint sets the run to imprinted text.
-func (_afff RunProperties )SetImprint (b bool ){if !b {_afff ._bfbg .Imprint =nil ;}else {_afff ._bfbg .Imprint =_fgg .NewCT_OnOff ();};};
+// Field is a simple or complex field within a document, such as a PAGE or TOC
+// field.  It references the document, so changes made through its runs will be
+// reflected in the document if it is saved.
+type Field struct{_ffcf Paragraph ;_ffa *_fgg .CT_SimpleField ;_dgeb []Run ;_dbfe string ;};
+
+// ImageSaveOptions controls how images are recompressed by
+// SaveWithImageOptions.  The image format is never changed.  PNG and JPEG
+// images whose width or height exceeds MaxDimension pixels are downscaled to
+// fit within it, keeping their aspect ratio; a MaxDimension of zero disables
+// downscaling.  JPEG images are re-encoded with JPEGQuality, from 1 to 100; a
+// JPEGQuality of zero only re-encodes downscaled images, using the default
+// quality.  A recompressed image is only used if it is smaller than the
+// original, and its displayed size in the document is unchanged.
+type ImageSaveOptions struct{MaxDimension int ;JPEGQuality int ;};
+
+// textBoxShape is a wps:wsp text box shape stored in a drawing's graphic
+// data.  The wordprocessingShape types in the wml package are written with
+// the wrong namespace prefix, so the shape is marshaled here instead.  Shapes
+// read from a document keep their elements in order so they are written back
+// unchanged.
+type textBoxShape struct{_cebg *_ed .CT_ShapeProperties ;_bcb *_fgg .CT_TxbxContent ;_bfgcd []interface{};};func (_fccae *textBoxShape )MarshalXML (e *_fda .Encoder ,start _fda .StartElement )error {start =_fda .StartElement {Name :_fda .Name {Local :"\u0077\u0070\u0073\u003a\u0077s\u0070"}};start .Attr =append (start .Attr ,_fda .Attr {Name :_fda .Name {Local :"\u0078\u006d\u006c\u006es:\u0077\u0070\u0073"},Value :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0073\u0063\u0068\u0065\u006d\u0061s\u002e\u006d\u0069\u0063\u0072\u006f\u0073\u006f\u0066\u0074\u002e\u0063\u006f\u006d\u002f\u006f\u0066\u0066\u0069\u0063e/\u0077o\u0072\u0064\u002f\u0032\u0030\u0031\u0030\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073s\u0069\u006e\u0067\u0053\u0068\u0061\u0070\u0065"});e .EncodeToken (start );if _fccae ._bfgcd !=nil {for _ ,_eabfb :=range _fccae ._bfgcd {switch _cbcea :=_eabfb .(type ){case *_ed .CT_ShapeProperties :e .EncodeElement (_cbcea ,_fda .StartElement {Name :_fda .Name {Local :"w\u0070\u0073\u003as\u0070\u0050\u0072"}});case *_fgg .CT_TxbxContent :_cdcef :=_fda .StartElement {Name :_fda .Name {Local :"\u0077\u0070\u0073:\u0074\u0078b\u0078"}};e .EncodeToken (_cdcef );e .EncodeElement (_cbcea ,_fda .StartElement {Name :_fda .Name {Local :"\u0077\u003a\u0074\u0078\u0062xC\u006f\u006e\u0074\u0065\u006e\u0074"}});e .EncodeToken (_cdcef .End ());case *_c .XSDAny :e .EncodeElement (_cbcea ,_fda .StartElement {Name :_cbcea .XMLName });};};e .EncodeToken (start .End ());return nil ;};_ggef :=_fda .StartElement {Name :_fda .Name {Local :"\u0077\u0070\u0073:c\u004e\u0076\u0053\u0070\u0050\u0072"},Attr :[]_fda .Attr {{Name :_fda .Name {Local :"\u0074\u0078\u0042\u006f\u0078"},Value :"\u0031"}}};e .EncodeToken (_ggef );e .EncodeToken (_ggef .End ());e .EncodeElement (_fccae ._cebg ,_fda .StartElement {Name :_fda .Name {Local :"w\u0070\u0073\u003as\u0070\u0050\u0072"}});_adcg :=_fda .StartElement {Name :_fda .Name {Local :"\u0077\u0070\u0073:\u0074\u0078b\u0078"}};e .EncodeToken (_adcg );e .EncodeElement (_fccae ._bcb ,_fda .StartElement {Name :_fda .Name {Local :"\u0077\u003a\u0074\u0078\u0062xC\u006f\u006e\u0074\u0065\u006e\u0074"}});e .EncodeToken (_adcg .End ());e .EncodeElement (_ed .NewCT_TextBodyProperties (),_fda .StartElement {Name :_fda .Name {Local :"\u0077\u0070\u0073:\u0062\u006f\u0064y\u0050\u0072"}});e .EncodeToken (start .End ());return nil ;};func (_ebaeb *textBoxShape )UnmarshalXML (d *_fda .Decoder ,start _fda .StartElement )error {_ebaeb ._bfgcd =[]interface{}{};for {_egec ,_agggg :=d .Token ();if _agggg !=nil {return _agggg ;};switch _abge :=_egec .(type ){case _fda .StartElement :switch _abge .Name .Local {case "\u0073\u0070\u0050\u0072":_ebaeb ._cebg =_ed .NewCT_ShapeProperties ();if _bfgfb :=d .DecodeElement (_ebaeb ._cebg ,&_abge );_bfgfb !=nil {return _bfgfb ;};_ebaeb ._bfgcd =append (_ebaeb ._bfgcd ,_ebaeb ._cebg );case "\u0074\u0078b\u0078":_fdaae :=struct{Content *_fgg .CT_TxbxContent `xml:"txbxContent"`;}{};if _gabe :=d .DecodeElement (&_fdaae ,&_abge );_gabe !=nil {return _gabe ;};_ebaeb ._bcb =_fdaae .Content ;if _ebaeb ._bcb ==nil {_ebaeb ._bcb =_fgg .NewCT_TxbxContent ();};_ebaeb ._bfgcd =append (_ebaeb ._bfgcd ,_ebaeb ._bcb );default:_bfagf :=&_c .XSDAny {};if _cbdaf :=d .DecodeElement (_bfagf ,&_abge );_cbdaf !=nil {return _cbdaf ;};_ebaeb ._bfgcd =append (_ebaeb ._bfgcd ,_bfagf );};case _fda .EndElement :if _abge .Name ==start .Name {return nil ;};};};};
+
+// ExtractText returns the text of the paragraphs within the document body,
+// including those within tables, in document order with one paragraph per
+// line. Field instructions are omitted, only the displayed field results are
+// included. Hidden text is included, use ExtractTextWithOptions to exclude it.
+func (_eaga *Document )ExtractText ()string {_aagce :=_d .Buffer {};for _gcgag ,_eeca :=range _eaga .bodyParagraphs (){if _gcgag > 0{_aagce .WriteByte ('\n');};_aagce .WriteString (_eeca .Text ());};return _aagce .String ();};
+
+// SetImprint sets the run to imprinted text. Imprinted text can't be combined
+// with embossed, shadowed or outlined text, so those properties are cleared.
+func (_afff RunProperties )SetImprint (b bool )RunProperties {if !b {_afff ._bfbg .Imprint =nil ;}else {_afff ._bfbg .Imprint =_fgg .NewCT_OnOff ();_afff ._bfbg .Emboss =nil ;_afff ._bfbg .Shadow =nil ;_afff ._bfbg .Outline =nil ;};return _afff ;};
+
+// MarkFormatReverted records a tracked formatting change on the run
+// (w:rPrChange), with previous being the formatting that the run had before
+// the change and the current run properties being the formatting after it.
+// Word displays the run as "formatted" by author and allows the change to be
+// accepted or rejected, rejecting it restores the previous properties.  The
+// previous properties are copied, so they can be taken from another run, for
+// example one created with NewRun.  The date of the change is optional in
+// WordprocessingML, so a zero date leaves out w:date and Word shows the change
+// without a date.
+func (_ggdc Run )MarkFormatReverted (author string ,date _aga .Time ,previous RunProperties )error {_eddge :=_fgg .CT_RPr {};if previous ._bfbg !=nil {_eddge =*previous ._bfbg ;};_eddge .RPrChange =nil ;_egaec :=_fgg .NewCT_RPrChange ();if _fabfe :=_eedf (_egaec .RPr ,&_eddge ,"\u0077\u003a\u0072\u0050\u0072");_fabfe !=nil {return _fabfe ;};_egaec .AuthorAttr =author ;if !date .IsZero (){_egaec .DateAttr =&date ;};if _ggdc ._adbf !=nil {_ffbge ,_caae :=_ggdc ._adbf .nextRevisionID ();if _caae !=nil {return _caae ;};_egaec .IdAttr =_ffbge ;};_ggdc .Properties ()._bfbg .RPrChange =_egaec ;return nil ;};
+
+// SetLocks controls whether the drawing can be selected, moved or resized by
+// the user. Passing false for all three removes the locks.
+func (_baaca AnchoredDrawing )SetLocks (noSelect ,noMove ,noResize bool ){if !noSelect &&!noMove &&!noResize {if _baaca ._gd .CNvGraphicFramePr !=nil {_baaca ._gd .CNvGraphicFramePr .GraphicFrameLocks =nil ;};return ;};if _baaca ._gd .CNvGraphicFramePr ==nil {_baaca ._gd .CNvGraphicFramePr =_ed .NewCT_NonVisualGraphicFrameProperties ();};_bcaa :=_ed .NewCT_GraphicalObjectFrameLocking ();if noSelect {_bcaa .NoSelectAttr =_c .Bool (true );};if noMove {_bcaa .NoMoveAttr =_c .Bool (true );};if noResize {_bcaa .NoResizeAttr =_c .Bool (true );};_baaca ._gd .CNvGraphicFramePr .GraphicFrameLocks =_bcaa ;};
 
 // SetFirstLineIndent controls the indentation of the first line in a paragraph.
 func (_fgee Paragraph )SetFirstLineIndent (m _ce .Distance ){_fgee .ensurePPr ();_fdgf :=_fgee ._cfdb .PPr ;if _fdgf .Ind ==nil {_fdgf .Ind =_fgg .NewCT_Ind ();};if m ==_ce .Zero {_fdgf .Ind .FirstLineAttr =nil ;}else {_fdgf .Ind .FirstLineAttr =&_fg .ST_TwipsMeasure {};_fdgf .Ind .FirstLineAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (m /_ce .Twips ));};};
@@ -830,9 +1466,17 @@ func (_cegd RunProperties )Fonts ()Fonts {if _cegd ._bfbg .RFonts ==nil {_cegd .
 // AddBreak adds a line break to a run.
 func (_bdee Run )AddBreak (){_eeefc :=_bdee .newIC ();_eeefc .Br =_fgg .NewCT_Br ()};
 
+// AddStyledRun adds a run to the paragraph that uses the character style with
+// the given style ID.  An error is returned and no run is added if the document
+// doesn't contain a character style with that ID.
+func (_beag Paragraph )AddStyledRun (styleID string )(Run ,error ){if _beag ._eecc ==nil {return Run {},_cf .Errorf ("\u0070\u0061\u0072\u0061\u0067\u0072\u0061p\u0068\u0020\u0069\u0073\u006e\u0027\u0074\u0020\u0070\u0061\u0072\u0074\u0020\u006f\u0066\u0020\u0061\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002c\u0020\u0063\u0061\u006e\u0027\u0074 \u006c\u006f\u006fk\u0020\u0075\u0070 \u0073\u0074\u0079\u006c\u0065\u0020\u0025\u0073",styleID );};_cgbfe :=false ;for _ ,_gbbab :=range _beag ._eecc .Styles .Styles (){if _gbbab .StyleID ()!=styleID {continue ;};if _gbbab .Type ()!=_fgg .ST_StyleTypeCharacter {return Run {},_cf .Errorf ("\u0073\u0074\u0079\u006c\u0065\u0020\u0025\u0073\u0020i\u0073\u006e'\u0074 \u0061\u0020\u0063\u0068\u0061\u0072\u0061\u0063\u0074\u0065\u0072\u0020\u0073\u0074\u0079\u006c\u0065",styleID );};_cgbfe =true ;break ;};if !_cgbfe {return Run {},_cf .Errorf ("\u0073\u0074\u0079\u006c\u0065\u0020\u0025\u0073\u0020\u0064\u006f\u0065\u0073\u006e\u0027\u0074\u0020\u0065\u0078\u0069\u0073\u0074\u0020i\u006e\u0020\u0074\u0068\u0065\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074",styleID );};_abbdc :=_beag .AddRun ();_abbdc .Properties ().SetStyle (styleID );return _abbdc ,nil ;};
+
 // SetWidthAuto sets the the table width to automatic.
 func (_bdef TableProperties )SetWidthAuto (){_bdef ._caea .TblW =_fgg .NewCT_TblWidth ();_bdef ._caea .TblW .TypeAttr =_fgg .ST_TblWidthAuto ;};
 
+// AddSubscript adds a subscript, returning its base and subscript.
+func (_cgfad MathArgument )AddSubscript ()(base ,sub MathArgument ){_faca :=_age .NewCT_SSub ();_cgfad .add ().SSub =_faca ;return MathArgument {&_faca .E .EG_OMathMathElements },MathArgument {&_faca .Sub .EG_OMathMathElements };};
+
 // SetColumnBandSize sets the number of Columns in the column band
 func (_adfg TableStyleProperties )SetColumnBandSize (cols int64 ){_adfg ._fbbc .TblStyleColBandSize =_fgg .NewCT_DecimalNumber ();_adfg ._fbbc .TblStyleColBandSize .ValAttr =cols ;};func (_gfac Endnote )id ()int64 {return _gfac ._dfb .IdAttr };
 
@@ -845,36 +1489,101 @@ type TableProperties struct{_caea *_fgg .CT_TblPr };
 // Bookmarks returns all of the bookmarks defined in the document.
 func (_bfad Document )Bookmarks ()[]Bookmark {if _bfad ._cdaa .Body ==nil {return nil ;};_dgbb :=[]Bookmark {};for _ ,_gbfb :=range _bfad ._cdaa .Body .EG_BlockLevelElts {for _ ,_gbec :=range _gbfb .EG_ContentBlockContent {for _ ,_afd :=range _gbe (_gbec ){_dgbb =append (_dgbb ,_afd );};};};return _dgbb ;};
 
+// withTextBoxes returns the paragraphs with the paragraphs of the text boxes
+// within each paragraph, including nested text boxes, following it.
+func _eefag (_fdbff []Paragraph )[]Paragraph {_abgbc :=[]Paragraph {};for _ ,_bcdc :=range _fdbff {_abgbc =append (_abgbc ,_bcdc );for _ ,_dfeg :=range _bcdc .allRuns (){for _ ,_dbadf :=range _dfeg ._bfbb .EG_RunInnerContent {if _dbadf .Drawing ==nil {continue ;};_fgeg :=[]*_ed .Graphic {};for _ ,_beaef :=range _dbadf .Drawing .Anchor {_fgeg =append (_fgeg ,_beaef .Graphic );};for _ ,_gfbed :=range _dbadf .Drawing .Inline {_fgeg =append (_fgeg ,_gfbed .Graphic );};for _ ,_afefc :=range _fgeg {if _afefc ==nil ||_afefc .GraphicData ==nil {continue ;};for _ ,_bagbd :=range _afefc .GraphicData .Any {if _fddbf ,_fcgba :=_bagbd .(*textBoxShape );_fcgba &&_fddbf ._bcb !=nil {_bcadd :=[]Paragraph {};_bcdc ._eecc .appendContentParagraphs (&_bcadd ,_fddbf ._bcb .EG_ContentBlockContent );_abgbc =append (_abgbc ,_eefag (_bcadd )...);};};};};};};return _abgbc ;};
+
 // SetStrikeThrough sets the run to strike-through.
-func (_agdea RunProperties )SetStrikeThrough (b bool ){if !b {_agdea ._bfbg .Strike =nil ;}else {_agdea ._bfbg .Strike =_fgg .NewCT_OnOff ();};};
+func (_agdea RunProperties )SetStrikeThrough (b bool )RunProperties {if !b {_agdea ._bfbg .Strike =nil ;}else {_agdea ._bfbg .Strike =_fgg .NewCT_OnOff ();};return _agdea ;};
+
+// SetPreserveWhitespace controls how the xml:space attribute is written for
+// run text when the document is saved.  By default the attribute is only
+// written for text that needs it to keep leading, trailing or repeated
+// whitespace.  Passing true writes xml:space="preserve" on all text, while
+// passing false omits the attribute entirely, which may be required by readers
+// that don't handle it.  The attribute of the text in the document is updated
+// accordingly when the document is saved.
+func (_edg *Document )SetPreserveWhitespace (preserve bool ){_edg ._fcbge =_c .Bool (preserve );};
 
 // X returns the inner wrapped XML type.
 func (_bee CellProperties )X ()*_fgg .CT_TcPr {return _bee ._egf };
 
+// AddTimeField adds a TIME field to the run. If format is not empty, it is
+// used as the date-picture switch (e.g. "h:mm am/pm").
+func (_adbda Run )AddTimeField (format string ){_adbda .AddFieldWithFormatting (FieldTIme ,_dfcfe (format ),true );};func _dfcfe (_dfgc string )string {if _dfgc ==""{return "";};return "\u005c\u0040\u0020\u0022"+_dfgc +"\u0022";};
+
 // Footers returns the footers defined in the document.
 func (_ded *Document )Footers ()[]Footer {_ccf :=[]Footer {};for _ ,_abc :=range _ded ._eefb {_ccf =append (_ccf ,Footer {_ded ,_abc });};return _ccf ;};
 
 // SetVerticalBanding controls the conditional formatting for vertical banding.
 func (_daeb TableLook )SetVerticalBanding (on bool ){if !on {_daeb ._gagb .NoVBandAttr =&_fg .ST_OnOff {};_daeb ._gagb .NoVBandAttr .ST_OnOff1 =_fg .ST_OnOff1On ;}else {_daeb ._gagb .NoVBandAttr =&_fg .ST_OnOff {};_daeb ._gagb .NoVBandAttr .ST_OnOff1 =_fg .ST_OnOff1Off ;};};
 
+// watermarkHeaders returns the headers that a watermark is added to.  Sections
+// without a default header use the header of the previous section, so a
+// default header is only created when the first section of the document
+// doesn't have one.
+func (_bgbcb *Document )watermarkHeaders ()[]Header {_gcgbg :=_bgbcb .Headers ();_eabfe :=_bgbcb .BodySection ();for _ ,_ebeec :=range _bgbcb .bodyParagraphs (){if _ebeec .X ().PPr !=nil &&_ebeec .X ().PPr .SectPr !=nil {_eabfe =Section {_bgbcb ,_ebeec .X ().PPr .SectPr };break ;};};for _ ,_ffggc :=range _eabfe .X ().EG_HdrFtrReferences {if _ffggc .HeaderReference !=nil &&(_ffggc .HeaderReference .TypeAttr ==_fgg .ST_HdrFtrDefault ||_ffggc .HeaderReference .TypeAttr ==_fgg .ST_HdrFtrUnset ){return _gcgbg ;};};_cbac :=_bgbcb .AddHeader ();_eabfe .SetHeader (_cbac ,_fgg .ST_HdrFtrDefault );return append (_gcgbg ,_cbac );};
+
 // AddParagraph adds a paragraph to the endnote.
 func (_cbbd Endnote )AddParagraph ()Paragraph {_beba :=_fgg .NewEG_ContentBlockContent ();_agf :=len (_cbbd ._dfb .EG_BlockLevelElts [0].EG_ContentBlockContent );_cbbd ._dfb .EG_BlockLevelElts [0].EG_ContentBlockContent =append (_cbbd ._dfb .EG_BlockLevelElts [0].EG_ContentBlockContent ,_beba );_eefe :=_fgg .NewCT_P ();var _cfab *_fgg .CT_String ;if _agf !=0{_cdad :=len (_cbbd ._dfb .EG_BlockLevelElts [0].EG_ContentBlockContent [_agf -1].P );_cfab =_cbbd ._dfb .EG_BlockLevelElts [0].EG_ContentBlockContent [_agf -1].P [_cdad -1].PPr .PStyle ;}else {_cfab =_fgg .NewCT_String ();_cfab .ValAttr ="\u0045n\u0064\u006e\u006f\u0074\u0065";};_beba .P =append (_beba .P ,_eefe );_cafea :=Paragraph {_cbbd ._cfba ,_eefe };_cafea ._cfdb .PPr =_fgg .NewCT_PPr ();_cafea ._cfdb .PPr .PStyle =_cfab ;_cafea ._cfdb .PPr .RPr =_fgg .NewCT_ParaRPr ();return _cafea ;};
 
 // SetVAlignment sets the vertical alignment for an anchored image.
 func (_ab AnchoredDrawing )SetVAlignment (v _fgg .WdST_AlignV ){_ab ._gd .PositionV .Choice =&_fgg .WdCT_PosVChoice {};_ab ._gd .PositionV .Choice .Align =v ;};
 
-// SetOutlineLevel sets the outline level of this style.
-func (_fddb ParagraphStyleProperties )SetOutlineLevel (lvl int ){_fddb ._bgca .OutlineLvl =_fgg .NewCT_DecimalNumber ();_fddb ._bgca .OutlineLvl .ValAttr =int64 (lvl );};
+// SetOutlineLevel sets the zero based outline level of this style, with 0 to 8
+// corresponding to levels 1 to 9 and 9 to body text.  Levels outside of that
+// range are clamped to it.
+func (_fddb ParagraphStyleProperties )SetOutlineLevel (lvl int ){if lvl < 0{lvl =0;}else if lvl > 9{lvl =9;};_fddb ._bgca .OutlineLvl =_fgg .NewCT_DecimalNumber ();_fddb ._bgca .OutlineLvl .ValAttr =int64 (lvl );};
+
+// ErrInvalidNumberingLevel is returned when a paragraph numbering level outside
+// the range 0 to 8 is set.
+var ErrInvalidNumberingLevel =_ef .New ("\u006e\u0075\u006d\u0062\u0065\u0072\u0069\u006e\u0067\u0020\u006c\u0065\u0076\u0065\u006c\u0020\u006d\u0075\u0073\u0074\u0020\u0062\u0065\u0020\u0062\u0065\u0074\u0077\u0065\u0065\u006e\u0020\u0030\u0020\u0061\u006e\u0064\u0020\u0038");
+
+// ErrInvalidOutlineLevel is returned when a paragraph outline level above 9 is
+// set.
+var ErrInvalidOutlineLevel =_ef .New ("\u006f\u0075t\u006c\u0069\u006e\u0065\u0020\u006c\u0065\u0076e\u006c \u006du\u0073t\u0020b\u0065\u0020\u0062\u0065\u0074\u0077\u0065\u0065\u006e\u0020\u0030\u0020\u0061\u006e\u0064\u0020\u0039");
+
+// X returns the inner wrapped XML type of a simple field, or nil for a complex
+// field.
+func (_ebaab Field )X ()*_fgg .CT_SimpleField {return _ebaab ._ffa };
+
+// Runs returns all of the runs in the header's paragraphs, including those
+// within tables, hyperlinks, simple fields and content controls.
+func (_dgdfc Header )Runs ()[]Run {_feggb :=[]Run {};for _ ,_dgece :=range _dgdfc .Paragraphs (){_feggb =append (_feggb ,_dgece .allRuns ()...);};return _feggb ;};
 
 // Open opens and reads a document from a file (.docx).
 func Open (filename string )(*Document ,error ){_aece ,_fcgb :=_cd .Open (filename );if _fcgb !=nil {return nil ,_cf .Errorf ("e\u0072r\u006f\u0072\u0020\u006f\u0070\u0065\u006e\u0069n\u0067\u0020\u0025\u0073: \u0025\u0073",filename ,_fcgb );};defer _aece .Close ();_dgee ,_fcgb :=_cd .Stat (filename );if _fcgb !=nil {return nil ,_cf .Errorf ("e\u0072r\u006f\u0072\u0020\u006f\u0070\u0065\u006e\u0069n\u0067\u0020\u0025\u0073: \u0025\u0073",filename ,_fcgb );};_ =_dgee ;return Read (_aece ,_dgee .Size ());};
 
+// NewRun creates a run from spec that isn't part of any paragraph.  It can be
+// added to a paragraph with Paragraph.AppendRun.
+func NewRun (spec RunSpec )Run {_fccf :=Run {nil ,_fgg .NewCT_R ()};_feafe :=_fccf .Properties ();if spec .Style !=""{_feafe .SetStyle (spec .Style );};if spec .Bold {_feafe .SetBold (true );};if spec .Italic {_feafe .SetItalic (true );};if spec .Underline !=_fgg .ST_UnderlineUnset {_feafe .SetUnderline (spec .Underline ,_bbd .Auto );};if spec .Color !=(_bbd .Color {}){_feafe .SetColor (spec .Color );};if spec .Size !=0{_feafe .SetSize (spec .Size );};if spec .FontFamily !=""{_feafe .SetFontFamily (spec .FontFamily );};if spec .Highlight !=_fgg .ST_HighlightColorUnset {_feafe .SetHighlight (spec .Highlight );};_fccf .removeEmptyRPr ();if spec .Text !=""{_fccf .replaceText (spec .Text );};for _ceaae :=0;_ceaae < spec .Breaks ;_ceaae ++{_fccf .AddBreak ();};return _fccf ;};
+
+// Runs returns all of the runs in the footer's paragraphs, including those
+// within tables, hyperlinks, simple fields and content controls.
+func (_eefca Footer )Runs ()[]Run {_ddgb :=[]Run {};for _ ,_edaec :=range _eefca .Paragraphs (){_ddgb =append (_ddgb ,_edaec .allRuns ()...);};return _ddgb ;};
+
 // AddTable adds a table to the table cell.
 func (_cge Cell )AddTable ()Table {_dge :=_fgg .NewEG_BlockLevelElts ();_cge ._gf .EG_BlockLevelElts =append (_cge ._gf .EG_BlockLevelElts ,_dge );_eeg :=_fgg .NewEG_ContentBlockContent ();_dge .EG_ContentBlockContent =append (_dge .EG_ContentBlockContent ,_eeg );_db :=_fgg .NewCT_Tbl ();_eeg .Tbl =append (_eeg .Tbl ,_db );return Table {_cge ._bcc ,_db };};
 
+// Edit validates the run and its existing run properties and returns the run
+// properties for editing.  Unlike Properties, it returns an error instead of
+// panicking for an invalid run, which is useful when processing untrusted
+// documents.  The returned RunProperties setters can be chained.
+func (_gdbcd Run )Edit ()(RunProperties ,error ){if _gdbcd ._bfbb ==nil {return RunProperties {},ErrInvalidRun ;};if _gdbcd ._bfbb .RPr !=nil {if _dgdde :=_gdbcd ._bfbb .RPr .Validate ();_dgdde !=nil {return RunProperties {},_cf .Errorf ("\u0069\u006e\u0076\u0061\u006c\u0069\u0064\u0020\u0072\u0075n\u0020\u0070\u0072\u006f\u0070\u0065\u0072\u0074i\u0065\u0073\u003a\u0020\u0025\u0073",_dgdde );};};return _gdbcd .Properties (),nil ;};
+
+// SetBetween sets the border drawn between consecutive paragraphs that have the
+// same border settings to a specified type, color and thickness.
+func (_cbab ParagraphBorders )SetBetween (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_cbab ._x .Between =_fgg .NewCT_Border ();_cafa (_cbab ._x .Between ,t ,c ,thickness );};
+
 // CharacterSpacingMeasure returns paragraph characters spacing with its measure which can be mm, cm, in, pt, pc or pi.
 func (_acbg RunProperties )CharacterSpacingMeasure ()string {if _geda :=_acbg ._bfbg .Spacing ;_geda !=nil {_agc :=_geda .ValAttr ;if _agc .ST_UniversalMeasure !=nil {return *_agc .ST_UniversalMeasure ;};};return "";};
 
+// Runs returns the runs making up the field.  For a complex field these are the
+// runs from the one containing the begin field character through the one
+// containing the end field character, which may span several paragraphs.  For a
+// simple field they are the runs holding the field result.
+func (_bcgd Field )Runs ()[]Run {return _bcgd ._dgeb };
+
 // InsertRowBefore inserts a row before another row
 func (_ccbc Table )InsertRowBefore (r Row )Row {for _efef ,_ccafg :=range _ccbc ._gaec .EG_ContentRowContent {if len (_ccafg .Tr )> 0&&r .X ()==_ccafg .Tr [0]{_cdbef :=_fgg .NewEG_ContentRowContent ();_ccbc ._gaec .EG_ContentRowContent =append (_ccbc ._gaec .EG_ContentRowContent ,nil );copy (_ccbc ._gaec .EG_ContentRowContent [_efef +1:],_ccbc ._gaec .EG_ContentRowContent [_efef :]);_ccbc ._gaec .EG_ContentRowContent [_efef ]=_cdbef ;_cbeg :=_fgg .NewCT_Row ();_cdbef .Tr =append (_cdbef .Tr ,_cbeg );return Row {_ccbc ._gcfe ,_cbeg };};};return _ccbc .AddRow ();};
 
@@ -884,9 +1593,26 @@ func (_ccbc Table )InsertRowBefore (r Row )Row {for _efef ,_ccafg :=range _ccbc
 // the content.
 func (_acdcc Settings )SetUpdateFieldsOnOpen (b bool ){if !b {_acdcc ._efag .UpdateFields =nil ;}else {_acdcc ._efag .UpdateFields =_fgg .NewCT_OnOff ();};};
 
+// AddParagraph adds a paragraph to the end of the text box.
+func (_adde TextBox )AddParagraph ()Paragraph {_aadfg :=_fgg .NewEG_ContentBlockContent ();_adde ._dffb .EG_ContentBlockContent =append (_adde ._dffb .EG_ContentBlockContent ,_aadfg );_bdfc :=_fgg .NewCT_P ();_aadfg .P =append (_aadfg .P ,_bdfc );return Paragraph {_adde ._fegb ,_bdfc };};
+
+// UnderlineColor returns the color of the run underline. If no explicit color
+// was set, color.Auto is returned.
+func (_bdbe Run )UnderlineColor ()_bbd .Color {if _adfbg :=_bdbe ._bfbb .RPr ;_adfbg !=nil &&_adfbg .U !=nil {if _ddgc :=_adfbg .U .ColorAttr ;_ddgc !=nil &&_ddgc .ST_HexColorRGB !=nil {return _bbd .FromHex (*_ddgc .ST_HexColorRGB );};};return _bbd .Auto ;};
+
+// Numbering returns the numbering instance ID and list level directly applied
+// to the paragraph.  ok is false if the paragraph has no numbering of its own;
+// numbering applied through the paragraph style isn't considered.
+func (_dabad Paragraph )Numbering ()(numID int64 ,level int ,ok bool ){if _dabad ._cfdb .PPr ==nil ||_dabad ._cfdb .PPr .NumPr ==nil ||_dabad ._cfdb .PPr .NumPr .NumId ==nil {return 0,0,false ;};if _dabad ._cfdb .PPr .NumPr .Ilvl !=nil {level =int (_dabad ._cfdb .PPr .NumPr .Ilvl .ValAttr );};return _dabad ._cfdb .PPr .NumPr .NumId .ValAttr ,level ,true ;};
+
 // X returns the inner wml.CT_TblBorders
 func (_gaeb TableBorders )X ()*_fgg .CT_TblBorders {return _gaeb ._efaad };
 
+// AddDrawingPlaceholder adds an anchored (floating) picture of the given size
+// without an image, reserving space for an image that is set later with
+// AnchoredDrawing.SetImage.
+func (_fbgcb Run )AddDrawingPlaceholder (w ,h _ce .Distance )AnchoredDrawing {_cecfg ,_ :=_fbgcb .addAnchoredPicture (_ce .ToEMU (float64 (w )),_ce .ToEMU (float64 (h )));return _cecfg ;};
+
 // SetCellSpacingAuto sets the cell spacing within a table to automatic.
 func (_cbegb TableStyleProperties )SetCellSpacingAuto (){_cbegb ._fbbc .TblCellSpacing =_fgg .NewCT_TblWidth ();_cbegb ._fbbc .TblCellSpacing .TypeAttr =_fgg .ST_TblWidthAuto ;};
 
@@ -899,6 +1625,9 @@ func (_fac Numbering )InitializeDefault (){_caec :=_fgg .NewCT_AbstractNum ();_c
 // X returns the inner wrapped XML type.
 func (_befg Settings )X ()*_fgg .Settings {return _befg ._efag };type mergeFieldInfo struct{_bgcb string ;_aeg string ;_deaa string ;_fffa bool ;_cab bool ;_fbfg bool ;_ecca bool ;_geff Paragraph ;_adae ,_bac ,_afce int ;_aaa *_fgg .EG_PContent ;_beca bool ;};const _aagd ="\u0046\u006f\u0072\u006d\u0046\u0069\u0065l\u0064\u0054\u0079\u0070\u0065\u0055\u006e\u006b\u006e\u006f\u0077\u006e\u0046\u006fr\u006dF\u0069\u0065\u006c\u0064\u0054\u0079p\u0065\u0054\u0065\u0078\u0074\u0046\u006fr\u006d\u0046\u0069\u0065\u006c\u0064\u0054\u0079\u0070\u0065\u0043\u0068\u0065\u0063\u006b\u0042\u006f\u0078\u0046\u006f\u0072\u006d\u0046i\u0065\u006c\u0064\u0054\u0079\u0070\u0065\u0044\u0072\u006f\u0070\u0044\u006fw\u006e";
 
+// TextBox is a floating text box created with Run.AddTextBox.
+type TextBox struct{_fegb *Document ;_gecc *_fgg .WdAnchor ;_dffb *_fgg .CT_TxbxContent ;};
+
 // Index returns the index of the header within the document.  This is used to
 // form its zip packaged filename as well as to match it with its relationship
 // ID.
@@ -914,8 +1643,33 @@ func (_dga Header )Paragraphs ()[]Paragraph {_ecbe :=[]Paragraph {};for _ ,_dace
 // Section is the beginning of a new section.
 type Section struct{_dbcd *Document ;_egcf *_fgg .CT_SectPr ;};
 
-// SetColor sets the text color.
-func (_gdfd RunProperties )SetColor (c _bbd .Color ){_gdfd ._bfbg .Color =_fgg .NewCT_Color ();_gdfd ._bfbg .Color .ValAttr .ST_HexColorRGB =c .AsRGBString ();};
+// ContainsField returns true if the run contains a field character or field
+// instruction, i.e. it is part of a complex field.
+func (_bagb Run )ContainsField ()bool {for _ ,_dabcg :=range _bagb ._bfbb .EG_RunInnerContent {if _dabcg .FldChar !=nil ||_dabcg .InstrText !=nil {return true ;};};return false ;};
+
+// drawingCharts returns the chart references of the drawing.
+func _agga (_fbea *_fgg .CT_Drawing )[]*_dcd .Chart {_dbef :=[]*_dcd .Chart {};for _ ,_afgae :=range _fbea .Anchor {if _afgae .Graphic !=nil &&_afgae .Graphic .GraphicData !=nil {for _ ,_ddbcf :=range _afgae .Graphic .GraphicData .Any {if _daede ,_eggg :=_ddbcf .(*_dcd .Chart );_eggg {_dbef =append (_dbef ,_daede );};};};};for _ ,_gegfe :=range _fbea .Inline {if _gegfe .Graphic !=nil &&_gegfe .Graphic .GraphicData !=nil {for _ ,_fbda :=range _gegfe .Graphic .GraphicData .Any {if _bgfef ,_feda :=_fbda .(*_dcd .Chart );_feda {_dbef =append (_dbef ,_bgfef );};};};};return _dbef ;};
+
+// AddPageNumberField adds a PAGE field to the run that displays the current
+// page number.
+func (_gada Run )AddPageNumberField (){_gada .AddFieldWithFormatting (FieldCurrentPage ,"",true )};
+
+// SetImage sets the image displayed by the drawing, replacing any existing
+// image.  The image is stretched to fill the drawing, whose size is left
+// unchanged.  It is typically used to fill a drawing created with
+// Run.AddDrawingPlaceholder.  An error is returned if the drawing doesn't belong
+// to a document, for example when it was added to a run created with NewRun.
+func (_eggb AnchoredDrawing )SetImage (img _aeb .ImageRef )error {if _eggb ._da ==nil {return _ef .New ("dr\u0061\u0077\u0069n\u0067\u0020\u006d\u0075\u0073\u0074\u0020\u0062\u0065\u006c\u006f\u006eg\u0020\u0074o\u0020\u0061\u0020\u0064\u006f\u0063\u0075\u006dent");};if !_eggb ._da .hasImage (img ){return ErrImageNotFound ;};if img .RelID ()==""{return ErrImageRelationMissing ;};if _eggb ._gd .Graphic ==nil ||_eggb ._gd .Graphic .GraphicData ==nil {return _ef .New ("\u0064\u0072\u0061\u0077\u0069\u006eg\u0020\u0064\u006f\u0065\u0073\u006e'\u0074\u0020c\u006f\u006e\u0074\u0061\u0069\u006e\u0020a \u0070\u0069\u0063\u0074\u0075re");};for _ ,_cbaa :=range _eggb ._gd .Graphic .GraphicData .Any {if _eadgb ,_ccgea :=_cbaa .(*_cde .Pic );_ccgea {if _eadgb .BlipFill ==nil {_eadgb .BlipFill =_ed .NewCT_BlipFillProperties ();_eadgb .BlipFill .Stretch =_ed .NewCT_StretchInfoProperties ();_eadgb .BlipFill .Stretch .FillRect =_ed .NewCT_RelativeRect ();};_fcda :=img .RelID ();_eadgb .BlipFill .Blip =_ed .NewCT_Blip ();_eadgb .BlipFill .Blip .EmbedAttr =&_fcda ;return nil ;};};return _ef .New ("\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u0020\u0064\u006fes\u006e\u0027t\u0020c\u006f\u006e\u0074\u0061\u0069\u006e\u0020\u0061 \u0070\u0069c\u0074u\u0072\u0065");};
+
+// SetColor sets the text color. Passing color.Auto sets the automatic color.
+func (_gdfd RunProperties )SetColor (c _bbd .Color )RunProperties {_gdfd ._bfbg .Color =_fgg .NewCT_Color ();Color {_gdfd ._bfbg .Color }.SetColor (c );return _gdfd ;};
+
+// AddTextBox adds a floating text box of the given size to the run.  The text
+// box has a white fill and a thin black border and is initially positioned at
+// the top left of the page with square wrapping; use TextBox.AnchoredDrawing
+// to change its placement.  The text box is written without a VML fallback,
+// so it is only displayed by Word 2010 and later.
+func (_ccedd Run )AddTextBox (w ,h _ce .Distance )TextBox {_ebacc :=_ccedd .newIC ();_ebacc .Drawing =_fgg .NewCT_Drawing ();_efadf :=_fgg .NewWdAnchor ();_efadf .SimplePosAttr =_c .Bool (false );_efadf .AllowOverlapAttr =true ;_efadf .CNvGraphicFramePr =_ed .NewCT_NonVisualGraphicFrameProperties ();_ebacc .Drawing .Anchor =append (_ebacc .Drawing .Anchor ,_efadf );_efadf .Graphic =_ed .NewGraphic ();_efadf .Graphic .GraphicData =_ed .NewCT_GraphicalObjectData ();_efadf .Graphic .GraphicData .UriAttr ="\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063h\u0065\u006d\u0061s\u002e\u006di\u0063\u0072\u006f\u0073\u006f\u0066\u0074\u002e\u0063\u006f\u006d\u002f\u006f\u0066f\u0069\u0063\u0065\u002f\u0077\u006f\u0072\u0064\u002f\u0032\u0030\u00310\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069\u006e\u0067\u0053\u0068\u0061\u0070\u0065";_efadf .SimplePos .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_efadf .SimplePos .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_efadf .PositionH .RelativeFromAttr =_fgg .WdST_RelFromHPage ;_efadf .PositionH .Choice =&_fgg .WdCT_PosHChoice {};_efadf .PositionH .Choice .PosOffset =_c .Int32 (0);_efadf .PositionV .RelativeFromAttr =_fgg .WdST_RelFromVPage ;_efadf .PositionV .Choice =&_fgg .WdCT_PosVChoice {};_efadf .PositionV .Choice .PosOffset =_c .Int32 (0);_dbaff :=_ce .ToEMU (float64 (w ));_adea :=_ce .ToEMU (float64 (h ));_efadf .Extent .CxAttr =_dbaff ;_efadf .Extent .CyAttr =_adea ;_efadf .Choice =&_fgg .WdEG_WrapTypeChoice {};_efadf .Choice .WrapSquare =_fgg .NewWdCT_WrapSquare ();_efadf .Choice .WrapSquare .WrapTextAttr =_fgg .WdST_WrapTextBothSides ;_efadf .DocPr .IdAttr =0x7FFFFFFF&_g .Uint32 ();_cdcae :=&textBoxShape {_ed .NewCT_ShapeProperties (),_fgg .NewCT_TxbxContent (),nil };_cdcae ._cebg .Xfrm =_ed .NewCT_Transform2D ();_cdcae ._cebg .Xfrm .Off =_ed .NewCT_Point2D ();_cdcae ._cebg .Xfrm .Off .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_cdcae ._cebg .Xfrm .Off .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_cdcae ._cebg .Xfrm .Ext =_ed .NewCT_PositiveSize2D ();_cdcae ._cebg .Xfrm .Ext .CxAttr =_dbaff ;_cdcae ._cebg .Xfrm .Ext .CyAttr =_adea ;_cdcae ._cebg .PrstGeom =_ed .NewCT_PresetGeometry2D ();_cdcae ._cebg .PrstGeom .PrstAttr =_ed .ST_ShapeTypeRect ;_cdcae ._cebg .SolidFill =_ed .NewCT_SolidColorFillProperties ();_cdcae ._cebg .SolidFill .SrgbClr =_ed .NewCT_SRgbColor ();_cdcae ._cebg .SolidFill .SrgbClr .ValAttr ="\u0046\u0046\u0046\u0046\u0046\u0046";_cdcae ._cebg .Ln =_ed .NewCT_LineProperties ();_cdcae ._cebg .Ln .WAttr =_c .Int32 (int32 (_ce .ToEMU (0.5*_ce .Point )));_cdcae ._cebg .Ln .SolidFill =_ed .NewCT_SolidColorFillProperties ();_cdcae ._cebg .Ln .SolidFill .SrgbClr =_ed .NewCT_SRgbColor ();_cdcae ._cebg .Ln .SolidFill .SrgbClr .ValAttr ="\u0030\u0030\u00300\u0030\u0030";_efadf .Graphic .GraphicData .Any =append (_efadf .Graphic .GraphicData .Any ,_cdcae );return TextBox {_ccedd ._adbf ,_efadf ,_cdcae ._bcb };};
 
 // ComplexSizeValue returns the value of run font size for complex fonts in points.
 func (_fceaf RunProperties )ComplexSizeValue ()float64 {if _eaac :=_fceaf ._bfbg .SzCs ;_eaac !=nil {_gffe :=_eaac .ValAttr ;if _gffe .ST_UnsignedDecimalNumber !=nil {return float64 (*_gffe .ST_UnsignedDecimalNumber )/2;};};return 0.0;};
@@ -925,11 +1679,17 @@ func (_cgafc RunProperties )ItalicValue ()OnOffValue {return _aafe (_cgafc ._bfb
 
 // SetVerticalAlignment controls the vertical alignment of the run, this is used
 // to control if text is superscript/subscript.
-func (_gebd RunProperties )SetVerticalAlignment (v _fg .ST_VerticalAlignRun ){if v ==_fg .ST_VerticalAlignRunUnset {_gebd ._bfbg .VertAlign =nil ;}else {_gebd ._bfbg .VertAlign =_fgg .NewCT_VerticalAlignRun ();_gebd ._bfbg .VertAlign .ValAttr =v ;};};func (_aff *Document )insertParagraph (_afbdb Paragraph ,_abeb bool )Paragraph {if _aff ._cdaa .Body ==nil {return _aff .AddParagraph ();};_dgec :=_afbdb .X ();for _ ,_egfd :=range _aff ._cdaa .Body .EG_BlockLevelElts {for _ ,_bcf :=range _egfd .EG_ContentBlockContent {for _fcea ,_bddg :=range _bcf .P {if _bddg ==_dgec {_dddc :=_fgg .NewCT_P ();_bcf .P =append (_bcf .P ,nil );if _abeb {copy (_bcf .P [_fcea +1:],_bcf .P [_fcea :]);_bcf .P [_fcea ]=_dddc ;}else {copy (_bcf .P [_fcea +2:],_bcf .P [_fcea +1:]);_bcf .P [_fcea +1]=_dddc ;};return Paragraph {_aff ,_dddc };};};for _ ,_efg :=range _bcf .Tbl {for _ ,_cedb :=range _efg .EG_ContentRowContent {for _ ,_dgca :=range _cedb .Tr {for _ ,_aad :=range _dgca .EG_ContentCellContent {for _ ,_ccgg :=range _aad .Tc {for _ ,_eacge :=range _ccgg .EG_BlockLevelElts {for _ ,_cbd :=range _eacge .EG_ContentBlockContent {for _gge ,_cdb :=range _cbd .P {if _cdb ==_dgec {_begb :=_fgg .NewCT_P ();_cbd .P =append (_cbd .P ,nil );if _abeb {copy (_cbd .P [_gge +1:],_cbd .P [_gge :]);_cbd .P [_gge ]=_begb ;}else {copy (_cbd .P [_gge +2:],_cbd .P [_gge +1:]);_cbd .P [_gge +1]=_begb ;};return Paragraph {_aff ,_begb };};};};};};};};};};if _bcf .Sdt !=nil &&_bcf .Sdt .SdtContent !=nil &&_bcf .Sdt .SdtContent .P !=nil {for _gcff ,_edd :=range _bcf .Sdt .SdtContent .P {if _edd ==_dgec {_def :=_fgg .NewCT_P ();_bcf .Sdt .SdtContent .P =append (_bcf .Sdt .SdtContent .P ,nil );if _abeb {copy (_bcf .Sdt .SdtContent .P [_gcff +1:],_bcf .Sdt .SdtContent .P [_gcff :]);_bcf .Sdt .SdtContent .P [_gcff ]=_def ;}else {copy (_bcf .Sdt .SdtContent .P [_gcff +2:],_bcf .Sdt .SdtContent .P [_gcff +1:]);_bcf .Sdt .SdtContent .P [_gcff +1]=_def ;};return Paragraph {_aff ,_def };};};};};};return _aff .AddParagraph ();};
+func (_gebd RunProperties )SetVerticalAlignment (v _fg .ST_VerticalAlignRun )RunProperties {if v ==_fg .ST_VerticalAlignRunUnset {_gebd ._bfbg .VertAlign =nil ;}else {_gebd ._bfbg .VertAlign =_fgg .NewCT_VerticalAlignRun ();_gebd ._bfbg .VertAlign .ValAttr =v ;};return _gebd ;};func (_aff *Document )insertParagraph (_afbdb Paragraph ,_abeb bool )Paragraph {if _aff ._cdaa .Body ==nil {return _aff .AddParagraph ();};_dgec :=_afbdb .X ();for _ ,_egfd :=range _aff ._cdaa .Body .EG_BlockLevelElts {for _ ,_bcf :=range _egfd .EG_ContentBlockContent {for _fcea ,_bddg :=range _bcf .P {if _bddg ==_dgec {_dddc :=_fgg .NewCT_P ();_bcf .P =append (_bcf .P ,nil );if _abeb {copy (_bcf .P [_fcea +1:],_bcf .P [_fcea :]);_bcf .P [_fcea ]=_dddc ;}else {copy (_bcf .P [_fcea +2:],_bcf .P [_fcea +1:]);_bcf .P [_fcea +1]=_dddc ;};return Paragraph {_aff ,_dddc };};};for _ ,_efg :=range _bcf .Tbl {for _ ,_cedb :=range _efg .EG_ContentRowContent {for _ ,_dgca :=range _cedb .Tr {for _ ,_aad :=range _dgca .EG_ContentCellContent {for _ ,_ccgg :=range _aad .Tc {for _ ,_eacge :=range _ccgg .EG_BlockLevelElts {for _ ,_cbd :=range _eacge .EG_ContentBlockContent {for _gge ,_cdb :=range _cbd .P {if _cdb ==_dgec {_begb :=_fgg .NewCT_P ();_cbd .P =append (_cbd .P ,nil );if _abeb {copy (_cbd .P [_gge +1:],_cbd .P [_gge :]);_cbd .P [_gge ]=_begb ;}else {copy (_cbd .P [_gge +2:],_cbd .P [_gge +1:]);_cbd .P [_gge +1]=_begb ;};return Paragraph {_aff ,_begb };};};};};};};};};};if _bcf .Sdt !=nil &&_bcf .Sdt .SdtContent !=nil &&_bcf .Sdt .SdtContent .P !=nil {for _gcff ,_edd :=range _bcf .Sdt .SdtContent .P {if _edd ==_dgec {_def :=_fgg .NewCT_P ();_bcf .Sdt .SdtContent .P =append (_bcf .Sdt .SdtContent .P ,nil );if _abeb {copy (_bcf .Sdt .SdtContent .P [_gcff +1:],_bcf .Sdt .SdtContent .P [_gcff :]);_bcf .Sdt .SdtContent .P [_gcff ]=_def ;}else {copy (_bcf .Sdt .SdtContent .P [_gcff +2:],_bcf .Sdt .SdtContent .P [_gcff +1:]);_bcf .Sdt .SdtContent .P [_gcff +1]=_def ;};return Paragraph {_aff ,_def };};};};};};return _aff .AddParagraph ();};
 
 // SetYOffset sets the Y offset for an image relative to the origin.
 func (_edb AnchoredDrawing )SetYOffset (y _ce .Distance ){_edb ._gd .PositionV .Choice =&_fgg .WdCT_PosVChoice {};_edb ._gd .PositionV .Choice .PosOffset =_c .Int32 (int32 (y /_ce .EMU ));};
 
+// mergeLanguage returns a copy of dst with the attributes set in src applied.
+func _edgce (_fdad ,_eda *_fgg .CT_Language )*_fgg .CT_Language {if _eda ==nil {return _fdad ;};_cbgbb :=_fgg .NewCT_Language ();if _fdad !=nil {*_cbgbb =*_fdad ;};if _eda .ValAttr !=nil {_cbgbb .ValAttr =_eda .ValAttr ;};if _eda .EastAsiaAttr !=nil {_cbgbb .EastAsiaAttr =_eda .EastAsiaAttr ;};if _eda .BidiAttr !=nil {_cbgbb .BidiAttr =_eda .BidiAttr ;};return _cbgbb ;};
+
+// Paragraph returns the paragraph containing the start of the field.
+func (_abbab Field )Paragraph ()Paragraph {return _abbab ._ffcf };
+
 // Borders allows controlling individual cell borders.
 func (_eed CellProperties )Borders ()CellBorders {if _eed ._egf .TcBorders ==nil {_eed ._egf .TcBorders =_fgg .NewCT_TcBorders ();};return CellBorders {_eed ._egf .TcBorders };};
 
@@ -942,6 +1702,14 @@ func (_aada Header )AddParagraph ()Paragraph {_eadc :=_fgg .NewEG_ContentBlockCo
 // Outline returns true if paragraph outline is on.
 func (_aebe ParagraphProperties )Outline ()bool {return _aeege (_aebe ._fdfc .RPr .Outline )};
 
+// SetProofingState controls whether spelling and grammar checking apply to the
+// run without changing its language, so a foreign language tag is kept for
+// rendering and hyphenation.  WordprocessingML can only exclude a run from
+// proofing as a whole, so the run is checked only if both spelling and grammar
+// are true; otherwise the no proofing property is set.  Spelling or grammar
+// errors can be hidden for the whole document through the document settings.
+func (_cgggf Run )SetProofingState (spelling ,grammar bool )Run {if spelling &&grammar {if _cgggf ._bfbb .RPr !=nil {_cgggf ._bfbb .RPr .NoProof =nil ;_cgggf .removeEmptyRPr ();};return _cgggf ;};_cgggf .Properties ().X ().NoProof =_fgg .NewCT_OnOff ();return _cgggf ;};
+
 // Clear clears all content within a footer
 func (_ddbe Footer )Clear (){_ddbe ._baba .EG_ContentBlockContent =nil };
 
@@ -957,15 +1725,34 @@ func (_cba Section )SetHeader (h Header ,t _fgg .ST_HdrFtr ){_ffge :=_fgg .NewEG
 // AnchoredDrawing is an absolutely positioned image within a document page.
 type AnchoredDrawing struct{_da *Document ;_gd *_fgg .WdAnchor ;};
 
+// AddPageOfPages adds the text "Page X of Y" to the run where X is a PAGE
+// field and Y is a NUMPAGES field.
+func (_daea Run )AddPageOfPages (){_daea .AddText ("\u0050\u0061\u0067e\u0020");_daea .AddPageNumberField ();_daea .AddText ("\u0020\u006f\u0066 ");_daea .AddTotalPagesField ();};
+
 // Caps returns true if paragraph font is capitalized.
 func (_gaag ParagraphProperties )Caps ()bool {return _aeege (_gaag ._fdfc .RPr .Caps )};
 
+// SetLeft sets the left border to a specified type, color and thickness.
+func (_gbcdd ParagraphBorders )SetLeft (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_gbcdd ._x .Left =_fgg .NewCT_Border ();_cafa (_gbcdd ._x .Left ,t ,c ,thickness );};
+
 // RemoveParagraph removes a paragraph from a document.
 func (_aecf *Document )RemoveParagraph (p Paragraph ){if _aecf ._cdaa .Body ==nil {return ;};for _ ,_cgbb :=range _aecf ._cdaa .Body .EG_BlockLevelElts {for _ ,_fdc :=range _cgbb .EG_ContentBlockContent {for _ebcc ,_fcgc :=range _fdc .P {if _fcgc ==p ._cfdb {copy (_fdc .P [_ebcc :],_fdc .P [_ebcc +1:]);_fdc .P =_fdc .P [0:len (_fdc .P )-1];return ;};};if _fdc .Sdt !=nil &&_fdc .Sdt .SdtContent !=nil &&_fdc .Sdt .SdtContent .P !=nil {for _ecc ,_aaea :=range _fdc .Sdt .SdtContent .P {if _aaea ==p ._cfdb {copy (_fdc .P [_ecc :],_fdc .P [_ecc +1:]);_fdc .P =_fdc .P [0:len (_fdc .P )-1];return ;};};};};};};
 
+var _gddgf =_ddc .MustCompile (`(?i)\b(?:https?://|www\.)[^\s<>"]+|[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,}`);func _edfga (_dgac *_fgg .CT_RPr )*_fgg .CT_RPr {if _dgac ==nil {return nil ;};_eedeb :=_fgg .NewCT_RPr ();if _fbcbb :=_eedf (_eedeb ,_dgac ,"\u0077\u003a\u0072\u0050r");_fbcbb !=nil {return nil ;};return _eedeb ;};func (_effb *Document )findRun (_gdac *_fgg .CT_R )(*_fgg .CT_P ,int ,int ,bool ){for _ ,_daba :=range _effb .Paragraphs (){for _efece ,_eacag :=range _daba ._cfdb .EG_PContent {for _eabf ,_gbfa :=range _eacag .EG_ContentRunContent {if _gbfa .R ==_gdac {return _daba ._cfdb ,_efece ,_eabf ,true ;};};};};return nil ,0,0,false ;};
+
+// AppendRun adds the run to the end of the paragraph and returns it attached
+// to the paragraph's document. It returns an error if the run is already part
+// of the paragraph.  A run must not be appended to more than one paragraph, as
+// the paragraphs would then share the same underlying element.
+func (_acea Paragraph )AppendRun (r Run )(Run ,error ){if r ._bfbb ==nil {return Run {},ErrInvalidRun ;};for _ ,_gaed :=range _acea .allRuns (){if _gaed ._bfbb ==r ._bfbb {return Run {},_ef .New ("r\u0075\u006e\u0020\u0069\u0073 \u0061\u006c\u0072\u0065\u0061\u0064\u0079 \u0070\u0061\u0072\u0074\u0020\u006f\u0066\u0020\u0061\u0020\u0070\u0061\u0072\u0061\u0067\u0072\u0061\u0070\u0068");};};_dbbgg :=_fgg .NewEG_PContent ();_faaaa :=_fgg .NewEG_ContentRunContent ();_faaaa .R =r ._bfbb ;_dbbgg .EG_ContentRunContent =append (_dbbgg .EG_ContentRunContent ,_faaaa );_acea ._cfdb .EG_PContent =append (_acea ._cfdb .EG_PContent ,_dbbgg );return Run {_acea ._eecc ,r ._bfbb },nil ;};
+
 // X returns the internally wrapped *wml.CT_SectPr.
 func (_aagb Section )X ()*_fgg .CT_SectPr {return _aagb ._egcf };
 
+// imageIndex returns the index of the image within Images, or -1 if the image
+// wasn't added to the document.
+func (_dbbd *Document )imageIndex (_aabbb _aeb .ImageRef )int {for _cgegb ,_eaegb :=range _dbbd .Images {if _eaegb .Data ()==_aabbb .Data ()&&_eaegb .Path ()==_aabbb .Path ()&&_eaegb .Format ()==_aabbb .Format ()&&_eaegb .Size ()==_aabbb .Size (){return _cgegb ;};};return -1;};
+
 // SetEastAsiaTheme sets the font East Asia Theme.
 func (_bgeb Fonts )SetEastAsiaTheme (t _fgg .ST_Theme ){_bgeb ._ddg .EastAsiaThemeAttr =t };
 
@@ -1009,11 +1796,13 @@ func (_ceecb Run )Clear (){_ceecb ._bfbb .EG_RunInnerContent =nil };
 func (_adcb Footer )Tables ()[]Table {_ecg :=[]Table {};if _adcb ._baba ==nil {return nil ;};for _ ,_bgfc :=range _adcb ._baba .EG_ContentBlockContent {for _ ,_cgce :=range _adcb ._gbfg .tables (_bgfc ){_ecg =append (_ecg ,_cgce );};};return _ecg ;};
 
 // SetEffect sets a text effect on the run.
-func (_becfc RunProperties )SetEffect (e _fgg .ST_TextEffect ){if e ==_fgg .ST_TextEffectUnset {_becfc ._bfbg .Effect =nil ;}else {_becfc ._bfbg .Effect =_fgg .NewCT_TextEffect ();_becfc ._bfbg .Effect .ValAttr =_fgg .ST_TextEffectShimmer ;};};
+func (_becfc RunProperties )SetEffect (e _fgg .ST_TextEffect )RunProperties {if e ==_fgg .ST_TextEffectUnset {_becfc ._bfbg .Effect =nil ;}else {_becfc ._bfbg .Effect =_fgg .NewCT_TextEffect ();_becfc ._bfbg .Effect .ValAttr =_fgg .ST_TextEffectShimmer ;};return _becfc ;};
 
 // AddFieldWithFormatting adds a field (automatically computed text) to the
 // document with field specifc formatting.
-func (_efdad Run )AddFieldWithFormatting (code string ,fmt string ,isDirty bool ){_cbe :=_efdad .newIC ();_cbe .FldChar =_fgg .NewCT_FldChar ();_cbe .FldChar .FldCharTypeAttr =_fgg .ST_FldCharTypeBegin ;if isDirty {_cbe .FldChar .DirtyAttr =&_fg .ST_OnOff {};_cbe .FldChar .DirtyAttr .Bool =_c .Bool (true );};_cbe =_efdad .newIC ();_cbe .InstrText =_fgg .NewCT_Text ();if fmt !=""{_cbe .InstrText .Content =code +"\u0020"+fmt ;}else {_cbe .InstrText .Content =code ;};_cbe =_efdad .newIC ();_cbe .FldChar =_fgg .NewCT_FldChar ();_cbe .FldChar .FldCharTypeAttr =_fgg .ST_FldCharTypeEnd ;};
+// If isDirty is true, both the begin and end field characters are marked dirty
+// so that the field is updated when the document is opened.
+func (_efdad Run )AddFieldWithFormatting (code string ,fmt string ,isDirty bool ){if fmt !=""{_efdad .addField (code +"\u0020"+fmt ,isDirty );}else {_efdad .addField (code ,isDirty );};};
 
 // Properties returns the numbering level paragraph properties.
 func (_ggd NumberingLevel )Properties ()ParagraphStyleProperties {if _ggd ._cbf .PPr ==nil {_ggd ._cbf .PPr =_fgg .NewCT_PPrGeneral ();};return ParagraphStyleProperties {_ggd ._cbf .PPr };};
@@ -1034,15 +1823,25 @@ func (_faaa Endnote )X ()*_fgg .CT_FtnEdn {return _faaa ._dfb };func _cafa (_afe
 // SetLinkedStyle sets the style that this style is linked to.
 func (_ccfea Style )SetLinkedStyle (name string ){if name ==""{_ccfea ._dedd .Link =nil ;}else {_ccfea ._dedd .Link =_fgg .NewCT_String ();_ccfea ._dedd .Link .ValAttr =name ;};};
 
+// AddTotalPagesField adds a NUMPAGES field to the run that displays the total
+// number of pages in the document.
+func (_befga Run )AddTotalPagesField (){_befga .AddFieldWithFormatting (FieldNumberOfPages ,"",true )};
+
 // SetKeepOnOnePage controls if all lines in a paragraph are kept on the same
 // page.
 func (_efbba ParagraphStyleProperties )SetKeepOnOnePage (b bool ){if !b {_efbba ._bgca .KeepLines =nil ;}else {_efbba ._bgca .KeepLines =_fgg .NewCT_OnOff ();};};
 
-// SetEmboss sets the run to embossed text.
-func (_ebef RunProperties )SetEmboss (b bool ){if !b {_ebef ._bfbg .Emboss =nil ;}else {_ebef ._bfbg .Emboss =_fgg .NewCT_OnOff ();};};
+// SetEmboss sets the run to embossed text. Embossed text can't be combined with
+// imprinted, shadowed or outlined text, so those properties are cleared.
+func (_ebef RunProperties )SetEmboss (b bool )RunProperties {if !b {_ebef ._bfbg .Emboss =nil ;}else {_ebef ._bfbg .Emboss =_fgg .NewCT_OnOff ();_ebef ._bfbg .Imprint =nil ;_ebef ._bfbg .Shadow =nil ;_ebef ._bfbg .Outline =nil ;};return _ebef ;};
+
+// AddDrawingAnchoredFit adds an anchored drawing from an ImageRef, scaled to
+// fit within the maxW x maxH bounding box while preserving the aspect ratio
+// of the image.
+func (_abgge Run )AddDrawingAnchoredFit (img _aeb .ImageRef ,maxW ,maxH _ce .Distance )(AnchoredDrawing ,error ){_gcfge ,_gacb :=_abgge .AddDrawingAnchored (img );if _gacb !=nil {return _gcfge ,_gacb ;};_gcce :=img .Size ();if _gcce .X ==0||_gcce .Y ==0{return _gcfge ,nil ;};_ccdfd :=_ce .Distance (_gcce .X )*_ce .Pixel72 ;_fcdgg :=_ce .Distance (_gcce .Y )*_ce .Pixel72 ;_ggdb :=maxW /_ccdfd ;if _afgbe :=maxH /_fcdgg ;_afgbe < _ggdb {_ggdb =_afgbe ;};_gcfge .SetSize (_ccdfd *_ggdb ,_fcdgg *_ggdb );for _ ,_cddd :=range _gcfge ._gd .Graphic .GraphicData .Any {if _dgedb ,_bdffg :=_cddd .(*_cde .Pic );_bdffg &&_dgedb .SpPr !=nil &&_dgedb .SpPr .Xfrm !=nil &&_dgedb .SpPr .Xfrm .Ext !=nil {_dgedb .SpPr .Xfrm .Ext .CxAttr =_gcfge ._gd .Extent .CxAttr ;_dgedb .SpPr .Xfrm .Ext .CyAttr =_gcfge ._gd .Extent .CyAttr ;};};return _gcfge ,nil ;};
 
 // AddDrawingAnchored adds an anchored (floating) drawing from an ImageRef.
-func (_beab Run )AddDrawingAnchored (img _aeb .ImageRef )(AnchoredDrawing ,error ){_fadg :=_beab .newIC ();_fadg .Drawing =_fgg .NewCT_Drawing ();_bgeg :=_fgg .NewWdAnchor ();_fagf :=AnchoredDrawing {_beab ._adbf ,_bgeg };_bgeg .SimplePosAttr =_c .Bool (false );_bgeg .AllowOverlapAttr =true ;_bgeg .CNvGraphicFramePr =_ed .NewCT_NonVisualGraphicFrameProperties ();_fadg .Drawing .Anchor =append (_fadg .Drawing .Anchor ,_bgeg );_bgeg .Graphic =_ed .NewGraphic ();_bgeg .Graphic .GraphicData =_ed .NewCT_GraphicalObjectData ();_bgeg .Graphic .GraphicData .UriAttr ="\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065";_bgeg .SimplePos .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_bgeg .SimplePos .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_bgeg .PositionH .RelativeFromAttr =_fgg .WdST_RelFromHPage ;_bgeg .PositionH .Choice =&_fgg .WdCT_PosHChoice {};_bgeg .PositionH .Choice .PosOffset =_c .Int32 (0);_bgeg .PositionV .RelativeFromAttr =_fgg .WdST_RelFromVPage ;_bgeg .PositionV .Choice =&_fgg .WdCT_PosVChoice {};_bgeg .PositionV .Choice .PosOffset =_c .Int32 (0);_bgeg .Extent .CxAttr =int64 (float64 (img .Size ().X *_ce .Pixel72 )/_ce .EMU );_bgeg .Extent .CyAttr =int64 (float64 (img .Size ().Y *_ce .Pixel72 )/_ce .EMU );_bgeg .Choice =&_fgg .WdEG_WrapTypeChoice {};_bgeg .Choice .WrapSquare =_fgg .NewWdCT_WrapSquare ();_bgeg .Choice .WrapSquare .WrapTextAttr =_fgg .WdST_WrapTextBothSides ;_feec :=0x7FFFFFFF&_g .Uint32 ();_bgeg .DocPr .IdAttr =_feec ;_ffbd :=_cde .NewPic ();_ffbd .NvPicPr .CNvPr .IdAttr =_feec ;_cfbe :=img .RelID ();if _cfbe ==""{return _fagf ,_ef .New ("\u0063\u006f\u0075\u006c\u0064\u006e\u0027\u0074\u0020\u0066\u0069\u006e\u0064\u0020\u0072\u0065\u0066\u0065\u0072\u0065n\u0063\u0065\u0020\u0074\u006f\u0020\u0069\u006d\u0061g\u0065\u0020\u0077\u0069\u0074\u0068\u0069\u006e\u0020\u0064\u006f\u0063\u0075m\u0065\u006e\u0074\u0020\u0072\u0065l\u0061\u0074\u0069o\u006e\u0073");};_bgeg .Graphic .GraphicData .Any =append (_bgeg .Graphic .GraphicData .Any ,_ffbd );_ffbd .BlipFill =_ed .NewCT_BlipFillProperties ();_ffbd .BlipFill .Blip =_ed .NewCT_Blip ();_ffbd .BlipFill .Blip .EmbedAttr =&_cfbe ;_ffbd .BlipFill .Stretch =_ed .NewCT_StretchInfoProperties ();_ffbd .BlipFill .Stretch .FillRect =_ed .NewCT_RelativeRect ();_ffbd .SpPr =_ed .NewCT_ShapeProperties ();_ffbd .SpPr .Xfrm =_ed .NewCT_Transform2D ();_ffbd .SpPr .Xfrm .Off =_ed .NewCT_Point2D ();_ffbd .SpPr .Xfrm .Off .XAttr .ST_CoordinateUnqualified =_c .Int64 (0);_ffbd .SpPr .Xfrm .Off .YAttr .ST_CoordinateUnqualified =_c .Int64 (0);_ffbd .SpPr .Xfrm .Ext =_ed .NewCT_PositiveSize2D ();_ffbd .SpPr .Xfrm .Ext .CxAttr =int64 (img .Size ().X *_ce .Point );_ffbd .SpPr .Xfrm .Ext .CyAttr =int64 (img .Size ().Y *_ce .Point );_ffbd .SpPr .PrstGeom =_ed .NewCT_PresetGeometry2D ();_ffbd .SpPr .PrstGeom .PrstAttr =_ed .ST_ShapeTypeRect ;return _fagf ,nil ;};
+func (_beab Run )AddDrawingAnchored (img _aeb .ImageRef )(AnchoredDrawing ,error ){if _beab ._adbf ==nil {return AnchoredDrawing {},_ef .New ("\u0072\u0075\u006e\u0020m\u0075\u0073\u0074\u0020\u0062\u0065\u006c\u006fn\u0067\u0020\u0074\u006f\u0020\u0061\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074") ;};if !_beab ._adbf .hasImage (img ){return AnchoredDrawing {},ErrImageNotFound ;};if img .RelID ()==""{return AnchoredDrawing {},ErrImageRelationMissing ;};_fagf ,_ffbd :=_beab .addAnchoredPicture (_ce .PixelsToEMU (img .Size ().X ,72),_ce .PixelsToEMU (img .Size ().Y ,72));_gbag :=img .RelID ();_ffbd .BlipFill .Blip =_ed .NewCT_Blip ();_ffbd .BlipFill .Blip .EmbedAttr =&_gbag ;return _fagf ,nil ;};
 
 // X returns the inner wrapped XML type.
 func (_edfb Table )X ()*_fgg .CT_Tbl {return _edfb ._gaec };
@@ -1051,6 +1850,11 @@ func (_edfb Table )X ()*_fgg .CT_Tbl {return _edfb ._gaec };
 // This is called the 'Total' row within Word.
 func (_agcd TableLook )SetLastRow (on bool ){if !on {_agcd ._gagb .LastRowAttr =&_fg .ST_OnOff {};_agcd ._gagb .LastRowAttr .ST_OnOff1 =_fg .ST_OnOff1Off ;}else {_agcd ._gagb .LastRowAttr =&_fg .ST_OnOff {};_agcd ._gagb .LastRowAttr .ST_OnOff1 =_fg .ST_OnOff1On ;};};
 
+// runRelationshipIDs returns the relationship IDs referenced by r:id, r:embed
+// and the other attributes of the relationships namespace anywhere within the
+// run.
+func _dad (_afaa *_fgg .CT_R )([]string ,error ){_gggb :=_d .Buffer {};if _ffg :=_fda .NewEncoder (&_gggb ).Encode (_afaa );_ffg !=nil {return nil ,_ffg ;};_dbbdd :=[]string {};_bfege :=_fda .NewDecoder (&_gggb );for {_dfd ,_dfece :=_bfege .Token ();if _dfece ==_ae .EOF {return _dbbdd ,nil ;};if _dfece !=nil {return nil ,_dfece ;};if _efbbb ,_gabc :=_dfd .(_fda .StartElement );_gabc {for _ ,_dafec :=range _efbbb .Attr {if _dafec .Name .Space =="\u0072"||_dafec .Name .Space =="\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073c\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073.\u006f\u0072\u0067\u002f\u006f\u0066\u0066i\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002f\u0072\u0065\u006c\u0061\u0074\u0069\u006f\u006es\u0068\u0069\u0070\u0073"{_dbbdd =append (_dbbdd ,_dafec .Value );};};};};};
+
 // GetImageByRelID returns an ImageRef with the associated relation ID in the
 // document.
 func (_bfed *Document )GetImageByRelID (relID string )(_aeb .ImageRef ,bool ){for _ ,_bcdb :=range _bfed .Images {if _bcdb .RelID ()==relID {return _bcdb ,true ;};};return _aeb .ImageRef {},false ;};
@@ -1064,9 +1868,20 @@ func (_aebd TableBorders )SetBottom (t _fgg .ST_Border ,c _bbd .Color ,thickness
 // Borders allows manipulation of the table borders.
 func (_geadb TableProperties )Borders ()TableBorders {if _geadb ._caea .TblBorders ==nil {_geadb ._caea .TblBorders =_fgg .NewCT_TblBorders ();};return TableBorders {_geadb ._caea .TblBorders };};
 
+// SetNumbering makes the paragraph a list item using the numbering instance
+// numID (the w:numId of a w:num in numbering.xml) at the given zero based list
+// level.  A numID of zero removes any numbering inherited from the
+// paragraph's style.  ErrInvalidNumberingLevel is returned and the paragraph
+// is left unchanged if level is outside the range 0 to 8.
+func (_faeb Paragraph )SetNumbering (numID int64 ,level int )error {if level < 0||level > 8{return ErrInvalidNumberingLevel ;};_faeb .ensurePPr ();_faeb ._cfdb .PPr .NumPr =_fgg .NewCT_NumPr ();_faeb ._cfdb .PPr .NumPr .NumId =_fgg .NewCT_DecimalNumber ();_faeb ._cfdb .PPr .NumPr .NumId .ValAttr =numID ;_faeb ._cfdb .PPr .NumPr .Ilvl =_fgg .NewCT_DecimalNumber ();_faeb ._cfdb .PPr .NumPr .Ilvl .ValAttr =int64 (level );return nil ;};
+
 // VerticalAlign returns the value of run vertical align.
 func (_fdccc RunProperties )VerticalAlignment ()_fg .ST_VerticalAlignRun {if _fgec :=_fdccc ._bfbg .VertAlign ;_fgec !=nil {return _fgec .ValAttr ;};return 0;};
 
+// ErrImageNotFound is returned when a drawing is added for an image that was
+// not added to the document.
+var ErrImageNotFound =_ef .New ("\u0069\u006d\u0061\u0067\u0065 \u006e\u006f\u0074\u0020\u0066\u006fun\u0064\u0020\u0077\u0069t\u0068\u0069\u006e\u0020d\u006f\u0063\u0075\u006d\u0065\u006e\u0074");
+
 // X returns the inner wrapped XML type.
 func (_abacd TableLook )X ()*_fgg .CT_TblLook {return _abacd ._gagb };
 
@@ -1077,7 +1892,9 @@ func (_cgfdc TableBorders )SetRight (t _fgg .ST_Border ,c _bbd .Color ,thickness
 func NewSettings ()Settings {_fafa :=_fgg .NewSettings ();_fafa .Compat =_fgg .NewCT_Compat ();_bbebc :=_fgg .NewCT_CompatSetting ();_bbebc .NameAttr =_c .String ("\u0063\u006f\u006d\u0070\u0061\u0074\u0069\u0062\u0069\u006c\u0069\u0074y\u004d\u006f\u0064\u0065");_bbebc .UriAttr =_c .String ("h\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006d\u0069\u0063\u0072o\u0073\u006f\u0066\u0074\u002e\u0063\u006f\u006d\u002f\u006fff\u0069\u0063\u0065/\u0077o\u0072\u0064");_bbebc .ValAttr =_c .String ("\u0031\u0035");_fafa .Compat .CompatSetting =append (_fafa .Compat .CompatSetting ,_bbebc );return Settings {_fafa };};
 
 // AddTabStop adds a tab stop to the paragraph.
-func (_bace ParagraphStyleProperties )AddTabStop (position _ce .Distance ,justificaton _fgg .ST_TabJc ,leader _fgg .ST_TabTlc ){if _bace ._bgca .Tabs ==nil {_bace ._bgca .Tabs =_fgg .NewCT_Tabs ();};_afge :=_fgg .NewCT_TabStop ();_afge .LeaderAttr =leader ;_afge .ValAttr =justificaton ;_afge .PosAttr .Int64 =_c .Int64 (int64 (position /_ce .Twips ));_bace ._bgca .Tabs .Tab =append (_bace ._bgca .Tabs .Tab ,_afge );};
+// The leader is used to fill the space before the tab stop (e.g. ST_TabTlcDot for
+// table of contents entries). Tab stops are kept ordered by position.
+func (_bace ParagraphStyleProperties )AddTabStop (position _ce .Distance ,justificaton _fgg .ST_TabJc ,leader _fgg .ST_TabTlc ){if _bace ._bgca .Tabs ==nil {_bace ._bgca .Tabs =_fgg .NewCT_Tabs ();};_afge :=_fgg .NewCT_TabStop ();_afge .LeaderAttr =leader ;_afge .ValAttr =justificaton ;_afge .PosAttr .Int64 =_c .Int64 (int64 (position /_ce .Twips ));_bace ._bgca .Tabs .Tab =_cbbfa (_bace ._bgca .Tabs .Tab ,_afge );};
 
 // Borders allows manipulation of the table borders.
 func (_egdga TableStyleProperties )Borders ()TableBorders {if _egdga ._fbbc .TblBorders ==nil {_egdga ._fbbc .TblBorders =_fgg .NewCT_TblBorders ();};return TableBorders {_egdga ._fbbc .TblBorders };};
@@ -1085,14 +1902,32 @@ func (_egdga TableStyleProperties )Borders ()TableBorders {if _egdga ._fbbc .Tbl
 // ParagraphProperties returns the paragraph properties controlling text formatting within the table.
 func (_ebad TableConditionalFormatting )ParagraphProperties ()ParagraphStyleProperties {if _ebad ._abace .PPr ==nil {_ebad ._abace .PPr =_fgg .NewCT_PPrGeneral ();};return ParagraphStyleProperties {_ebad ._abace .PPr };};
 
-// SetHighlight highlights text in a specified color.
-func (_acge RunProperties )SetHighlight (c _fgg .ST_HighlightColor ){_acge ._bfbg .Highlight =_fgg .NewCT_Highlight ();_acge ._bfbg .Highlight .ValAttr =c ;};
+// addRsid registers the revision identifier in the document settings if it
+// isn't already present.
+func (_edef Settings )addRsid (_aed string ){if _edef ._efag ==nil {return ;};if _edef ._efag .Rsids ==nil {_edef ._efag .Rsids =_fgg .NewCT_DocRsids ();};for _ ,_gfdfa :=range _edef ._efag .Rsids .Rsid {if _a .EqualFold (_gfdfa .ValAttr ,_aed ){return ;};};_bedef :=_fgg .NewCT_LongHexNumber ();_bedef .ValAttr =_aed ;_edef ._efag .Rsids .Rsid =append (_edef ._efag .Rsids .Rsid ,_bedef );};
+
+// CloneInto returns a copy of the run, including its formatting and content,
+// that belongs to dst.  Images and charts referenced by drawings in the run are
+// added to dst and the copied drawings are updated to refer to them.  The
+// returned run is not part of any paragraph and can be inserted with
+// Paragraph.AppendRun.  Styles referenced by the run are not copied and must
+// exist in dst.  An error is returned if the run contains content that can't be
+// moved between documents, such as embedded objects, footnote and endnote
+// references or any other relationship than a picture's image or a chart, or if
+// the run references a relationship but isn't part of a document.  Images and
+// charts are added to the main document part, use CloneIntoParagraph to copy a
+// run into a header or footer.
+func (_cbgd Run )CloneInto (dst *Document )(Run ,error ){if dst ==nil {return Run {},_ef .New ("\u0064\u0065\u0073\u0074\u0069\u006e\u0061\u0074\u0069\u006f\u006e\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u0020\u006d\u0075\u0073\u0074\u0020\u006e\u006f\u0074\u0020\u0062\u0065\u0020\u006e\u0069\u006c");};return _cbgd .cloneInto (dst ,dst ._efe );};func (_eebca Run )cloneInto (dst *Document ,_dbaeb _aeb .Relationships )(Run ,error ){_ccabg :=_fgg .NewCT_R ();if _bcaed :=_eedf (_ccabg ,_eebca ._bfbb ,"\u0077:\u0072");_bcaed !=nil {return Run {},_bcaed ;};for _ ,_aaeec :=range _ccabg .EG_RunInnerContent {if _aaeec .Object !=nil ||_aaeec .FootnoteReference !=nil ||_aaeec .EndnoteReference !=nil {return Run {},_ef .New ("\u0072\u0075\u006e\u0020\u0063\u006f\u006e\u0074\u0061\u0069\u006e\u0073\u0020\u0063\u006f\u006e\u0074\u0065\u006e\u0074\u0020\u0074\u0068\u0061t\u0020c\u0061\u006e\u0027\u0074\u0020b\u0065\u0020\u0063\u006f\u0070\u0069\u0065\u0064\u0020\u0074\u006f\u0020\u0061\u006e\u006f\u0074\u0068\u0065\u0072\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074");};};_bbae :=map[string ]bool {};_ggfba :=map[string ][]*_dcd .Chart {};for _ ,_bcabg :=range _ccabg .EG_RunInnerContent {if _bcabg .Drawing !=nil {for _ ,_cfdc :=range _aeagf (_bcabg .Drawing ){_bbae [_cfdc ]=true ;};for _ ,_fdge :=range _agga (_bcabg .Drawing ){_ggfba [_fdge .IdAttr ]=append (_ggfba [_fdge .IdAttr ],_fdge );};};};_fbbcf ,_aeeec :=_dad (_ccabg );if _aeeec !=nil {return Run {},_aeeec ;};for _ ,_eedcc :=range _fbbcf {if _eebca ._adbf ==nil {return Run {},_cf .Errorf ("\u0072\u0075\u006e\u0020\u0072e\u0066\u0065\u0072\u0065\u006e\u0063\u0065\u0073\u0020\u0072\u0065\u006ca\u0074i\u006fn\u0073\u0068\u0069\u0070\u0020\u0025\u0073\u0020\u0062\u0075t\u0020\u0069\u0073\u006e\u0027\u0074\u0020p\u0061r\u0074\u0020\u006f\u0066\u0020\u0061\u0020d\u006f\u0063\u0075me\u006e\u0074",_eedcc );};if !_bbae [_eedcc ]&&_ggfba [_eedcc ]==nil {return Run {},_cf .Errorf ("\u0072\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073h\u0069\u0070\u0020\u0025\u0073\u0020\u0063\u0061\u006e\u0027\u0074\u0020b\u0065\u0020\u0063o\u0070\u0069ed\u0020t\u006f\u0020\u0061\u006eo\u0074\u0068\u0065\u0072\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074",_eedcc );};};if len (_fbbcf )==0{return Run {dst ,_ccabg },nil ;};_gfbd :=_eebca ._adbf .relsOfRun (_eebca );_ecaeb :=func (_gdggd string )string {for _ ,_dfffe :=range _gfbd .Relationships (){if _dfffe .ID ()==_gdggd {return _dfffe .X ().TargetAttr ;};};return "";};_abgbd :=map[string ]string {};for _dcdad :=range _bbae {_fgacc :=_ecaeb (_dcdad );if _fgacc ==""{return Run {},_cf .Errorf ("\u0064\u0072\u0061\u0077\u0069\u006eg\u0020\u0072\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065\u0073 \u0069\u006d\u0061\u0067e\u0020\u0072\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073\u0068\u0069\u0070\u0020\u0025\u0073\u0020\u0077\u0068i\u0063h\u0020\u0064\u006f\u0065\u0073n\u0027\u0074\u0020\u0065\u0078\u0069\u0073\u0074",_dcdad );};_cgdae :=false ;for _efgb ,_fddef :=range _eebca ._adbf .Images {if !_a .EqualFold (_fgacc ,_cf .Sprintf ("\u006d\u0065\u0064\u0069\u0061\u002f\u0069\u006d\u0061\u0067\u0065\u0025\u0064\u002e\u0025s",_efgb +1,_a .ToLower (_fddef .Format ()))){continue ;};_defc ,_eaaab :=dst .imageRelID (_dbaeb ,_fddef );if _eaaab !=nil {return Run {},_eaaab ;};_abgbd [_dcdad ]=_defc ;_cgdae =true ;break ;};if !_cgdae {return Run {},ErrImageNotFound ;};};for _ ,_edae :=range _ccabg .EG_RunInnerContent {if _edae .Drawing !=nil {_egcbd (_edae .Drawing ,_abgbd );};};for _geebf ,_fbbe :=range _ggfba {_egfgb :=_ecaeb (_geebf );var _cfbaf *_dcd .ChartSpace ;for _feaad ,_aedb :=range _eebca ._adbf ._ebgfc {if _aedb =="\u0077o\u0072d\u002f"+_egfgb {_cfbaf =_eebca ._adbf ._gcagf [_feaad ];break ;};};if _cfbaf ==nil {return Run {},_cf .Errorf ("\u0063\u0068\u0061\u0072t\u0020\u0072e\u006c\u0061\u0074\u0069\u006f\u006e\u0073\u0068\u0069\u0070\u0020\u0025\u0073\u0020\u0063\u0061\u006e\u0027\u0074\u0020\u0062\u0065\u0020\u0063\u006f\u0070\u0069\u0065\u0064\u0020\u0074\u006f\u0020\u0061\u006e\u006f\u0074\u0068\u0065\u0072\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006et",_geebf );};_gcgfg :=_dcd .NewChartSpace ();if _gdbc :=_eedf (_gcgfg ,_cfbaf ,"\u0063\u003a\u0063\u0068\u0061\u0072\u0074\u0053\u0070a\u0063\u0065");_gdbc !=nil {return Run {},_gdbc ;};_dffef :=dst .addChartPart (_dbaeb ,_gcgfg );for _ ,_bae :=range _fbbe {_bae .IdAttr =_dffef .ID ();};};return Run {dst ,_ccabg },nil ;};
+
+// SetHighlight highlights text in a specified color, replacing any existing
+// highlight.  Passing wml.ST_HighlightColorUnset removes the highlight.
+func (_acge RunProperties )SetHighlight (c _fgg .ST_HighlightColor )RunProperties {if c ==_fgg .ST_HighlightColorUnset {_acge ._bfbg .Highlight =nil ;return _acge ;};_acge ._bfbg .Highlight =_fgg .NewCT_Highlight ();_acge ._bfbg .Highlight .ValAttr =c ;return _acge ;};
 
 // SetFooter sets a section footer.
 func (_ggdg Section )SetFooter (f Footer ,t _fgg .ST_HdrFtr ){_cbfe :=_fgg .NewEG_HdrFtrReferences ();_ggdg ._egcf .EG_HdrFtrReferences =append (_ggdg ._egcf .EG_HdrFtrReferences ,_cbfe );_cbfe .FooterReference =_fgg .NewCT_HdrFtrRef ();_cbfe .FooterReference .TypeAttr =t ;_bfdf :=_ggdg ._dbcd ._efe .FindRIDForN (f .Index (),_c .FooterType );if _bfdf ==""{_ee .Print ("\u0075\u006ea\u0062\u006c\u0065\u0020\u0074\u006f\u0020\u0064\u0065\u0074\u0065\u0072\u006d\u0069\u006e\u0065\u0020\u0066\u006f\u006f\u0074\u0065r \u0049\u0044");};_cbfe .FooterReference .IdAttr =_bfdf ;};
 
-// SetShadow sets the run to shadowed text.
-func (_aeca RunProperties )SetShadow (b bool ){if !b {_aeca ._bfbg .Shadow =nil ;}else {_aeca ._bfbg .Shadow =_fgg .NewCT_OnOff ();};};
+// SetShadow sets the run to shadowed text. Any emboss or imprint is cleared.
+func (_aeca RunProperties )SetShadow (b bool )RunProperties {if !b {_aeca ._bfbg .Shadow =nil ;}else {_aeca ._bfbg .Shadow =_fgg .NewCT_OnOff ();_aeca ._bfbg .Emboss =nil ;_aeca ._bfbg .Imprint =nil ;};return _aeca ;};
 
 // RemoveParagraph removes a paragraph from the footnote.
 func (_gdgb Footnote )RemoveParagraph (p Paragraph ){for _ ,_adgf :=range _gdgb .content (){for _cfbf ,_gbed :=range _adgf .P {if _gbed ==p ._cfdb {copy (_adgf .P [_cfbf :],_adgf .P [_cfbf +1:]);_adgf .P =_adgf .P [0:len (_adgf .P )-1];return ;};};};};
@@ -1113,9 +1948,20 @@ func (_ddga ParagraphProperties )SetAlignment (align _fgg .ST_Jc ){if align ==_f
 // SetHAlignment sets the horizontal alignment for an anchored image.
 func (_be AnchoredDrawing )SetHAlignment (h _fgg .WdST_AlignH ){_be ._gd .PositionH .Choice =&_fgg .WdCT_PosHChoice {};_be ._gd .PositionH .Choice .Align =h ;};
 
+// nextChartIndex returns the lowest chart part number that isn't used by a
+// chart added to the document or by a chart part read with the document.
+func (_ceace *Document )nextChartIndex ()int {_cfdag :=map[string ]bool {};for _ ,_cffbb :=range _ceace ._ebgfc {_cfdag [_cffbb ]=true ;};for _ ,_badda :=range _ceace .ExtraFiles {_cfdag [_badda .ZipPath ]=true ;};for _gcdba :=1;;_gcdba ++{if !_cfdag [_c .AbsoluteFilename (_c .DocTypeDocument ,_c .ChartType ,_gcdba )]{return _gcdba ;};};};
+
 // SetThemeColor sets the color from the theme.
 func (_eab Color )SetThemeColor (t _fgg .ST_ThemeColor ){_eab ._aaf .ThemeColorAttr =t };
 
+// SetWrapTight sets the text wrap to tight, wrapping text around the polygon
+// poly.  The polygon vertices are relative to the drawing in a coordinate space
+// where (0,0) is the top left and (21600,21600) is the bottom right corner of the
+// drawing.  The polygon is closed automatically.  If poly contains less than
+// three points, the drawing bounds are used.
+func (_bbefg AnchoredDrawing )SetWrapTight (poly []_bb .Point ){if len (poly )< 3{poly =[]_bb .Point {{0,0},{0,21600},{21600,21600},{21600,0}};};_bbefg ._gd .Choice =&_fgg .WdEG_WrapTypeChoice {};_bbefg ._gd .Choice .WrapTight =_fgg .NewWdCT_WrapTight ();_bbefg ._gd .Choice .WrapTight .WrapTextAttr =_fgg .WdST_WrapTextBothSides ;_adaee :=_fgg .NewWdCT_WrapPath ();_adaee .EditedAttr =_c .Bool (false );_adaee .Start =_ed .NewCT_Point2D ();_adaee .Start .XAttr .ST_CoordinateUnqualified =_c .Int64 (int64 (poly [0].X ));_adaee .Start .YAttr .ST_CoordinateUnqualified =_c .Int64 (int64 (poly [0].Y ));if poly [len (poly )-1]!=poly [0]{poly =append (poly [:len (poly ):len (poly )],poly [0]);};for _ ,_fdfce :=range poly [1:]{_fcaee :=_ed .NewCT_Point2D ();_fcaee .XAttr .ST_CoordinateUnqualified =_c .Int64 (int64 (_fdfce .X ));_fcaee .YAttr .ST_CoordinateUnqualified =_c .Int64 (int64 (_fdfce .Y ));_adaee .LineTo =append (_adaee .LineTo ,_fcaee );};_bbefg ._gd .Choice .WrapTight .WrapPolygon =_adaee ;};
+
 // Color controls the run or styles color.
 type Color struct{_aaf *_fgg .CT_Color };
 
@@ -1126,6 +1972,9 @@ func (_aeed Run )AddPageBreak (){_abdg :=_aeed .newIC ();_abdg .Br =_fgg .NewCT_
 // the mail merge source info from the document settings.
 func (_gfbf *Document )MailMerge (mergeContent map[string ]string ){_gedc :=_gfbf .mergeFields ();_cdbb :=map[Paragraph ][]Run {};for _ ,_bfda :=range _gedc {_ecgf ,_acab :=mergeContent [_bfda ._bgcb ];if _acab {if _bfda ._fffa {_ecgf =_a .ToUpper (_ecgf );}else if _bfda ._cab {_ecgf =_a .ToLower (_ecgf );}else if _bfda ._ecca {_ecgf =_a .Title (_ecgf );}else if _bfda ._fbfg {_ceed :=_d .Buffer {};for _dfcfg ,_aace :=range _ecgf {if _dfcfg ==0{_ceed .WriteRune (_b .ToUpper (_aace ));}else {_ceed .WriteRune (_aace );};};_ecgf =_ceed .String ();};if _ecgf !=""&&_bfda ._deaa !=""{_ecgf =_bfda ._deaa +_ecgf ;};if _ecgf !=""&&_bfda ._aeg !=""{_ecgf =_ecgf +_bfda ._aeg ;};};if _bfda ._beca {if len (_bfda ._aaa .FldSimple )==1&&len (_bfda ._aaa .FldSimple [0].EG_PContent )==1&&len (_bfda ._aaa .FldSimple [0].EG_PContent [0].EG_ContentRunContent )==1{_bced :=&_fgg .EG_ContentRunContent {};_bced .R =_bfda ._aaa .FldSimple [0].EG_PContent [0].EG_ContentRunContent [0].R ;_bfda ._aaa .FldSimple =nil ;_acbda :=Run {_gfbf ,_bced .R };_acbda .ClearContent ();_acbda .AddText (_ecgf );_bfda ._aaa .EG_ContentRunContent =append (_bfda ._aaa .EG_ContentRunContent ,_bced );};}else {_bdc :=_bfda ._geff .Runs ();for _gbadc :=_bfda ._adae ;_gbadc <=_bfda ._afce ;_gbadc ++{if _gbadc ==_bfda ._bac +1{_bdc [_gbadc ].ClearContent ();_bdc [_gbadc ].AddText (_ecgf );}else {_cdbb [_bfda ._geff ]=append (_cdbb [_bfda ._geff ],_bdc [_gbadc ]);};};};};for _bgb ,_dcggf :=range _cdbb {for _ ,_edc :=range _dcggf {_bgb .RemoveRun (_edc );};};_gfbf .Settings .RemoveMailMerge ();};
 
+// X returns the inner wrapped XML type.
+func (_cfff Equation )X ()*_age .OMath {return _cfff ._fbcd };func (_gbcg MathArgument )add ()*_age .EG_OMathMathElements {_ccbe :=_age .NewEG_OMathMathElements ();*_gbcg ._bdeb =append (*_gbcg ._bdeb ,_ccbe );return _ccbe ;};
+
 // Cells returns the cells defined in the table.
 func (_degff Row )Cells ()[]Cell {_ecfeb :=[]Cell {};for _ ,_dedb :=range _degff ._edag .EG_ContentCellContent {for _ ,_efbe :=range _dedb .Tc {_ecfeb =append (_ecfeb ,Cell {_degff ._aade ,_efbe });};if _dedb .Sdt !=nil &&_dedb .Sdt .SdtContent !=nil {for _ ,_gbac :=range _dedb .Sdt .SdtContent .Tc {_ecfeb =append (_ecfeb ,Cell {_degff ._aade ,_gbac });};};};return _ecfeb ;};
 
@@ -1142,6 +1991,10 @@ func (_gcag RunProperties )Italic ()bool {_decfc :=_gcag ._bfbg ;return _aeege (
 // Header is a header for a document section.
 type Header struct{_gdd *Document ;_fcad *_fgg .Hdr ;};
 
+// Drawings returns the anchored and inline drawings within the run in document
+// order.
+func (_fage Run )Drawings ()[]Drawing {_bfbcc :=[]Drawing {};for _ ,_edfa :=range _fage ._bfbb .EG_RunInnerContent {if _edfa .Drawing ==nil {continue ;};for _ ,_cceef :=range _edfa .Drawing .Anchor {_bfbcc =append (_bfbcc ,Drawing {_gbce :_fage ._adbf ,_cfga :_cceef });};for _ ,_gbaab :=range _edfa .Drawing .Inline {_bfbcc =append (_bfbcc ,Drawing {_gbce :_fage ._adbf ,_eff :_gbaab });};};return _bfbcc ;};
+
 // SetConformance sets conformance attribute of the document
 // as one of these values from github.com/unidoc/unioffice/schema/soo/ofc/sharedTypes:
 // ST_ConformanceClassUnset, ST_ConformanceClassStrict or ST_ConformanceClassTransitional.
@@ -1154,6 +2007,17 @@ func (_fff *Document )FormFields ()[]FormField {_cegg :=[]FormField {};for _ ,_d
 // X returns the inner wrapped XML type.
 func (_efdaf NumberingLevel )X ()*_fgg .CT_Lvl {return _efdaf ._cbf };
 
+// MeasureWidth returns an estimate of the width of the run when rendered,
+// using bundled metrics for common fonts rather than the fonts themselves.
+// The font, size, capitalization and character spacing are taken from the
+// effective properties of the run.  Fonts that aren't known are measured as a
+// sans serif font, East Asian characters are measured as one em wide, bold
+// text is widened by five percent and tabs are measured as one default tab
+// stop.  For runs containing breaks the width of the widest line is returned.
+// An error is returned if the run contains drawings or other objects whose
+// width can't be estimated from font metrics.
+func (_agdbg Run )MeasureWidth ()(_ce .Distance ,error ){_gdagc :=_agdbg .EffectiveProperties ();_edaaf :=_gdagc .SizeValue ();if _edaaf ==0{_edaaf =10;};_defdd :=_a .ToLower (_agdbg ._adbf .runFontName (_gdagc ));_geefb ,_ddaac :=_ebdfa [_defdd ];if !_ddaac {_geefb .widths =&_ccbfe ;_geefb .scale =1;switch {case _a .Contains (_defdd ,"\u006d\u006f\u006e\u006f")||_a .Contains (_defdd ,"\u0063\u006f\u0075r\u0069\u0065\u0072"):_geefb .widths =nil ;case _a .Contains (_defdd ,"\u0073\u0065\u0072\u0069\u0066")&&!_a .Contains (_defdd ,"\u0073\u0061\u006e\u0073"):_geefb .widths =&_fadbe ;};};_ggbc :=_geefb .scale *_edaaf /1000;if _gdagc .IsBold (){_ggbc *=1.05;};_baccc :=float64 (_gdagc .CharacterSpacingValue ())/20;_cgde :=36.0;if _agdbg ._adbf !=nil &&_agdbg ._adbf .Settings .X ()!=nil &&_agdbg ._adbf .Settings .X ().DefaultTabStop !=nil {if _eedg :=_agdbg ._adbf .Settings .X ().DefaultTabStop .ValAttr .ST_UnsignedDecimalNumber ;_eedg !=nil {_cgde =float64 (*_eedg )/20;};};_aaggf :=func (_gfaab rune )float64 {switch {case _b .Is (_b .Han ,_gfaab )||_b .Is (_b .Hiragana ,_gfaab )||_b .Is (_b .Katakana ,_gfaab )||_b .Is (_b .Hangul ,_gfaab ):return _edaaf ;case _geefb .widths ==nil :return 600*_ggbc ;case _gfaab >=' '&&_gfaab <='~':return float64 (_geefb .widths [_gfaab -' '])*_ggbc ;};return float64 (_geefb .widths ['n'-' '])*_ggbc ;};_bdgg ,_afca :=0.0,0.0;for _ ,_fabfd :=range _agdbg ._bfbb .EG_RunInnerContent {switch {case _fabfd .T !=nil :_fgfcb :=_fabfd .T .Content ;if _gdagc .Caps (){_fgfcb =_a .ToUpper (_fgfcb );};for _ ,_gdcea :=range _fgfcb {_afca +=_aaggf (_gdcea )+_baccc ;};case _fabfd .NoBreakHyphen !=nil :_afca +=_aaggf ('-')+_baccc ;case _fabfd .Tab !=nil :_afca +=_cgde ;case _fabfd .Br !=nil ||_fabfd .Cr !=nil :if _afca > _bdgg {_bdgg =_afca ;};_afca =0;case _fabfd .Drawing !=nil ||_fabfd .Object !=nil ||_fabfd .Pict !=nil :return 0,_ef .New ("ru\u006e\u0020\u0063\u006f\u006e\u0074\u0061\u0069\u006e\u0073\u0020\u0061\u0020d\u0072\u0061\u0077\u0069\u006e\u0067\u0020\u006f\u0072\u0020\u006f\u0062\u006a\u0065\u0063\u0074 \u0074h\u0061\u0074\u0020\u0063\u0061\u006e\u0027\u0074\u0020b\u0065\u0020\u006de\u0061s\u0075\u0072\u0065\u0064");};};if _afca > _bdgg {_bdgg =_afca ;};return _ce .Distance (_bdgg )*_ce .Point ,nil ;};
+
 // Numbering is the document wide numbering styles contained in numbering.xml.
 type Numbering struct{_fdda *_fgg .Numbering };
 
@@ -1176,6 +2040,11 @@ func (_afe CellMargins )SetTopPct (pct float64 ){_afe ._bgg .Top =_fgg .NewCT_Tb
 // destination will be used many times.
 func (_bcfb HyperLink )SetTargetByRef (link _aeb .Hyperlink ){_bcfb ._efga .IdAttr =_c .String (_aeb .Relationship (link ).ID ());_bcfb ._efga .AnchorAttr =nil ;};
 
+// Equation is an inline Office Math (OMML) equation within a paragraph.
+// Content is added to the equation with the methods of the embedded
+// MathArgument.
+type Equation struct{MathArgument ;_fbcd *_age .OMath ;};
+
 // Shadow returns true if run shadow is on.
 func (_eegfa RunProperties )Shadow ()bool {return _aeege (_eegfa ._bfbg .Shadow )};
 
@@ -1188,12 +2057,40 @@ func (_ebg HyperLink )SetTargetBookmark (bm Bookmark ){_ebg ._efga .AnchorAttr =
 // SetBasedOn sets the style that this style is based on.
 func (_aacc Style )SetBasedOn (name string ){if name ==""{_aacc ._dedd .BasedOn =nil ;}else {_aacc ._dedd .BasedOn =_fgg .NewCT_String ();_aacc ._dedd .BasedOn .ValAttr =name ;};};
 
+// ExtractTextWithOptions returns the text of the paragraphs within the document
+// body, one paragraph per line, in the same way as ExtractText.  Hidden text is
+// skipped unless opts.IncludeHidden is set, so that text that isn't displayed,
+// such as hidden reviewer notes, can be excluded when indexing the document.
+func (_bfedb *Document )ExtractTextWithOptions (opts ExtractTextOptions )string {_eaaga :=_d .Buffer {};for _eccb ,_fgge :=range _bfedb .bodyParagraphs (){if _eccb > 0{_eaaga .WriteByte ('\n');};for _ ,_aedae :=range _fgge .allRuns (){if !opts .IncludeHidden &&_bfedb .isRunHidden (_fgge ,_aedae ){continue ;};_eaaga .WriteString (_aedae .Text ());};};return _eaaga .String ();};
+
+// SetColorHex sets the text color from a hex string in the form "#RRGGBB" or
+// "RRGGBB".  The run is left unchanged if hex is invalid.
+func (_ecfdg Run )SetColorHex (hex string )error {_gdbb ,_bgdge :=_bbd .ParseHex (hex );if _bgdge !=nil {return _bgdge ;};_ecfdg .Properties ().SetColor (_gdbb );return nil ;};
+
 // TableLook returns the table look, or conditional formatting applied to a table style.
 func (_gfc TableProperties )TableLook ()TableLook {if _gfc ._caea .TblLook ==nil {_gfc ._caea .TblLook =_fgg .NewCT_TblLook ();};return TableLook {_gfc ._caea .TblLook };};
 
 // Paragraph is a paragraph within a document.
 type Paragraph struct{_eecc *Document ;_cfdb *_fgg .CT_P ;};
 
+// AddImageWithContentType adds an image with the given content type (e.g.
+// "image/png" or "image/x-emf") and size in pixels to the document package
+// without decoding it. This allows embedding large images without the cost of
+// decoding them and embedding formats that can't be decoded.
+func (_fedfe *Document )AddImageWithContentType (data []byte ,contentType string ,size _bb .Point )(_aeb .ImageRef ,error ){_dacc :=contentType ;if _eac :=_a .LastIndex (_dacc ,"\u002f");_eac >=0{_dacc =_dacc [_eac +1:];};_dacc =_a .TrimPrefix (_dacc ,"\u0078\u002d");if _fbgcg :=_a .Index (_dacc ,"\u002b");_fbgcg >=0{_dacc =_dacc [:_fbgcg ];};_dacc =_a .ToLower (_dacc );if _dacc ==""||!_a .HasPrefix (contentType ,"\u0069\u006d\u0061\u0067\u0065\u002f"){return _aeb .ImageRef {},_ef .New ("\u0069\u006eva\u006c\u0069\u0064\u0020\u0069\u006d\u0061\u0067\u0065\u0020\u0063\u006f\u006e\u0074\u0065\u006e\u0074\u0020\u0074\u0079\u0070\u0065");};_ ,_fgdba :=_fedfe .imageDefault (_dacc );_fedfe .ContentTypes .EnsureDefault (_dacc ,contentType );_bbag ,_bbead :=_fedfe .AddImage (_aeb .Image {Data :&data ,Format :_dacc ,Size :size });if _bbead !=nil &&!_fgdba {_fedfe .removeImageDefault (_dacc );};return _bbag ,_bbead ;};
+
+// ItalicComplexScriptValue returns the precise nature of the complex script
+// italic setting (unset, off or on).
+func (_eadcd RunProperties )ItalicComplexScriptValue ()OnOffValue {return _aafe (_eadcd ._bfbg .ICs )};
+
+// AddWatermark adds a text watermark, such as "DRAFT" or "CONFIDENTIAL",
+// centered behind the text of every page.  The watermark is a text box placed
+// in the document headers so that it repeats on each page.  It is added to
+// every existing header and, if the first section has no default header, a new
+// default header is created for it.  The document isn't modified if an error
+// is returned.
+func (_cbcb *Document )AddWatermark (text string ,opts WatermarkOptions )error {if _a .TrimSpace (text )==""{return _ef .New ("\u0077\u0061\u0074\u0065\u0072\u006d\u0061\u0072\u006b\u0020\u0074\u0065\u0078\u0074\u0020\u006d\u0075s\u0074 \u006eo\u0074 \u0062\u0065\u0020\u0065\u006d\u0070\u0074y");};if opts .Font ==""{opts .Font ="\u0043\u0061l\u0069\u0062\u0072\u0069";};if opts .Size <=0{opts .Size =72;};if opts .Color ==(_bbd .Color {}){opts .Color =_bbd .Silver ;};_becfd :=func (_cgbfd Run ){_cgbfd .Properties ().SetFontFamily (opts .Font );_cgbfd .Properties ().SetSize (_ce .Distance (opts .Size ));_cgbfd .Properties ().SetColor (opts .Color );_cgbfd .AddText (text );};_gfecf :=Run {_cbcb ,_fgg .NewCT_R ()};_becfd (_gfecf );_gfbdg ,_fggf :=_gfecf .MeasureWidth ();if _fggf !=nil {return _fggf ;};_bgcgc :=_ce .ToEMU (float64 (_gfbdg *1.1+0.25*_ce .Inch ));_gfgd :=_ce .ToEMU (opts .Size *1.4+float64 (0.15*_ce .Inch ));for _ ,_fagb :=range _cbcb .watermarkHeaders (){_bbeea :=_fagb .watermarkParagraph ().AddRun ().AddTextBox (_ce .Inch ,_ce .Inch );_ffda :=_bbeea .AddParagraph ();_ffda .Properties ().SetAlignment (_fgg .ST_JcCenter );_ffda .SetSpacingBefore (0);_ffda .SetSpacingAfter (0);_becfd (_ffda .AddRun ());_bdae :=_bbeea .AnchoredDrawing ();for _ ,_adgfd :=range _bdae ._gd .Graphic .GraphicData .Any {if _fbdb ,_geca :=_adgfd .(*textBoxShape );_geca {_fbdb ._cebg .SolidFill =nil ;_fbdb ._cebg .NoFill =_ed .NewCT_NoFillProperties ();_fbdb ._cebg .Ln =_ed .NewCT_LineProperties ();_fbdb ._cebg .Ln .NoFill =_ed .NewCT_NoFillProperties ();_fbdb ._cebg .Xfrm .Ext .CxAttr =_bgcgc ;_fbdb ._cebg .Xfrm .Ext .CyAttr =_gfgd ;if !opts .Horizontal {_fbdb ._cebg .Xfrm .RotAttr =_c .Int32 (315*60000);};};};_bdae ._gd .Extent .CxAttr =_bgcgc ;_bdae ._gd .Extent .CyAttr =_gfgd ;_bdae ._gd .DocPr .NameAttr ="\u0057\u0061\u0074\u0065\u0072m\u0061\u0072\u006b";_cbcb .placeWatermark (_bdae );};return nil ;};
+
 // X returns the inner wrapped XML type.
 func (_cebb TableStyleProperties )X ()*_fgg .CT_TblPrBase {return _cebb ._fbbc };
 
@@ -1209,6 +2106,9 @@ func (_cce Document )SetStrict (strict bool ){if strict {_cce ._cdaa .Conformanc
 func (_ddbc ParagraphSpacing )SetBeforeAuto (b bool ){if b {_ddbc ._bged .BeforeAutospacingAttr =&_fg .ST_OnOff {};_ddbc ._bged .BeforeAutospacingAttr .Bool =_c .Bool (true );}else {_ddbc ._bged .BeforeAutospacingAttr =nil ;};};
 
 // Run is a run of text within a paragraph that shares the same formatting.
+// Formatting setters on Run return the Run so that calls can be chained, in the
+// same way as the RunProperties setters.  Setters that can fail return an
+// error instead.
 type Run struct{_adbf *Document ;_bfbb *_fgg .CT_R ;};
 
 // TableLook is the conditional formatting associated with a table style that
@@ -1231,12 +2131,24 @@ func (_aeaa RunProperties )RightToLeft ()bool {return _aeege (_aeaa ._bfbg .Rtl
 // can be used to add the image to a run and place it in the document contents.
 func (_acbd Footer )AddImage (i _aeb .Image )(_aeb .ImageRef ,error ){var _ebcbf _aeb .Relationships ;for _edgg ,_ddaf :=range _acbd ._gbfg ._eefb {if _ddaf ==_acbd ._baba {_ebcbf =_acbd ._gbfg ._edgc [_edgg ];};};_dbe :=_aeb .MakeImageRef (i ,&_acbd ._gbfg .DocBase ,_ebcbf );if i .Data ==nil &&i .Path ==""{return _dbe ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0064\u0061t\u0061\u0020\u006f\u0072\u0020\u0061\u0020\u0070\u0061\u0074\u0068");};if i .Format ==""{return _dbe ,_ef .New ("\u0069\u006d\u0061\u0067\u0065\u0020\u006d\u0075\u0073\u0074 \u0068\u0061\u0076\u0065\u0020\u0061\u0020v\u0061\u006c\u0069\u0064\u0020\u0066\u006f\u0072\u006d\u0061\u0074");};if i .Size .X ==0||i .Size .Y ==0{return _dbe ,_ef .New ("\u0069\u006d\u0061\u0067e\u0020\u006d\u0075\u0073\u0074\u0020\u0068\u0061\u0076\u0065 \u0061 \u0076\u0061\u006c\u0069\u0064\u0020\u0073i\u007a\u0065");};_acbd ._gbfg .Images =append (_acbd ._gbfg .Images ,_dbe );_gccf :=_cf .Sprintf ("\u006d\u0065d\u0069\u0061\u002fi\u006d\u0061\u0067\u0065\u0025\u0064\u002e\u0025\u0073",len (_acbd ._gbfg .Images ),i .Format );_bebf :=_ebcbf .AddRelationship (_gccf ,_c .ImageType );_dbe .SetRelID (_bebf .X ().IdAttr );return _dbe ,nil ;};
 
+// DisableSpellcheck disables spelling and grammar checking for the run by
+// setting the no proofing property and clearing the run language.
+func (_gbde Run )DisableSpellcheck ()Run {_dfac :=_gbde .Properties ().X ();_dfac .NoProof =_fgg .NewCT_OnOff ();_dfac .Lang =nil ;return _gbde ;};
+
 // Levels returns all of the numbering levels defined in the definition.
 func (_caba NumberingDefinition )Levels ()[]NumberingLevel {_fffb :=[]NumberingLevel {};for _ ,_caece :=range _caba ._ddfb .Lvl {_fffb =append (_fffb ,NumberingLevel {_caece });};return _fffb ;};
 
 // SetInsideHorizontal sets the interior horizontal borders to a specified type, color and thickness.
 func (_dggab TableBorders )SetInsideHorizontal (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_dggab ._efaad .InsideH =_fgg .NewCT_Border ();_cafa (_dggab ._efaad .InsideH ,t ,c ,thickness );};
 
+// addChartPart stores the chart space as a new chart part of the document and
+// returns the relationship to it added to rels.
+func (_dacfe *Document )addChartPart (_ffcg _aeb .Relationships ,_bdeff *_dcd .ChartSpace )_aeb .Relationship {_agbg :=_dacfe .nextChartIndex ();_ccab :=_c .AbsoluteFilename (_c .DocTypeDocument ,_c .ChartType ,_agbg );_dacfe ._gcagf =append (_dacfe ._gcagf ,_bdeff );_dacfe ._ebgfc =append (_dacfe ._ebgfc ,_ccab );_dacfe .ContentTypes .AddOverride ("\u002f"+_ccab ,_c .ChartContentType );return _ffcg .AddRelationship (_cf .Sprintf ("\u0063\u0068\u0061r\u0074\u0073\u002fch\u0061r\u0074\u0025\u0064\u002ex\u006dl",_agbg ),_c .ChartType );};
+
+// SetHidden sets the run to hidden text (w:vanish) so that it is not displayed
+// or printed unless hidden text display is enabled.
+func (_bdac Run )SetHidden (b bool )Run {if !b {if _bdac ._bfbb .RPr !=nil {_bdac ._bfbb .RPr .Vanish =nil ;_bdac .removeEmptyRPr ();};return _bdac ;};_bdac .Properties ()._bfbg .Vanish =_fgg .NewCT_OnOff ();return _bdac ;};
+
 // SetValue sets the width value.
 func (_acae TableWidth )SetValue (m _ce .Distance ){_acae ._eegef .WAttr =&_fgg .ST_MeasurementOrPercent {};_acae ._eegef .WAttr .ST_DecimalNumberOrPercent =&_fgg .ST_DecimalNumberOrPercent {};_acae ._eegef .WAttr .ST_DecimalNumberOrPercent .ST_UnqualifiedPercentage =_c .Int64 (int64 (m /_ce .Twips ));_acae ._eegef .TypeAttr =_fgg .ST_TblWidthDxa ;};
 
@@ -1257,12 +2169,23 @@ func (_cddb TableConditionalFormatting )X ()*_fgg .CT_TblStylePr {return _cddb .
 // RunProperties returns the run properties controlling text formatting within the table.
 func (_defda TableConditionalFormatting )RunProperties ()RunProperties {if _defda ._abace .RPr ==nil {_defda ._abace .RPr =_fgg .NewCT_RPr ();};return RunProperties {_defda ._abace .RPr };};
 
+// AddChartAnchored adds an anchored (floating) drawing that displays the chart.
+// The chart is stored as a separate part of the document and is written when
+// the document is saved, so it can still be modified after it has been added.
+// The drawing is initially 6 by 3.5 inches and can be resized with
+// AnchoredDrawing.SetSize.  The run must already be part of a paragraph.
+func (_agcdb Run )AddChartAnchored (c _gac .Chart )(AnchoredDrawing ,error ){if c .X ()==nil {return AnchoredDrawing {},_ef .New ("c\u0068\u0061\u0072\u0074\u0020\u006d\u0075\u0073\u0074\u0020\u006e\u006f\u0074\u0020\u0062\u0065\u0020\u0065\u006dp\u0074\u0079");};if _agcdb ._adbf ==nil {return AnchoredDrawing {},_ef .New ("\u0072\u0075\u006e\u0020m\u0075\u0073\u0074\u0020\u0062\u0065\u006c\u006fn\u0067\u0020\u0074\u006f\u0020\u0061\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074");};_aedda :=_agcdb ._adbf .addChartPart (_agcdb ._adbf .relsOfRun (_agcdb ),c .X ());_ecac :=_agcdb .addAnchor (5486400,3200400,"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065ma\u0073\u002eo\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073.o\u0072g\u002f\u0064\u0072\u0061w\u0069n\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002f\u0063\u0068\u0061\u0072\u0074");_dbdbe :=_dcd .NewChart ();_dbdbe .IdAttr =_aedda .ID ();_ecac ._gd .Graphic .GraphicData .Any =append (_ecac ._gd .Graphic .GraphicData .Any ,_dbdbe );return _ecac ,nil ;};
+
 // AddDefinition adds a new numbering definition.
 func (_decdd Numbering )AddDefinition ()NumberingDefinition {_ggaab :=_fgg .NewCT_Num ();_ebbd :=int64 (1);for _ ,_bffe :=range _decdd .Definitions (){if _bffe .AbstractNumberID ()>=_ebbd {_ebbd =_bffe .AbstractNumberID ()+1;};};_ageg :=int64 (1);for _ ,_gcbd :=range _decdd .X ().Num {if _gcbd .NumIdAttr >=_ageg {_ageg =_gcbd .NumIdAttr +1;};};_ggaab .NumIdAttr =_ageg ;_ggaab .AbstractNumId =_fgg .NewCT_DecimalNumber ();_ggaab .AbstractNumId .ValAttr =_ebbd ;_ecge :=_fgg .NewCT_AbstractNum ();_ecge .AbstractNumIdAttr =_ebbd ;_decdd ._fdda .AbstractNum =append (_decdd ._fdda .AbstractNum ,_ecge );_decdd ._fdda .Num =append (_decdd ._fdda .Num ,_ggaab );return NumberingDefinition {_ecge };};
 
 // SetStart sets the cell start margin
 func (_cgb CellMargins )SetStart (d _ce .Distance ){_cgb ._bgg .Start =_fgg .NewCT_TblWidth ();_eb (_cgb ._bgg .Start ,d );};
 
+// replaceText replaces the text, tabs and non breaking hyphens in the run with
+// s, placing the new content where the first replaced element was.
+func (_dadgb Run )replaceText (_dfgda string ){_bdeaa :=Run {_dadgb ._adbf ,_fgg .NewCT_R ()};for _fdabe ,_afgac :=range _a .Split (_dfgda ,"\u0009"){if _fdabe > 0{_bdeaa .AddTab ();};if _afgac !=""{_bdeaa .AddText (_afgac );};};_bdbdd :=make ([]*_fgg .EG_RunInnerContent ,0,len (_dadgb ._bfbb .EG_RunInnerContent )+len (_bdeaa ._bfbb .EG_RunInnerContent ));_cdeag :=false ;for _ ,_cega :=range _dadgb ._bfbb .EG_RunInnerContent {if _cega .T !=nil ||_cega .Tab !=nil ||_cega .NoBreakHyphen !=nil {if !_cdeag {_bdbdd =append (_bdbdd ,_bdeaa ._bfbb .EG_RunInnerContent ...);_cdeag =true ;};continue ;};_bdbdd =append (_bdbdd ,_cega );};if !_cdeag {_bdbdd =append (_bdbdd ,_bdeaa ._bfbb .EG_RunInnerContent ...);};_dadgb ._bfbb .EG_RunInnerContent =_bdbdd ;};
+
 // Runs returns all of the runs in a paragraph.
 func (_cfbaa Paragraph )Runs ()[]Run {_bcbf :=[]Run {};for _ ,_agde :=range _cfbaa ._cfdb .EG_PContent {for _ ,_ddagf :=range _agde .EG_ContentRunContent {if _ddagf .R !=nil {_bcbf =append (_bcbf ,Run {_cfbaa ._eecc ,_ddagf .R });};if _ddagf .Sdt !=nil &&_ddagf .Sdt .SdtContent !=nil {for _ ,_aab :=range _ddagf .Sdt .SdtContent .EG_ContentRunContent {if _aab .R !=nil {_bcbf =append (_bcbf ,Run {_cfbaa ._eecc ,_aab .R });};};};};};return _bcbf ;};
 
@@ -1272,12 +2195,21 @@ func (_edee CellMargins )SetBottomPct (pct float64 ){_edee ._bgg .Bottom =_fgg .
 // SetCellSpacingAuto sets the cell spacing within a table to automatic.
 func (_fabb TableProperties )SetCellSpacingAuto (){_fabb ._caea .TblCellSpacing =_fgg .NewCT_TblWidth ();_fabb ._caea .TblCellSpacing .TypeAttr =_fgg .ST_TblWidthAuto ;};
 
-// SetFontFamily sets the Ascii & HAnsi fonly family for a run.
-func (_gabf RunProperties )SetFontFamily (family string ){if _gabf ._bfbg .RFonts ==nil {_gabf ._bfbg .RFonts =_fgg .NewCT_Fonts ();};_gabf ._bfbg .RFonts .AsciiAttr =_c .String (family );_gabf ._bfbg .RFonts .HAnsiAttr =_c .String (family );_gabf ._bfbg .RFonts .EastAsiaAttr =_c .String (family );};
+// SetFontFamily sets the Ascii, HAnsi and EastAsia font family for a run.  The
+// complex script font is left unchanged, so right-to-left and other complex
+// script text keeps its existing font; use SetFontFamilyAll to change it too.
+func (_gabf RunProperties )SetFontFamily (family string )RunProperties {if _gabf ._bfbg .RFonts ==nil {_gabf ._bfbg .RFonts =_fgg .NewCT_Fonts ();};_gabf ._bfbg .RFonts .AsciiAttr =_c .String (family );_gabf ._bfbg .RFonts .HAnsiAttr =_c .String (family );_gabf ._bfbg .RFonts .EastAsiaAttr =_c .String (family );return _gabf ;};
 
 // AddTab adds tab to a run and can be used with the the Paragraph's tab stops.
 func (_acee Run )AddTab (){_ecgad :=_acee .newIC ();_ecgad .Tab =_fgg .NewCT_Empty ()};
 
+// SetTop sets the top border to a specified type, color and thickness.
+func (_bbffb ParagraphBorders )SetTop (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_bbffb ._x .Top =_fgg .NewCT_Border ();_cafa (_bbffb ._x .Top ,t ,c ,thickness );};
+
+// UnderlineStyle returns the underline style of the run, or
+// ST_UnderlineUnset if the run has no direct underline formatting.
+func (_ffgaa Run )UnderlineStyle ()_fgg .ST_Underline {if _gcge :=_ffgaa ._bfbb .RPr ;_gcge !=nil &&_gcge .U !=nil {return _gcge .U .ValAttr ;};return _fgg .ST_UnderlineUnset ;};
+
 // AddSection adds a new document section with an optional section break.  If t
 // is ST_SectionMarkUnset, then no break will be inserted.
 func (_afdg ParagraphProperties )AddSection (t _fgg .ST_SectionMark )Section {_afdg ._fdfc .SectPr =_fgg .NewCT_SectPr ();if t !=_fgg .ST_SectionMarkUnset {_afdg ._fdfc .SectPr .Type =_fgg .NewCT_SectType ();_afdg ._fdfc .SectPr .Type .ValAttr =t ;};return Section {_afdg ._dfag ,_afdg ._fdfc .SectPr };};
@@ -1288,6 +2220,14 @@ func (_egce Style )StyleID ()string {if _egce ._dedd .StyleIdAttr ==nil {return
 // Underline returns the type of run underline.
 func (_efdg RunProperties )Underline ()_fgg .ST_Underline {if _egefg :=_efdg ._bfbg .U ;_egefg !=nil {return _egefg .ValAttr ;};return 0;};
 
+// writeTempPart writes content to a new temporary file for a package part,
+// returning the path of the file.
+func (_ggca *Document )writeTempPart (_gdgbb []byte ,_faee string )(string ,error ){if _ggca .TmpPath ==""{_befb ,_cgad :=_aebc .TempDir ("\u0075\u006e\u0069\u006f\u0066\u0066\u0069\u0063\u0065\u002d\u0064\u006f\u0063\u0078");if _cgad !=nil {return "",_cgad ;};_ggca .TmpPath =_befb ;};_badde ,_adca :=_aebc .TempFile (_ggca .TmpPath ,_faee );if _adca !=nil {return "",_adca ;};if _ ,_adca =_badde .Write (_gdgbb );_adca !=nil {_badde .Close ();return "",_adca ;};if _adca =_badde .Close ();_adca !=nil {return "",_adca ;};return _badde .Name (),nil ;};const _egaa ="\u0068\u0074\u0074\u0070:\u002f\u002f\u0073\u0063h\u0065\u006das\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061ts\u002e\u006f\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075me\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002f\u0072\u0065\u006c\u0061ti\u006f\u006e\u0073\u0068\u0069\u0070\u0073\u002f\u006f\u006c\u0065\u004f\u0062\u006a\u0065\u0063\u0074";
+
+// RunNode is an element of the content of a run as returned by Run.AST.  It is
+// implemented by TextNode, TabNode, BreakNode, DrawingNode and FieldNode only.
+type RunNode interface{isRunNode ()};
+
 // StructuredDocumentTags returns the structured document tags in the document
 // which are commonly used in document templates.
 func (_dceg *Document )StructuredDocumentTags ()[]StructuredDocumentTag {_adc :=[]StructuredDocumentTag {};for _ ,_fddc :=range _dceg ._cdaa .Body .EG_BlockLevelElts {for _ ,_ddee :=range _fddc .EG_ContentBlockContent {if _ddee .Sdt !=nil {_adc =append (_adc ,StructuredDocumentTag {_dceg ,_ddee .Sdt });};};};return _adc ;};
@@ -1295,22 +2235,43 @@ func (_dceg *Document )StructuredDocumentTags ()[]StructuredDocumentTag {_adc :=
 // CharacterSpacingMeasure returns paragraph characters spacing with its measure which can be mm, cm, in, pt, pc or pi.
 func (_dbbe ParagraphProperties )CharacterSpacingMeasure ()string {if _gdfb :=_dbbe ._fdfc .RPr .Spacing ;_gdfb !=nil {_dcdg :=_gdfb .ValAttr ;if _dcdg .ST_UniversalMeasure !=nil {return *_dcdg .ST_UniversalMeasure ;};};return "";};
 
-// RunProperties controls run styling properties
+// RunProperties controls run styling properties. The setters return the
+// RunProperties so that calls can be chained. The properties of the schema are
+// always written in their defined order, regardless of the order the setters
+// were called in. Text effects from the w14 namespace are written after them and
+// before a recorded revision of the properties, which is where Word expects them.
 type RunProperties struct{_bfbg *_fgg .CT_RPr };
 
+// applyStyleRPr merges the run properties of the style with the given ID into
+// rpr, resolving the based on chain. If id is empty, the default style of the
+// given type is used.
+func _abdec (_ccaca *_fgg .CT_RPr ,_fgag *_fgg .Styles ,_dcbc string ,_cafd _fgg .ST_StyleType ){var _cgc *_fgg .CT_Style ;for _ ,_dggg :=range _fgag .Style {if _dggg .TypeAttr !=_cafd {continue ;};if _dcbc ==""&&_dggg .DefaultAttr !=nil &&((_dggg .DefaultAttr .Bool !=nil &&*_dggg .DefaultAttr .Bool )||_dggg .DefaultAttr .ST_OnOff1 ==_fg .ST_OnOff1On ){_cgc =_dggg ;break ;};if _dcbc !=""&&_dggg .StyleIdAttr !=nil &&*_dggg .StyleIdAttr ==_dcbc {_cgc =_dggg ;break ;};};_adaga :=[]*_fgg .CT_Style {};_afa :=map[*_fgg .CT_Style ]struct{}{};for _cgc !=nil {if _ ,_fgbg :=_afa [_cgc ];_fgbg {break ;};_afa [_cgc ]=struct{}{};_adaga =append (_adaga ,_cgc );if _cgc .BasedOn ==nil {break ;};var _gcdce *_fgg .CT_Style ;for _ ,_fdef :=range _fgag .Style {if _fdef .StyleIdAttr !=nil &&*_fdef .StyleIdAttr ==_cgc .BasedOn .ValAttr {_gcdce =_fdef ;break ;};};_cgc =_gcdce ;};for _ffdb :=len (_adaga )-1;_ffdb >=0;_ffdb --{if _adaga [_ffdb ].RPr !=nil {_cbbc (_ccaca ,_adaga [_ffdb ].RPr );};};};func _cbbc (_fbdd ,_acfc *_fgg .CT_RPr ){_fbdd .RFonts =_fefg (_fbdd .RFonts ,_acfc .RFonts );if _acfc .B !=nil {_fbdd .B =_acfc .B ;};if _acfc .BCs !=nil {_fbdd .BCs =_acfc .BCs ;};if _acfc .I !=nil {_fbdd .I =_acfc .I ;};if _acfc .ICs !=nil {_fbdd .ICs =_acfc .ICs ;};if _acfc .Caps !=nil {_fbdd .Caps =_acfc .Caps ;};if _acfc .SmallCaps !=nil {_fbdd .SmallCaps =_acfc .SmallCaps ;};if _acfc .Strike !=nil {_fbdd .Strike =_acfc .Strike ;};if _acfc .Dstrike !=nil {_fbdd .Dstrike =_acfc .Dstrike ;};if _acfc .Outline !=nil {_fbdd .Outline =_acfc .Outline ;};if _acfc .Shadow !=nil {_fbdd .Shadow =_acfc .Shadow ;};if _acfc .Emboss !=nil {_fbdd .Emboss =_acfc .Emboss ;};if _acfc .Imprint !=nil {_fbdd .Imprint =_acfc .Imprint ;};if _acfc .NoProof !=nil {_fbdd .NoProof =_acfc .NoProof ;};if _acfc .SnapToGrid !=nil {_fbdd .SnapToGrid =_acfc .SnapToGrid ;};if _acfc .Vanish !=nil {_fbdd .Vanish =_acfc .Vanish ;};if _acfc .WebHidden !=nil {_fbdd .WebHidden =_acfc .WebHidden ;};if _acfc .Color !=nil {_fbdd .Color =_acfc .Color ;};if _acfc .Spacing !=nil {_fbdd .Spacing =_acfc .Spacing ;};if _acfc .W !=nil {_fbdd .W =_acfc .W ;};if _acfc .Kern !=nil {_fbdd .Kern =_acfc .Kern ;};if _acfc .Position !=nil {_fbdd .Position =_acfc .Position ;};if _acfc .Sz !=nil {_fbdd .Sz =_acfc .Sz ;};if _acfc .SzCs !=nil {_fbdd .SzCs =_acfc .SzCs ;};if _acfc .Highlight !=nil {_fbdd .Highlight =_acfc .Highlight ;};if _acfc .U !=nil {_fbdd .U =_acfc .U ;};if _acfc .Effect !=nil {_fbdd .Effect =_acfc .Effect ;};if _acfc .Bdr !=nil {_fbdd .Bdr =_acfc .Bdr ;};if _acfc .Shd !=nil {_fbdd .Shd =_acfc .Shd ;};if _acfc .FitText !=nil {_fbdd .FitText =_acfc .FitText ;};if _acfc .VertAlign !=nil {_fbdd .VertAlign =_acfc .VertAlign ;};if _acfc .Rtl !=nil {_fbdd .Rtl =_acfc .Rtl ;};if _acfc .Cs !=nil {_fbdd .Cs =_acfc .Cs ;};if _acfc .Em !=nil {_fbdd .Em =_acfc .Em ;};_fbdd .Lang =_edgce (_fbdd .Lang ,_acfc .Lang );if _acfc .EastAsianLayout !=nil {_fbdd .EastAsianLayout =_acfc .EastAsianLayout ;};if _acfc .SpecVanish !=nil {_fbdd .SpecVanish =_acfc .SpecVanish ;};if _acfc .OMath !=nil {_fbdd .OMath =_acfc .OMath ;};for _ ,_gcfdae :=range _acfc .Extra {_fbdd .Extra =_afced (_fbdd .Extra ,_gbbdg (_gcfdae ),_gcfdae );};};
+
+// RsidR returns the revision identifier of the editing session that added the
+// run, or an empty string if it isn't set.
+func (_cefad Run )RsidR ()string {if _cefad ._bfbb .RsidRAttr ==nil {return "";};return *_cefad ._bfbb .RsidRAttr ;};
+
 // X returns the inner wrapped type
 func (_cfb CellBorders )X ()*_fgg .CT_TcBorders {return _cfb ._bff };func (_dbaa *Document )InsertTableBefore (relativeTo Paragraph )Table {return _dbaa .insertTable (relativeTo ,true );};
 
 // SetTextWrapSquare sets the text wrap to square with a given wrap type.
 func (_gc AnchoredDrawing )SetTextWrapSquare (t _fgg .WdST_WrapText ){_gc ._gd .Choice =&_fgg .WdEG_WrapTypeChoice {};_gc ._gd .Choice .WrapSquare =_fgg .NewWdCT_WrapSquare ();_gc ._gd .Choice .WrapSquare .WrapTextAttr =t ;};
 
+// SetBottomBorder sets the bottom border of the paragraph, which draws a rule
+// across the full width of the paragraph below its last line.
+func (_gdgbf Paragraph )SetBottomBorder (t _fgg .ST_Border ,c _bbd .Color ,thickness _ce .Distance ){_gdgbf .Properties ().Borders ().SetBottom (t ,c ,thickness );};
+
+// applyPreserveWhitespace applies the SetPreserveWhitespace option to the
+// xml:space attribute of the text in the document before it is written.
+func (_baffa *Document )applyPreserveWhitespace (){if _baffa ._fcbge ==nil {return ;};_bddbd :=_baffa .allParagraphs ();if _baffa ._begd !=nil {for _ ,_beaaf :=range _baffa .Footnotes (){_bddbd =append (_bddbd ,_eefag (_beaaf .Paragraphs ())...);};};if _baffa ._acd !=nil {for _ ,_affdc :=range _baffa .Endnotes (){_bddbd =append (_bddbd ,_eefag (_affdc .Paragraphs ())...);};};for _ ,_agfc :=range _bddbd {for _ ,_dfbef :=range _agfc .allRuns (){for _ ,_deffa :=range _dfbef ._bfbb .EG_RunInnerContent {for _ ,_beef :=range []*_fgg .CT_Text {_deffa .T ,_deffa .DelText ,_deffa .InstrText ,_deffa .DelInstrText }{if _beef ==nil {continue ;};if *_baffa ._fcbge {_beef .SpaceAttr =_c .String ("\u0070\u0072\u0065\u0073\u0065\u0072v\u0065");}else {_beef .SpaceAttr =nil ;};};};};};};
+
 // SetHeadingLevel sets a heading level and style based on the level to a
 // paragraph.  The default styles for a new unioffice document support headings
 // from level 1 to 8.
 func (_aafa ParagraphProperties )SetHeadingLevel (idx int ){_aafa .SetStyle (_cf .Sprintf ("\u0048e\u0061\u0064\u0069\u006e\u0067\u0025d",idx ));if _aafa ._fdfc .NumPr ==nil {_aafa ._fdfc .NumPr =_fgg .NewCT_NumPr ();};_aafa ._fdfc .NumPr .Ilvl =_fgg .NewCT_DecimalNumber ();_aafa ._fdfc .NumPr .Ilvl .ValAttr =int64 (idx );};
 
 // Save writes the document to an io.Writer in the Zip package format.
-func (_gfaa *Document )Save (w _ae .Writer )error {if _aef :=_gfaa ._cdaa .Validate ();_aef !=nil {_c .Log ("\u0076\u0061\u006c\u0069\u0064\u0061\u0074\u0069\u006f\u006e\u0020\u0065\u0072\u0072\u006fr\u0020i\u006e\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u003a\u0020\u0025\u0073",_aef );};_aee :=_c .DocTypeDocument ;if !_ba .GetLicenseKey ().IsLicensed ()&&!_bgdb {_cf .Println ("\u0055\u006e\u006ci\u0063\u0065\u006e\u0073e\u0064\u0020\u0076\u0065\u0072\u0073\u0069o\u006e\u0020\u006f\u0066\u0020\u0055\u006e\u0069\u004f\u0066\u0066\u0069\u0063\u0065");_cf .Println ("\u002d\u0020\u0047e\u0074\u0020\u0061\u0020\u0074\u0072\u0069\u0061\u006c\u0020\u006c\u0069\u0063\u0065\u006e\u0073\u0065\u0020\u006f\u006e\u0020\u0068\u0074\u0074\u0070\u0073\u003a\u002f\u002fu\u006e\u0069\u0064\u006f\u0063\u002e\u0069\u006f");return _ef .New ("\u0075\u006e\u0069\u006f\u0066\u0066\u0069\u0063\u0065\u0020\u006ci\u0063\u0065\u006e\u0073\u0065\u0020\u0072\u0065\u0071\u0075i\u0072\u0065\u0064");};_ada :=_f .NewWriter (w );defer _ada .Close ();if _fag :=_ca .MarshalXML (_ada ,_c .BaseRelsFilename ,_gfaa .Rels .X ());_fag !=nil {return _fag ;};if _ec :=_ca .MarshalXMLByType (_ada ,_aee ,_c .ExtendedPropertiesType ,_gfaa .AppProperties .X ());_ec !=nil {return _ec ;};if _fcga :=_ca .MarshalXMLByType (_ada ,_aee ,_c .CorePropertiesType ,_gfaa .CoreProperties .X ());_fcga !=nil {return _fcga ;};if _gfaa .CustomProperties .X ()!=nil {if _gdg :=_ca .MarshalXMLByType (_ada ,_aee ,_c .CustomPropertiesType ,_gfaa .CustomProperties .X ());_gdg !=nil {return _gdg ;};};if _gfaa .Thumbnail !=nil {_cdd ,_caa :=_ada .Create ("\u0064\u006f\u0063Pr\u006f\u0070\u0073\u002f\u0074\u0068\u0075\u006d\u0062\u006e\u0061\u0069\u006c\u002e\u006a\u0070\u0065\u0067");if _caa !=nil {return _caa ;};if _dce :=_dg .Encode (_cdd ,_gfaa .Thumbnail ,nil );_dce !=nil {return _dce ;};};if _dceb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .SettingsType ,_gfaa .Settings .X ());_dceb !=nil {return _dceb ;};_edgb :=_c .AbsoluteFilename (_aee ,_c .OfficeDocumentType ,0);if _ebd :=_ca .MarshalXML (_ada ,_edgb ,_gfaa ._cdaa );_ebd !=nil {return _ebd ;};if _adbb :=_ca .MarshalXML (_ada ,_ca .RelationsPathFor (_edgb ),_gfaa ._efe .X ());_adbb !=nil {return _adbb ;};if _gfaa .Numbering .X ()!=nil {if _eee :=_ca .MarshalXMLByType (_ada ,_aee ,_c .NumberingType ,_gfaa .Numbering .X ());_eee !=nil {return _eee ;};};if _eeb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .StylesType ,_gfaa .Styles .X ());_eeb !=nil {return _eeb ;};if _gfaa ._egb !=nil {if _fdb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .WebSettingsType ,_gfaa ._egb );_fdb !=nil {return _fdb ;};};if _gfaa ._fbg !=nil {if _bce :=_ca .MarshalXMLByType (_ada ,_aee ,_c .FontTableType ,_gfaa ._fbg );_bce !=nil {return _bce ;};};if _gfaa ._acd !=nil {if _cbb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .EndNotesType ,_gfaa ._acd );_cbb !=nil {return _cbb ;};};if _gfaa ._begd !=nil {if _ddag :=_ca .MarshalXMLByType (_ada ,_aee ,_c .FootNotesType ,_gfaa ._begd );_ddag !=nil {return _ddag ;};};for _dba ,_gca :=range _gfaa ._fae {if _fgc :=_ca .MarshalXMLByTypeIndex (_ada ,_aee ,_c .ThemeType ,_dba +1,_gca );_fgc !=nil {return _fgc ;};};for _dbcf ,_acf :=range _gfaa ._fbc {_aec :=_c .AbsoluteFilename (_aee ,_c .HeaderType ,_dbcf +1);if _efec :=_ca .MarshalXML (_ada ,_aec ,_acf );_efec !=nil {return _efec ;};if !_gfaa ._ff [_dbcf ].IsEmpty (){_ca .MarshalXML (_ada ,_ca .RelationsPathFor (_aec ),_gfaa ._ff [_dbcf ].X ());};};for _aae ,_cbcg :=range _gfaa ._eefb {_ead :=_c .AbsoluteFilename (_aee ,_c .FooterType ,_aae +1);if _eaa :=_ca .MarshalXMLByTypeIndex (_ada ,_aee ,_c .FooterType ,_aae +1,_cbcg );_eaa !=nil {return _eaa ;};if !_gfaa ._edgc [_aae ].IsEmpty (){_ca .MarshalXML (_ada ,_ca .RelationsPathFor (_ead ),_gfaa ._edgc [_aae ].X ());};};for _fef ,_bda :=range _gfaa .Images {if _ccbd :=_aeb .AddImageToZip (_ada ,_bda ,_fef +1,_c .DocTypeDocument );_ccbd !=nil {return _ccbd ;};};if _fdbg :=_ca .MarshalXML (_ada ,_c .ContentTypesFilename ,_gfaa .ContentTypes .X ());_fdbg !=nil {return _fdbg ;};if _cded :=_gfaa .WriteExtraFiles (_ada );_cded !=nil {return _cded ;};return _ada .Close ();};
+func (_gfaa *Document )Save (w _ae .Writer )error {return _gfaa .save (w ,nil )};func (_gfaa *Document )save (w _ae .Writer ,_dfbae *ImageSaveOptions )error {if _aef :=_gfaa ._cdaa .Validate ();_aef !=nil {_c .Log ("\u0076\u0061\u006c\u0069\u0064\u0061\u0074\u0069\u006f\u006e\u0020\u0065\u0072\u0072\u006fr\u0020i\u006e\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u003a\u0020\u0025\u0073",_aef );};if _gcfbde :=_gfaa .validateImageRels ();_gcfbde !=nil {_c .Log ("\u0076\u0061\u006c\u0069\u0064\u0061\u0074\u0069\u006f\u006e\u0020\u0065\u0072\u0072\u006fr\u0020i\u006e\u0020\u0064\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u003a\u0020\u0025\u0073",_gcfbde );};_gfaa .applyPreserveWhitespace ();_aee :=_c .DocTypeDocument ;if !_ba .GetLicenseKey ().IsLicensed ()&&!_bgdb {_cf .Println ("\u0055\u006e\u006ci\u0063\u0065\u006e\u0073e\u0064\u0020\u0076\u0065\u0072\u0073\u0069o\u006e\u0020\u006f\u0066\u0020\u0055\u006e\u0069\u004f\u0066\u0066\u0069\u0063\u0065");_cf .Println ("\u002d\u0020\u0047e\u0074\u0020\u0061\u0020\u0074\u0072\u0069\u0061\u006c\u0020\u006c\u0069\u0063\u0065\u006e\u0073\u0065\u0020\u006f\u006e\u0020\u0068\u0074\u0074\u0070\u0073\u003a\u002f\u002fu\u006e\u0069\u0064\u006f\u0063\u002e\u0069\u006f");return _ef .New ("\u0075\u006e\u0069\u006f\u0066\u0066\u0069\u0063\u0065\u0020\u006ci\u0063\u0065\u006e\u0073\u0065\u0020\u0072\u0065\u0071\u0075i\u0072\u0065\u0064");};_ada :=_f .NewWriter (w );defer _ada .Close ();if _fag :=_ca .MarshalXML (_ada ,_c .BaseRelsFilename ,_gfaa .Rels .X ());_fag !=nil {return _fag ;};if _ec :=_ca .MarshalXMLByType (_ada ,_aee ,_c .ExtendedPropertiesType ,_gfaa .AppProperties .X ());_ec !=nil {return _ec ;};if _fcga :=_ca .MarshalXMLByType (_ada ,_aee ,_c .CorePropertiesType ,_gfaa .CoreProperties .X ());_fcga !=nil {return _fcga ;};if _gfaa .CustomProperties .X ()!=nil {if _gdg :=_ca .MarshalXMLByType (_ada ,_aee ,_c .CustomPropertiesType ,_gfaa .CustomProperties .X ());_gdg !=nil {return _gdg ;};};if _gfaa .Thumbnail !=nil {_cdd ,_caa :=_ada .Create ("\u0064\u006f\u0063Pr\u006f\u0070\u0073\u002f\u0074\u0068\u0075\u006d\u0062\u006e\u0061\u0069\u006c\u002e\u006a\u0070\u0065\u0067");if _caa !=nil {return _caa ;};if _dce :=_dg .Encode (_cdd ,_gfaa .Thumbnail ,nil );_dce !=nil {return _dce ;};};if _dceb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .SettingsType ,_gfaa .Settings .X ());_dceb !=nil {return _dceb ;};_edgb :=_c .AbsoluteFilename (_aee ,_c .OfficeDocumentType ,0);if _ebd :=_ca .MarshalXML (_ada ,_edgb ,_gfaa ._cdaa );_ebd !=nil {return _ebd ;};if _adbb :=_ca .MarshalXML (_ada ,_ca .RelationsPathFor (_edgb ),_gfaa ._efe .X ());_adbb !=nil {return _adbb ;};if _gfaa .Numbering .X ()!=nil {if _eee :=_ca .MarshalXMLByType (_ada ,_aee ,_c .NumberingType ,_gfaa .Numbering .X ());_eee !=nil {return _eee ;};};if _eeb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .StylesType ,_gfaa .Styles .X ());_eeb !=nil {return _eeb ;};if _gfaa ._egb !=nil {if _fdb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .WebSettingsType ,_gfaa ._egb );_fdb !=nil {return _fdb ;};};if _gfaa ._fbg !=nil {if _bce :=_ca .MarshalXMLByType (_ada ,_aee ,_c .FontTableType ,_gfaa ._fbg );_bce !=nil {return _bce ;};};if _gfaa ._acd !=nil {if _cbb :=_ca .MarshalXMLByType (_ada ,_aee ,_c .EndNotesType ,_gfaa ._acd );_cbb !=nil {return _cbb ;};};if _gfaa ._begd !=nil {if _ddag :=_ca .MarshalXMLByType (_ada ,_aee ,_c .FootNotesType ,_gfaa ._begd );_ddag !=nil {return _ddag ;};};for _dba ,_gca :=range _gfaa ._fae {if _fgc :=_ca .MarshalXMLByTypeIndex (_ada ,_aee ,_c .ThemeType ,_dba +1,_gca );_fgc !=nil {return _fgc ;};};for _dbcf ,_acf :=range _gfaa ._fbc {_aec :=_c .AbsoluteFilename (_aee ,_c .HeaderType ,_dbcf +1);if _efec :=_ca .MarshalXML (_ada ,_aec ,_acf );_efec !=nil {return _efec ;};if !_gfaa ._ff [_dbcf ].IsEmpty (){_ca .MarshalXML (_ada ,_ca .RelationsPathFor (_aec ),_gfaa ._ff [_dbcf ].X ());};};for _aae ,_cbcg :=range _gfaa ._eefb {_ead :=_c .AbsoluteFilename (_aee ,_c .FooterType ,_aae +1);if _eaa :=_ca .MarshalXMLByTypeIndex (_ada ,_aee ,_c .FooterType ,_aae +1,_cbcg );_eaa !=nil {return _eaa ;};if !_gfaa ._edgc [_aae ].IsEmpty (){_ca .MarshalXML (_ada ,_ca .RelationsPathFor (_ead ),_gfaa ._edgc [_aae ].X ());};};for _fef ,_bda :=range _gfaa .Images {if _dfbae !=nil {if _gfcea ,_eegba :=_gfaa .recompressImage (_bda ,*_dfbae );_eegba {_bda =_gfcea ;};};if _ccbd :=_aeb .AddImageToZip (_ada ,_bda ,_fef +1,_c .DocTypeDocument );_ccbd !=nil {return _ccbd ;};};for _fbcda ,_gbbga :=range _gfaa ._gcagf {if _cdcbe :=_ca .MarshalXML (_ada ,_gfaa ._ebgfc [_fbcda ],_gbbga );_cdcbe !=nil {return _cdcbe ;};};if _fdbg :=_ca .MarshalXML (_ada ,_c .ContentTypesFilename ,_gfaa .ContentTypes .X ());_fdbg !=nil {return _fdbg ;};if _cded :=_gfaa .WriteExtraFiles (_ada );_cded !=nil {return _cded ;};return _ada .Close ();};
 
 // SetBefore sets the spacing that comes before the paragraph.
 func (_ffe ParagraphSpacing )SetBefore (before _ce .Distance ){_ffe ._bged .BeforeAttr =&_fg .ST_TwipsMeasure {};_ffe ._bged .BeforeAttr .ST_UnsignedDecimalNumber =_c .Uint64 (uint64 (before /_ce .Twips ));};
@@ -1318,6 +2279,8 @@ func (_ffe ParagraphSpacing )SetBefore (before _ce .Distance ){_ffe ._bged .Befo
 // SetHANSITheme sets the font H ANSI Theme.
 func (_faea Fonts )SetHANSITheme (t _fgg .ST_Theme ){_faea ._ddg .HAnsiThemeAttr =t };
 
+const _aged ="\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066o\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002f\u0072el\u0061\u0074\u0069\u006f\u006e\u0073\u0068\u0069\u0070\u0073\u002f\u0061\u0046\u0043\u0068\u0075\u006e\u006b";
+
 // X returns the inner wrapped XML type.
 func (_aebcc TableWidth )X ()*_fgg .CT_TblWidth {return _aebcc ._eegef };
 
@@ -1331,8 +2294,13 @@ func (_accf FormField )SetValue (v string ){if _accf ._edda .DdList !=nil {for _
 // SetVerticalAlignment sets the vertical alignment of content within a table cell.
 func (_ge CellProperties )SetVerticalAlignment (align _fgg .ST_VerticalJc ){if align ==_fgg .ST_VerticalJcUnset {_ge ._egf .VAlign =nil ;}else {_ge ._egf .VAlign =_fgg .NewCT_VerticalJc ();_ge ._egf .VAlign .ValAttr =align ;};};
 
-// AddText adds tet to a run.
-func (_bdbd Run )AddText (s string ){_fbbd :=_fgg .NewEG_RunInnerContent ();_bdbd ._bfbb .EG_RunInnerContent =append (_bdbd ._bfbb .EG_RunInnerContent ,_fbbd );_fbbd .T =_fgg .NewCT_Text ();if _c .NeedsSpacePreserve (s ){_gff :="\u0070\u0072\u0065\u0073\u0065\u0072\u0076\u0065";_fbbd .T .SpaceAttr =&_gff ;};_fbbd .T .Content =s ;};
+// AddText adds tet to a run. Newlines in the text are converted to line breaks.
+func (_bdbd Run )AddText (s string ){if _a .ContainsAny (s ,"\u000d\u000a"){s =_a .Replace (s ,"\u000d\u000a","\u000a",-1);s =_a .Replace (s ,"\u000d","\u000a",-1);for _daad ,_ebabg :=range _a .Split (s ,"\u000a"){if _daad > 0{_bdbd .AddBreak ();};if _ebabg !=""{_bdbd .AddText (_ebabg );};};return ;};_fbbd :=_fgg .NewEG_RunInnerContent ();_bdbd ._bfbb .EG_RunInnerContent =append (_bdbd ._bfbb .EG_RunInnerContent ,_fbbd );_fbbd .T =_fgg .NewCT_Text ();if _c .NeedsSpacePreserve (s ){_gff :="\u0070\u0072\u0065\u0073\u0065\u0072\u0076\u0065";_fbbd .T .SpaceAttr =&_gff ;};_fbbd .T .Content =s ;};
+
+// ParagraphBorders are the borders of a paragraph.  Unlike runs, which only
+// support a single border that surrounds the whole run, each side of a
+// paragraph can have its own border, e.g. a rule below a heading.
+type ParagraphBorders struct{_x *_fgg .CT_PBdr };
 
 // Endnote is an individual endnote reference within the document.
 type Endnote struct{_cfba *Document ;_dfb *_fgg .CT_FtnEdn ;};func (_aegd Paragraph )ensurePPr (){if _aegd ._cfdb .PPr ==nil {_aegd ._cfdb .PPr =_fgg .NewCT_PPr ();};};
@@ -1345,14 +2313,34 @@ func (_cege Paragraph )RemoveEndnote (id int64 ){_fedcb :=_cege ._eecc ._acd ;va
 // match an ID defined in numbering.xml
 func (_bea Paragraph )SetNumberingDefinitionByID (abstractNumberID int64 ){_bea .ensurePPr ();if _bea ._cfdb .PPr .NumPr ==nil {_bea ._cfdb .PPr .NumPr =_fgg .NewCT_NumPr ();};_bafb :=_fgg .NewCT_DecimalNumber ();_bafb .ValAttr =int64 (abstractNumberID );_bea ._cfdb .PPr .NumPr .NumId =_bafb ;};
 
+// RemoveRuns removes every run in the document body, headers, footers,
+// footnotes and endnotes for which pred returns true, including runs within
+// hyperlinks, simple fields, structured document tags and text boxes.  It
+// returns the number of runs removed.
+func (_aaee *Document )RemoveRuns (pred func (Run )bool )int {_ebbed :=_aaee .allParagraphs ();if _aaee ._begd !=nil {for _ ,_aedgb :=range _aaee .Footnotes (){_ebbed =append (_ebbed ,_eefag (_aedgb .Paragraphs ())...);};};if _aaee ._acd !=nil {for _ ,_fgaca :=range _aaee .Endnotes (){_ebbed =append (_ebbed ,_eefag (_fgaca .Paragraphs ())...);};};_fced :=0;for _ ,_bddgb :=range _ebbed {var _cdga func (_dedce []*_fgg .EG_ContentRunContent )[]*_fgg .EG_ContentRunContent ;_cdga =func (_egffb []*_fgg .EG_ContentRunContent )[]*_fgg .EG_ContentRunContent {_fcac :=_egffb [:0];for _ ,_cacdd :=range _egffb {if _cacdd .R !=nil &&pred (Run {_bddgb ._eecc ,_cacdd .R }){_fced ++;continue ;};if _cacdd .Sdt !=nil &&_cacdd .Sdt .SdtContent !=nil {_cacdd .Sdt .SdtContent .EG_ContentRunContent =_cdga (_cacdd .Sdt .SdtContent .EG_ContentRunContent );};_fcac =append (_fcac ,_cacdd );};return _fcac ;};var _ecgg func (_gccdb []*_fgg .EG_PContent );_ecgg =func (_gece []*_fgg .EG_PContent ){for _ ,_acceg :=range _gece {_acceg .EG_ContentRunContent =_cdga (_acceg .EG_ContentRunContent );if _acceg .Hyperlink !=nil {_acceg .Hyperlink .EG_ContentRunContent =_cdga (_acceg .Hyperlink .EG_ContentRunContent );};for _ ,_fgba :=range _acceg .FldSimple {_ecgg (_fgba .EG_PContent );};};};_ecgg (_bddgb ._cfdb .EG_PContent );};return _fced ;};
+
+// SetAutoSpaceDE controls if Word automatically adjusts the spacing between
+// East Asian and Latin text in the paragraph.  The setting is written even when
+// enabled so that it overrides the paragraph style.
+func (_ecaga Paragraph )SetAutoSpaceDE (b bool ){_ecaga .ensurePPr ();_ecaga ._cfdb .PPr .AutoSpaceDE =_fgg .NewCT_OnOff ();if !b {_ecaga ._cfdb .PPr .AutoSpaceDE .ValAttr =&_fg .ST_OnOff {Bool :_c .Bool (false )};};};
+
+// WatermarkOptions controls the appearance of a text watermark added with
+// AddWatermark.  The zero value produces a light gray, diagonal watermark in
+// 72 point Calibri.
+type WatermarkOptions struct{Font string ;Size float64 ;Color _bbd .Color ;Horizontal bool ;};
+
 // SetFirstRow controls the conditional formatting for the first row in a table.
 func (_abcca TableLook )SetFirstRow (on bool ){if !on {_abcca ._gagb .FirstRowAttr =&_fg .ST_OnOff {};_abcca ._gagb .FirstRowAttr .ST_OnOff1 =_fg .ST_OnOff1Off ;}else {_abcca ._gagb .FirstRowAttr =&_fg .ST_OnOff {};_abcca ._gagb .FirstRowAttr .ST_OnOff1 =_fg .ST_OnOff1On ;};};
 
 // SetCellSpacingPercent sets the cell spacing within a table to a percent width.
 func (_fdgfe TableProperties )SetCellSpacingPercent (pct float64 ){_fdgfe ._caea .TblCellSpacing =_fgg .NewCT_TblWidth ();_fdgfe ._caea .TblCellSpacing .TypeAttr =_fgg .ST_TblWidthPct ;_fdgfe ._caea .TblCellSpacing .WAttr =&_fgg .ST_MeasurementOrPercent {};_fdgfe ._caea .TblCellSpacing .WAttr .ST_DecimalNumberOrPercent =&_fgg .ST_DecimalNumberOrPercent {};_fdgfe ._caea .TblCellSpacing .WAttr .ST_DecimalNumberOrPercent .ST_UnqualifiedPercentage =_c .Int64 (int64 (pct *50));};
 
+// AnchoredDrawing returns the drawing containing the text box, which controls
+// its position, size and wrapping.
+func (_dafb TextBox )AnchoredDrawing ()AnchoredDrawing {return AnchoredDrawing {_dafb ._fegb ,_dafb ._gecc };};
+
 // SetUnderline controls underline for a run style.
-func (_dcdf RunProperties )SetUnderline (style _fgg .ST_Underline ,c _bbd .Color ){if style ==_fgg .ST_UnderlineUnset {_dcdf ._bfbg .U =nil ;}else {_dcdf ._bfbg .U =_fgg .NewCT_Underline ();_dcdf ._bfbg .U .ColorAttr =&_fgg .ST_HexColor {};_dcdf ._bfbg .U .ColorAttr .ST_HexColorRGB =c .AsRGBString ();_dcdf ._bfbg .U .ValAttr =style ;};};
+func (_dcdf RunProperties )SetUnderline (style _fgg .ST_Underline ,c _bbd .Color )RunProperties {if style ==_fgg .ST_UnderlineUnset {_dcdf ._bfbg .U =nil ;}else {_dcdf ._bfbg .U =_fgg .NewCT_Underline ();_dcdf ._bfbg .U .ColorAttr =&_fgg .ST_HexColor {};_dcdf ._bfbg .U .ColorAttr .ST_HexColorRGB =c .AsRGBString ();_dcdf ._bfbg .U .ValAttr =style ;};return _dcdf ;};
 
 // BoldValue returns the precise nature of the bold setting (unset, off or on).
 func (_bfbe RunProperties )BoldValue ()OnOffValue {return _aafe (_bfbe ._bfbg .B )};
@@ -0,0 +1,1428 @@
+package document_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unidoc/unioffice"
+	"github.com/unidoc/unioffice/chart"
+	"github.com/unidoc/unioffice/color"
+	"github.com/unidoc/unioffice/common"
+	"github.com/unidoc/unioffice/document"
+	"github.com/unidoc/unioffice/measurement"
+	crt "github.com/unidoc/unioffice/schema/soo/dml/chart"
+	"github.com/unidoc/unioffice/schema/soo/dml/picture"
+	"github.com/unidoc/unioffice/schema/soo/ofc/sharedTypes"
+	"github.com/unidoc/unioffice/schema/soo/wml"
+)
+
+func TestExtractTextDocumentOrder(t *testing.T) {
+	doc := document.New()
+	doc.AddParagraph().AddRun().AddText("first")
+	doc.AddTable().AddRow().AddCell().AddParagraph().AddRun().AddText("table")
+	doc.AddParagraph().AddRun().AddText("last")
+
+	exp := "first\ntable\nlast"
+	if got := doc.ExtractText(); got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+	if got := doc.ExtractTextWithOptions(document.ExtractTextOptions{}); got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
+
+func marshalBody(t *testing.T, doc *document.Document) string {
+	buf := bytes.Buffer{}
+	if err := xml.NewEncoder(&buf).Encode(doc.X().Body); err != nil {
+		t.Fatalf("error marshaling body: %s", err)
+	}
+	return buf.String()
+}
+
+func TestClearedRunPropertiesNotWritten(t *testing.T) {
+	doc := document.New()
+	para := doc.AddParagraph()
+	r := para.AddRun()
+	r.AddText("bold")
+	r.Properties().SetBold(true).SetBold(false)
+	r = para.AddRun()
+	r.AddText("read")
+	if r.Properties().IsBold() {
+		t.Errorf("expected run not to be bold")
+	}
+
+	if got := marshalBody(t, doc); strings.Contains(got, "rPr") {
+		t.Errorf("expected no run properties to be written, got %s", got)
+	}
+}
+
+func TestAddTabStopOrderAndLeader(t *testing.T) {
+	doc := document.New()
+	pp := doc.AddParagraph().Properties()
+	pp.AddTabStop(3*measurement.Inch, wml.ST_TabJcRight, wml.ST_TabTlcDot)
+	pp.AddTabStop(1*measurement.Inch, wml.ST_TabJcLeft, wml.ST_TabTlcNone)
+	pp.AddTabStop(2*measurement.Inch, wml.ST_TabJcCenter, wml.ST_TabTlcHyphen)
+
+	tabs := pp.X().Tabs.Tab
+	if len(tabs) != 3 {
+		t.Fatalf("expected 3 tab stops, got %d", len(tabs))
+	}
+	exp := []struct {
+		pos    int64
+		jc     wml.ST_TabJc
+		leader wml.ST_TabTlc
+	}{
+		{1440, wml.ST_TabJcLeft, wml.ST_TabTlcNone},
+		{2880, wml.ST_TabJcCenter, wml.ST_TabTlcHyphen},
+		{4320, wml.ST_TabJcRight, wml.ST_TabTlcDot},
+	}
+	for i, e := range exp {
+		if *tabs[i].PosAttr.Int64 != e.pos || tabs[i].ValAttr != e.jc || tabs[i].LeaderAttr != e.leader {
+			t.Errorf("tab %d: expected pos %d, val %s, leader %s; got pos %d, val %s, leader %s", i, e.pos, e.jc, e.leader,
+				*tabs[i].PosAttr.Int64, tabs[i].ValAttr, tabs[i].LeaderAttr)
+		}
+	}
+	if got := marshalBody(t, doc); !strings.Contains(got, `<w:tab w:val="right" w:leader="dot" w:pos="4320">`) {
+		t.Errorf("expected right aligned dot leader tab stop, got %s", got)
+	}
+}
+
+func TestAddImageWithContentTypeKeepsType(t *testing.T) {
+	doc := document.New()
+	size := image.Point{X: 10, Y: 10}
+	if _, err := doc.AddImageWithContentType([]byte{0}, "image/x-emf", size); err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	data := []byte{0}
+	if _, err := doc.AddImage(common.Image{Data: &data, Format: "emf", Size: size}); err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	types := map[string]string{}
+	for _, def := range doc.ContentTypes.X().Default {
+		types[def.ExtensionAttr] = def.ContentTypeAttr
+	}
+	if got := types["emf"]; got != "image/x-emf" {
+		t.Errorf("expected emf content type image/x-emf, got %q", got)
+	}
+}
+
+func TestRunSpecValidateOpacity(t *testing.T) {
+	spec := document.RunSpec{Color: color.RGB(0xab, 0xcd, 0xef)}
+	if err := spec.Validate(); err != nil {
+		t.Errorf("expected opaque color to be valid, got %s", err)
+	}
+	spec.Color = color.RGBA(0xab, 0xcd, 0xef, 0xfe)
+	if err := spec.Validate(); err == nil {
+		t.Errorf("expected an error for a translucent color")
+	}
+}
+
+func TestSetTwoLinesInOne(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.SetTwoLinesInOne('[', ']')
+	layout := r.X().RPr.EastAsianLayout
+	if layout == nil || layout.IdAttr == nil {
+		t.Fatalf("expected eastAsianLayout with an id")
+	}
+	if layout.CombineBracketsAttr != wml.ST_CombineBracketsSquare {
+		t.Errorf("expected square brackets, got %s", layout.CombineBracketsAttr)
+	}
+	r.SetTwoLinesInOne('(', ']')
+	if layout.CombineBracketsAttr != wml.ST_CombineBracketsNone {
+		t.Errorf("expected no brackets for a mismatched pair, got %s", layout.CombineBracketsAttr)
+	}
+	r.SetCombineCharacters(false)
+	if r.X().RPr != nil {
+		t.Errorf("expected run properties to be removed")
+	}
+}
+
+func TestMapTextKeepsBreaks(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.AddText("one")
+	r.AddBreak()
+	r.AddText("two")
+	r.AddTab()
+	r.AddText("three")
+	doc.MapText(func(s string) string {
+		if s == "three" {
+			return ""
+		}
+		return strings.ToUpper(s)
+	})
+	ic := r.X().EG_RunInnerContent
+	if len(ic) != 4 {
+		t.Fatalf("expected 4 elements, got %d", len(ic))
+	}
+	if ic[0].T == nil || ic[0].T.Content != "ONE" || ic[1].Br == nil ||
+		ic[2].T == nil || ic[2].T.Content != "TWO" || ic[3].Tab == nil {
+		t.Errorf("expected text, break, text and tab in their original order")
+	}
+}
+
+func TestDetachedRun(t *testing.T) {
+	doc := document.New()
+	r := document.NewRun(document.RunSpec{Text: "detached"})
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	if _, err := r.AddDrawingInline(img); err == nil {
+		t.Errorf("expected an error adding a drawing to a run without a document")
+	}
+	r.AddTextAutoLink(" see www.example.com")
+	if got := r.Text(); got != "detached see www.example.com" {
+		t.Errorf("unexpected text %q", got)
+	}
+
+	para := doc.AddParagraph()
+	if _, err := para.AppendRun(r); err != nil {
+		t.Fatalf("error appending run: %s", err)
+	}
+	if _, err := para.AppendRun(r); err == nil {
+		t.Errorf("expected an error appending a run that is already part of the paragraph")
+	}
+}
+
+func TestSetShadingHighlightClearsOwnShading(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.SetShadingHighlight(color.Yellow)
+	r.SetShadingHighlight(color.Auto)
+	if r.X().RPr != nil {
+		t.Errorf("expected shading highlight to be removed")
+	}
+
+	shd := wml.NewCT_Shd()
+	shd.ValAttr = wml.ST_ShdPct10
+	r.Properties().X().Shd = shd
+	r.SetShadingHighlight(color.Auto)
+	if r.X().RPr == nil || r.X().RPr.Shd == nil {
+		t.Errorf("expected other shading to be kept")
+	}
+}
+
+func TestSetAllCapsOverridesStyle(t *testing.T) {
+	doc := document.New()
+	st := doc.Styles.AddStyle("Shout", wml.ST_StyleTypeCharacter, false)
+	st.RunProperties().SetAllCaps(true)
+
+	r := doc.AddParagraph().AddRun()
+	r.Properties().SetStyle("Shout")
+	if !r.EffectiveProperties().Caps() {
+		t.Fatalf("expected caps from the style")
+	}
+	r.Properties().SetAllCaps(false)
+	if r.EffectiveProperties().Caps() {
+		t.Errorf("expected direct formatting to turn caps off")
+	}
+	if got := marshalBody(t, doc); !strings.Contains(got, `<w:caps w:val="false">`) {
+		t.Errorf("expected an explicit caps off, got %s", got)
+	}
+}
+
+func TestRunIsItalicComplexScript(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	if r.IsItalicComplexScript() {
+		t.Errorf("expected a new run not to be italic")
+	}
+	r.Properties().SetItalic(true)
+	if !r.IsItalicComplexScript() {
+		t.Errorf("expected run to be italic for complex scripts")
+	}
+	r.Properties().SetItalic(false)
+	if r.IsItalicComplexScript() {
+		t.Errorf("expected italic to be turned off")
+	}
+}
+
+func TestRepeatedColorSetters(t *testing.T) {
+	doc := document.New()
+	rp := doc.AddParagraph().AddRun().Properties()
+
+	rp.SetColor(color.Red)
+	rp.Color().SetThemeColor(wml.ST_ThemeColorAccent1)
+	if got := rp.X().Color.ThemeColorAttr; got != wml.ST_ThemeColorAccent1 {
+		t.Errorf("expected theme color accent1, got %s", got)
+	}
+
+	rp.Color().SetThemeShade(0x80)
+	rp.SetColor(color.Blue)
+	c := rp.X().Color
+	if c.ThemeColorAttr != wml.ST_ThemeColorUnset || c.ThemeShadeAttr != nil || c.ThemeTintAttr != nil {
+		t.Errorf("expected theme attributes to be removed by SetColor")
+	}
+	if c.ValAttr.ST_HexColorRGB == nil || *c.ValAttr.ST_HexColorRGB != "0000FF" {
+		t.Errorf("expected color 0000FF")
+	}
+
+	rp.SetHighlight(wml.ST_HighlightColorYellow).SetHighlight(wml.ST_HighlightColorGreen)
+	got := marshalBody(t, doc)
+	if n := strings.Count(got, "<w:highlight "); n != 1 || !strings.Contains(got, `w:val="green"`) {
+		t.Errorf("expected a single green highlight, got %s", got)
+	}
+	if n := strings.Count(got, "<w:color "); n != 1 {
+		t.Errorf("expected a single color, got %s", got)
+	}
+}
+
+func TestCloneIntoRelationships(t *testing.T) {
+	src := document.New()
+	img, err := src.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	pic := src.AddParagraph().AddRun()
+	if _, err := pic.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	dst := document.New()
+	own, err := dst.AddImageWithContentType([]byte{1}, "image/png", image.Point{X: 2, Y: 2})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	clone, err := pic.CloneInto(dst)
+	if err != nil {
+		t.Fatalf("error cloning run: %s", err)
+	}
+	if len(dst.Images) != 2 || len(clone.Drawings()) != 1 {
+		t.Fatalf("expected the image to be copied")
+	}
+	blip := clone.X().EG_RunInnerContent[0].Drawing.Inline[0].Graphic.GraphicData.Any[0].(*picture.Pic).BlipFill.Blip
+	if *blip.EmbedAttr == "" || *blip.EmbedAttr == own.RelID() {
+		t.Errorf("expected drawing to refer to the copied image, got %q", *blip.EmbedAttr)
+	}
+
+	detached := document.NewRun(document.RunSpec{})
+	detached.X().EG_RunInnerContent = append(detached.X().EG_RunInnerContent, pic.X().EG_RunInnerContent...)
+	if _, err := detached.CloneInto(dst); err == nil {
+		t.Errorf("expected an error cloning a drawing of a run without a document")
+	}
+
+	ch := src.AddParagraph().AddRun()
+	if _, err := ch.AddChartAnchored(chart.MakeChart(crt.NewChartSpace())); err != nil {
+		t.Fatalf("error adding chart: %s", err)
+	}
+	cc, err := ch.CloneInto(dst)
+	if err != nil {
+		t.Fatalf("error cloning chart: %s", err)
+	}
+	ref := cc.X().EG_RunInnerContent[0].Drawing.Anchor[0].Graphic.GraphicData.Any[0].(*crt.Chart)
+	if ref.IdAttr == "" || ref.IdAttr == own.RelID() {
+		t.Errorf("expected drawing to refer to the copied chart, got %q", ref.IdAttr)
+	}
+	if !hasOverride(dst, "/word/charts/chart1.xml") {
+		t.Errorf("expected the chart to be added to the destination document")
+	}
+}
+
+func hasOverride(doc *document.Document, part string) bool {
+	for _, o := range doc.ContentTypes.X().Override {
+		if o.PartNameAttr == part {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAddChartAnchoredSkipsUsedParts(t *testing.T) {
+	doc := document.New()
+	doc.ExtraFiles = append(doc.ExtraFiles, common.ExtraFile{ZipPath: "word/charts/chart2.xml"})
+	for i := 0; i < 2; i++ {
+		if _, err := doc.AddParagraph().AddRun().AddChartAnchored(chart.MakeChart(crt.NewChartSpace())); err != nil {
+			t.Fatalf("error adding chart: %s", err)
+		}
+	}
+	if !hasOverride(doc, "/word/charts/chart1.xml") || !hasOverride(doc, "/word/charts/chart3.xml") || hasOverride(doc, "/word/charts/chart2.xml") {
+		t.Errorf("expected charts to skip the existing chart part")
+	}
+}
+
+func TestEmptyRunPropertiesSetters(t *testing.T) {
+	r := document.New().AddParagraph().AddRun()
+	r.X().RPr = wml.NewCT_RPr()
+	r.Properties().SetBold(true).SetItalic(true).SetSize(12)
+	if !r.Properties().IsBold() || !r.Properties().IsItalic() {
+		t.Errorf("expected setters to work on an empty rPr")
+	}
+}
+
+func TestSetWrapDistanceNegative(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	ad, err := doc.AddParagraph().AddRun().AddDrawingAnchored(img)
+	if err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	ad.SetWrapDistance(-measurement.Inch, measurement.Inch, 0, 0)
+	if got := *ad.X().DistTAttr; got != 0 {
+		t.Errorf("expected negative distance to be clamped to 0, got %d", got)
+	}
+	if got := *ad.X().DistBAttr; got != 914400 {
+		t.Errorf("expected 914400, got %d", got)
+	}
+}
+
+func TestAddWatermarkSections(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	p.AddRun().AddText("first section")
+	first := p.Properties().AddSection(wml.ST_SectionMarkNextPage)
+	body := doc.AddHeader()
+	doc.BodySection().SetHeader(body, wml.ST_HdrFtrDefault)
+
+	if err := doc.AddImageWatermark(common.ImageRef{}); err == nil {
+		t.Fatalf("expected an error for an image that wasn't added")
+	}
+	if len(doc.Headers()) != 1 || len(first.X().EG_HdrFtrReferences) != 0 {
+		t.Fatalf("expected a failed watermark to leave the document unchanged")
+	}
+
+	if err := doc.AddWatermark("DRAFT", document.WatermarkOptions{}); err != nil {
+		t.Fatalf("error adding watermark: %s", err)
+	}
+	if len(doc.Headers()) != 2 || len(first.X().EG_HdrFtrReferences) != 1 {
+		t.Errorf("expected a default header to be added to the first section")
+	}
+	for i, h := range doc.Headers() {
+		if len(h.Paragraphs()) == 0 || len(h.Paragraphs()[0].Runs()) == 0 {
+			t.Errorf("expected header %d to contain the watermark", i)
+		}
+	}
+}
+
+func TestMarkFormatRevertedUniqueID(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	p.X().PPr = wml.NewCT_PPr()
+	p.X().PPr.RPr = wml.NewCT_ParaRPr()
+	p.X().PPr.RPr.Ins = wml.NewCT_TrackChange()
+	p.X().PPr.RPr.Ins.IdAttr = 7
+	r := p.AddRun()
+	r.AddText("changed")
+	if err := r.MarkFormatReverted("author", time.Time{}, document.NewRun(document.RunSpec{}).Properties()); err != nil {
+		t.Fatalf("error marking format change: %s", err)
+	}
+	if id := r.X().RPr.RPrChange.IdAttr; id != 8 {
+		t.Errorf("expected revision id 8, got %d", id)
+	}
+	r = p.AddRun()
+	if err := r.MarkFormatReverted("author", time.Time{}, document.NewRun(document.RunSpec{}).Properties()); err != nil {
+		t.Fatalf("error marking format change: %s", err)
+	}
+	if id := r.X().RPr.RPrChange.IdAttr; id != 9 {
+		t.Errorf("expected revision id 9, got %d", id)
+	}
+}
+
+func TestSetImageDetachedDrawing(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	ad := document.NewRun(document.RunSpec{}).AddDrawingPlaceholder(measurement.Inch, measurement.Inch)
+	if err := ad.SetImage(img); err == nil {
+		t.Errorf("expected an error setting the image of a drawing without a document")
+	}
+	ad = doc.AddParagraph().AddRun().AddDrawingPlaceholder(measurement.Inch, measurement.Inch)
+	if err := ad.SetImage(img); err != nil {
+		t.Errorf("error setting image: %s", err)
+	}
+}
+
+func TestRemoveDrawingsKeepsImage(t *testing.T) {
+	doc := document.New()
+	data := []byte{0}
+	img, err := doc.AddImage(common.Image{Size: image.Point{X: 1, Y: 1}, Format: "png", Data: &data})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	r := doc.AddParagraph().AddRun()
+	r.AddText("text")
+	if _, err := r.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	if n := r.RemoveDrawings(); n != 1 {
+		t.Errorf("expected 1 drawing to be removed, got %d", n)
+	}
+	if r.Text() != "text" {
+		t.Errorf("expected the text to be kept, got %q", r.Text())
+	}
+	if _, err := doc.AddParagraph().AddRun().AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Errorf("expected the image to be usable after RemoveDrawings: %s", err)
+	}
+}
+
+func TestValidateDanglingImageRelationship(t *testing.T) {
+	doc := document.New()
+	data := []byte{0}
+	img, err := doc.AddImage(common.Image{Size: image.Point{X: 1, Y: 1}, Format: "png", Data: &data})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	r := doc.AddParagraph().AddRun()
+	if _, err := r.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Errorf("expected no validation error, got %s", err)
+	}
+	blip := r.X().EG_RunInnerContent[0].Drawing.Inline[0].Graphic.GraphicData.Any[0].(*picture.Pic).BlipFill.Blip
+	blip.EmbedAttr = unioffice.String("rId999")
+	if err := doc.Validate(); err == nil {
+		t.Errorf("expected a validation error for a dangling image relationship")
+	}
+}
+
+func TestParagraphSetOutlineLevel(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	if err := p.SetOutlineLevel(0); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if lvl := p.X().PPr.OutlineLvl; lvl == nil || lvl.ValAttr != 0 {
+		t.Errorf("expected outline level 0, got %v", lvl)
+	}
+	if err := p.SetOutlineLevel(10); err != document.ErrInvalidOutlineLevel {
+		t.Errorf("expected ErrInvalidOutlineLevel, got %v", err)
+	}
+	if err := p.SetOutlineLevel(-1); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if p.X().PPr.OutlineLvl != nil {
+		t.Errorf("expected the outline level to be removed")
+	}
+
+	s := doc.Styles.AddStyle("Outlined", wml.ST_StyleTypeParagraph, false)
+	s.ParagraphProperties().SetOutlineLevel(12)
+	if lvl := s.X().PPr.OutlineLvl; lvl == nil || lvl.ValAttr != 9 {
+		t.Errorf("expected the style outline level to be clamped to 9, got %v", lvl)
+	}
+}
+
+func TestTextBoxRoundTrip(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	p.AddRun().AddText("before")
+	tb := p.AddRun().AddTextBox(2*measurement.Inch, measurement.Inch)
+	tb.AddParagraph().AddRun().AddText("inside")
+	doc.AddParagraph().AddRun().AddText("after")
+
+	exp := "before\ninside\nafter"
+	if got := doc.ExtractText(); got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+
+	buf := bytes.Buffer{}
+	if err := xml.NewEncoder(&buf).Encode(doc.X()); err != nil {
+		t.Fatalf("error marshaling document: %s", err)
+	}
+	read := document.New()
+	if err := xml.Unmarshal(buf.Bytes(), read.X()); err != nil {
+		t.Fatalf("error unmarshaling document: %s", err)
+	}
+	if got := read.ExtractText(); got != exp {
+		t.Errorf("expected %q after reading, got %q", exp, got)
+	}
+
+	buf.Reset()
+	if err := xml.NewEncoder(&buf).Encode(read.X()); err != nil {
+		t.Fatalf("error marshaling document: %s", err)
+	}
+	for _, exp := range []string{`txBox="1"`, "prstGeom", "<wps:txbx><w:txbxContent>", "inside", "wps:bodyPr"} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Errorf("expected %q in the written text box, got %s", exp, buf.String())
+		}
+	}
+}
+
+func TestAddEmbeddedObjectInHeader(t *testing.T) {
+	doc := document.New()
+	hdr := doc.AddHeader()
+	data := []byte{0}
+	icon, err := hdr.AddImage(common.Image{Size: image.Point{X: 1, Y: 1}, Format: "png", Data: &data})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	r := hdr.AddParagraph().AddRun()
+	if err := r.AddEmbeddedObject([]byte{1, 2, 3}, "Package", icon); err != nil {
+		t.Fatalf("error adding embedded object: %s", err)
+	}
+	var obj *wml.CT_Object
+	for _, ic := range r.X().EG_RunInnerContent {
+		if ic.Object != nil {
+			obj = ic.Object
+		}
+	}
+	if obj == nil || obj.Choice == nil || obj.Choice.ObjectEmbed == nil {
+		t.Fatalf("expected an embedded object")
+	}
+	// the header's relationships only hold the icon, so the object's
+	// relationship follows it
+	if id := obj.Choice.ObjectEmbed.IdAttr; id == icon.RelID() || id != "rId2" {
+		t.Errorf("expected the object relationship to be added to the header, got %s", id)
+	}
+}
+
+func TestCloneIntoParagraphHeader(t *testing.T) {
+	src := document.New()
+	img, err := src.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	pic := src.AddParagraph().AddRun()
+	if _, err := pic.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+
+	dst := document.New()
+	hp := dst.AddHeader().AddParagraph()
+	clone, err := pic.CloneIntoParagraph(hp)
+	if err != nil {
+		t.Fatalf("error cloning run: %s", err)
+	}
+	if len(hp.Runs()) != 1 || len(clone.Drawings()) != 1 {
+		t.Fatalf("expected the clone to be appended to the header paragraph")
+	}
+	if _, err := pic.CloneInto(dst); err != nil {
+		t.Fatalf("error cloning run: %s", err)
+	}
+	if _, err := pic.CloneIntoParagraph(dst.AddParagraph()); err != nil {
+		t.Fatalf("error cloning run: %s", err)
+	}
+	if len(dst.Images) != 1 {
+		t.Errorf("expected the image to be copied once, got %d images", len(dst.Images))
+	}
+	if err := dst.Validate(); err != nil {
+		t.Errorf("expected the header drawing to refer to a header relationship: %s", err)
+	}
+}
+
+func TestCloneIntoParagraphHeaderChart(t *testing.T) {
+	src := document.New()
+	ch := src.AddParagraph().AddRun()
+	if _, err := ch.AddChartAnchored(chart.MakeChart(crt.NewChartSpace())); err != nil {
+		t.Fatalf("error adding chart: %s", err)
+	}
+	dst := document.New()
+	hdr := dst.AddHeader()
+	clone, err := ch.CloneIntoParagraph(hdr.AddParagraph())
+	if err != nil {
+		t.Fatalf("error cloning chart: %s", err)
+	}
+	ref := clone.X().EG_RunInnerContent[0].Drawing.Anchor[0].Graphic.GraphicData.Any[0].(*crt.Chart)
+	// a new header only has the chart relationship
+	if ref.IdAttr != "rId1" {
+		t.Errorf("expected the chart relationship to be added to the header, got %q", ref.IdAttr)
+	}
+	if !hasOverride(dst, "/word/charts/chart1.xml") {
+		t.Errorf("expected the chart to be added to the destination document")
+	}
+}
+
+func TestTextBoxInHeaderEmbeddedObject(t *testing.T) {
+	doc := document.New()
+	hdr := doc.AddHeader()
+	data := []byte{0}
+	icon, err := hdr.AddImage(common.Image{Size: image.Point{X: 1, Y: 1}, Format: "png", Data: &data})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	tb := hdr.AddParagraph().AddRun().AddTextBox(measurement.Inch, measurement.Inch)
+	r := tb.AddParagraph().AddRun()
+	if err := r.AddEmbeddedObject([]byte{1}, "Package", icon); err != nil {
+		t.Fatalf("error adding embedded object: %s", err)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Errorf("expected the icon to resolve within the header: %s", err)
+	}
+	for _, ic := range r.X().EG_RunInnerContent {
+		if ic.Object != nil && ic.Object.Choice.ObjectEmbed.IdAttr != "rId2" {
+			t.Errorf("expected the object relationship to be added to the header, got %s", ic.Object.Choice.ObjectEmbed.IdAttr)
+		}
+	}
+}
+
+func TestValidateTextBoxImageRelationship(t *testing.T) {
+	doc := document.New()
+	data := []byte{0}
+	img, err := doc.AddImage(common.Image{Size: image.Point{X: 1, Y: 1}, Format: "png", Data: &data})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	tb := doc.AddParagraph().AddRun().AddTextBox(measurement.Inch, measurement.Inch)
+	r := tb.AddParagraph().AddRun()
+	if _, err := r.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Errorf("expected no validation error, got %s", err)
+	}
+	blip := r.X().EG_RunInnerContent[0].Drawing.Inline[0].Graphic.GraphicData.Any[0].(*picture.Pic).BlipFill.Blip
+	blip.EmbedAttr = unioffice.String("rId999")
+	if err := doc.Validate(); err == nil {
+		t.Errorf("expected a validation error for a dangling image relationship within a text box")
+	}
+}
+
+func TestFieldsDontSpanParts(t *testing.T) {
+	fldChar := func(r document.Run, typ wml.ST_FldCharType) {
+		ic := wml.NewEG_RunInnerContent()
+		ic.FldChar = wml.NewCT_FldChar()
+		ic.FldChar.FldCharTypeAttr = typ
+		r.X().EG_RunInnerContent = append(r.X().EG_RunInnerContent, ic)
+	}
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	fldChar(r, wml.ST_FldCharTypeBegin)
+	ic := wml.NewEG_RunInnerContent()
+	ic.InstrText = wml.NewCT_Text()
+	ic.InstrText.Content = "NUMPAGES"
+	r.X().EG_RunInnerContent = append(r.X().EG_RunInnerContent, ic)
+
+	hr := doc.AddHeader().AddParagraph().AddRun()
+	fldChar(hr, wml.ST_FldCharTypeEnd)
+	doc.AddFooter().AddParagraph().AddRun().AddField(document.FieldCurrentPage)
+
+	flds := doc.Fields()
+	if len(flds) != 1 {
+		t.Fatalf("expected only the footer field, got %d fields", len(flds))
+	}
+	if flds[0].Type() != document.FieldCurrentPage || flds[0].Paragraph().X() == doc.Paragraphs()[0].X() {
+		t.Errorf("expected the footer's page field, got %q", flds[0].Type())
+	}
+}
+
+func TestEmptyRunPropertiesOmitted(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.X().RPr = wml.NewCT_RPr()
+	if got := marshalBody(t, doc); strings.Contains(got, "rPr") {
+		t.Errorf("expected empty run properties to be omitted, got %s", got)
+	}
+	r.X().RPr.RPrChange = wml.NewCT_RPrChange()
+	if got := marshalBody(t, doc); !strings.Contains(got, "<w:rPr><w:rPrChange") {
+		t.Errorf("expected run properties to be written, got %s", got)
+	}
+}
+
+func TestEffectivePropertiesToggles(t *testing.T) {
+	doc := document.New()
+	ps := doc.Styles.AddStyle("BoldPara", wml.ST_StyleTypeParagraph, false)
+	ps.RunProperties().SetBold(true)
+	ps.RunProperties().SetItalic(true)
+	cs := doc.Styles.AddStyle("BoldChar", wml.ST_StyleTypeCharacter, false)
+	cs.RunProperties().SetBold(true)
+
+	p := doc.AddParagraph()
+	p.SetStyle("BoldPara")
+	r := p.AddRun()
+	if !r.EffectiveProperties().IsBold() {
+		t.Errorf("expected bold from the paragraph style")
+	}
+	r.Properties().SetStyle("BoldChar")
+	ep := r.EffectiveProperties()
+	if ep.IsBold() {
+		t.Errorf("expected bold in both styles to toggle bold off")
+	}
+	if !ep.IsItalic() {
+		t.Errorf("expected italic from the paragraph style")
+	}
+	r.Properties().SetBold(true)
+	if !r.EffectiveProperties().IsBold() {
+		t.Errorf("expected direct formatting to win over toggled styles")
+	}
+}
+
+func TestEffectivePropertiesMergesFontsAndLanguage(t *testing.T) {
+	doc := document.New()
+	cs := doc.Styles.AddStyle("Fonts", wml.ST_StyleTypeCharacter, false)
+	cs.RunProperties().X().RFonts = wml.NewCT_Fonts()
+	cs.RunProperties().X().RFonts.AsciiAttr = unioffice.String("Arial")
+	cs.RunProperties().X().Lang = wml.NewCT_Language()
+	cs.RunProperties().X().Lang.ValAttr = unioffice.String("en-US")
+
+	r := doc.AddParagraph().AddRun()
+	r.Properties().SetStyle("Fonts")
+	r.Properties().X().RFonts = wml.NewCT_Fonts()
+	r.Properties().X().RFonts.EastAsiaAttr = unioffice.String("MS Mincho")
+	r.Properties().X().Lang = wml.NewCT_Language()
+	r.Properties().X().Lang.EastAsiaAttr = unioffice.String("ja-JP")
+
+	ep := r.EffectiveProperties().X()
+	if ep.RFonts == nil || ep.RFonts.AsciiAttr == nil || *ep.RFonts.AsciiAttr != "Arial" ||
+		ep.RFonts.EastAsiaAttr == nil || *ep.RFonts.EastAsiaAttr != "MS Mincho" {
+		t.Errorf("expected fonts to be merged per attribute, got %+v", ep.RFonts)
+	}
+	if ep.Lang == nil || ep.Lang.ValAttr == nil || *ep.Lang.ValAttr != "en-US" ||
+		ep.Lang.EastAsiaAttr == nil || *ep.Lang.EastAsiaAttr != "ja-JP" {
+		t.Errorf("expected languages to be merged per attribute, got %+v", ep.Lang)
+	}
+	if cs.RunProperties().X().RFonts.EastAsiaAttr != nil {
+		t.Errorf("expected the style fonts to be left unchanged")
+	}
+}
+
+func TestRunPropertiesOrder(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.Properties().SetVerticalAlignment(sharedTypes.ST_VerticalAlignRunSuperscript)
+	r.Properties().SetSize(12)
+	r.Properties().SetColor(color.Red)
+	r.Properties().SetBold(true)
+	r.Properties().SetFontFamily("Arial")
+	r.Properties().SetStyle("Emphasis")
+
+	got := marshalBody(t, doc)
+	last := -1
+	for _, el := range []string{"<w:rStyle ", "<w:rFonts ", "<w:b>", "<w:color ", "<w:sz ", "<w:vertAlign "} {
+		idx := strings.Index(got, el)
+		if idx < 0 {
+			t.Fatalf("expected %s in %s", el, got)
+		}
+		if idx < last {
+			t.Errorf("expected %s to follow the previous properties in %s", el, got)
+		}
+		last = idx
+	}
+}
+
+func TestDrawingSizeRounding(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 3, Y: 7})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	inl, err := doc.AddParagraph().AddRun().AddDrawingInline(img)
+	if err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	ext := inl.X().Extent
+	pic := inl.X().Graphic.GraphicData.Any[0].(*picture.Pic)
+	if ext.CxAttr != 38100 || ext.CyAttr != 88900 ||
+		pic.SpPr.Xfrm.Ext.CxAttr != ext.CxAttr || pic.SpPr.Xfrm.Ext.CyAttr != ext.CyAttr {
+		t.Errorf("expected the picture extent to match the drawing extent, got %dx%d and %dx%d",
+			ext.CxAttr, ext.CyAttr, pic.SpPr.Xfrm.Ext.CxAttr, pic.SpPr.Xfrm.Ext.CyAttr)
+	}
+
+	// two thirds of a point are 8466.67 EMU
+	inl.SetSize(2.0/3*measurement.Point, 1*measurement.Inch)
+	if ext.CxAttr != 8467 || ext.CyAttr != 914400 {
+		t.Errorf("expected the inline size to be rounded, got %dx%d", ext.CxAttr, ext.CyAttr)
+	}
+	anc, err := doc.AddParagraph().AddRun().AddDrawingAnchored(img)
+	if err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	anc.SetSize(2.0/3*measurement.Point, 1*measurement.Inch)
+	if anc.X().Extent.CxAttr != 8467 || anc.X().Extent.CyAttr != 914400 {
+		t.Errorf("expected the anchored size to be rounded, got %dx%d", anc.X().Extent.CxAttr, anc.X().Extent.CyAttr)
+	}
+}
+
+func TestRunSetFontFamilyAll(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.Properties().SetFontFamily("Arial")
+	if f := r.X().RPr.RFonts; f.CsAttr != nil {
+		t.Errorf("expected SetFontFamily to leave the complex script font unset")
+	}
+	r.SetFontFamilyAll("Noto Sans")
+	f := r.X().RPr.RFonts
+	for _, v := range []*string{f.AsciiAttr, f.HAnsiAttr, f.EastAsiaAttr, f.CsAttr} {
+		if v == nil || *v != "Noto Sans" {
+			t.Errorf("expected all fonts to be Noto Sans, got %+v", f)
+		}
+	}
+}
+
+func TestParagraphSetNumbering(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	if err := p.SetNumbering(3, 2); err != nil {
+		t.Fatalf("error setting numbering: %s", err)
+	}
+	if id, lvl, ok := p.Numbering(); !ok || id != 3 || lvl != 2 {
+		t.Errorf("expected numbering 3 at level 2, got %d %d %v", id, lvl, ok)
+	}
+	for _, lvl := range []int{-1, 9} {
+		if err := p.SetNumbering(4, lvl); err != document.ErrInvalidNumberingLevel {
+			t.Errorf("expected ErrInvalidNumberingLevel for level %d, got %v", lvl, err)
+		}
+	}
+	if id, lvl, _ := p.Numbering(); id != 3 || lvl != 2 {
+		t.Errorf("expected an invalid level to leave the numbering unchanged, got %d %d", id, lvl)
+	}
+}
+
+func measureRun(t *testing.T, font string, caps bool, build func(r document.Run)) float64 {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.Properties().SetFontFamily(font)
+	r.Properties().SetSize(12 * measurement.Point)
+	r.Properties().SetAllCaps(caps)
+	build(r)
+	w, err := r.MeasureWidth()
+	if err != nil {
+		t.Fatalf("error measuring run: %s", err)
+	}
+	return float64(w)
+}
+
+func TestRunMeasureWidth(t *testing.T) {
+	text := func(s string) func(document.Run) {
+		return func(r document.Run) { r.AddText(s) }
+	}
+	near := func(a, b float64) bool { return a-b < 0.01 && b-a < 0.01 }
+
+	if w := measureRun(t, "Courier New", false, text("Hello World")); !near(w, 79.2) {
+		t.Errorf("expected 11 monospaced characters to be 79.2pt, got %v", w)
+	}
+	if w := measureRun(t, "Arial", false, text("Hello World")); !near(w, 62.004) {
+		t.Errorf("expected Hello World in Arial to be 62pt, got %v", w)
+	}
+	lower := measureRun(t, "Arial", false, text("abc"))
+	upper := measureRun(t, "Arial", false, text("ABC"))
+	if w := measureRun(t, "Arial", true, text("abc")); lower >= upper || !near(w, upper) {
+		t.Errorf("expected all caps text to be measured in upper case, got %v for %v", w, upper)
+	}
+	tab := measureRun(t, "Courier New", false, func(r document.Run) {
+		r.AddText("a")
+		r.AddTab()
+		r.AddText("b")
+	})
+	if !near(tab, 2*7.2+36) {
+		t.Errorf("expected a tab to be measured as a default tab stop, got %v", tab)
+	}
+	br := measureRun(t, "Courier New", false, func(r document.Run) {
+		r.AddText("abc")
+		r.AddBreak()
+		r.AddText("a")
+	})
+	if !near(br, 3*7.2) {
+		t.Errorf("expected the widest line to be measured, got %v", br)
+	}
+}
+
+func TestRunMeasureWidthDrawing(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	r := doc.AddParagraph().AddRun()
+	if _, err := r.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	if _, err := r.MeasureWidth(); err == nil {
+		t.Errorf("expected an error measuring a drawing")
+	}
+}
+
+func TestRemoveRunsNested(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	p.AddRun().AddText("keep")
+	p.AddRun().AddText("x")
+	p.AddHyperLink().AddRun().AddText("x")
+
+	fld := wml.NewCT_SimpleField()
+	fld.InstrAttr = "PAGE"
+	fr := wml.NewCT_R()
+	fr.EG_RunInnerContent = []*wml.EG_RunInnerContent{{T: &wml.CT_Text{Content: "x"}}}
+	fld.EG_PContent = []*wml.EG_PContent{{EG_ContentRunContent: []*wml.EG_ContentRunContent{{R: fr}}}}
+	sdt := wml.NewCT_SdtRun()
+	sdt.SdtContent = wml.NewCT_SdtContentRun()
+	sr := wml.NewCT_R()
+	sr.EG_RunInnerContent = []*wml.EG_RunInnerContent{{T: &wml.CT_Text{Content: "x"}}}
+	sdt.SdtContent.EG_ContentRunContent = []*wml.EG_ContentRunContent{{R: sr}}
+	p.X().EG_PContent = append(p.X().EG_PContent, &wml.EG_PContent{FldSimple: []*wml.CT_SimpleField{fld}},
+		&wml.EG_PContent{EG_ContentRunContent: []*wml.EG_ContentRunContent{{Sdt: sdt}}})
+
+	p.AddRun().AddTextBox(measurement.Inch, measurement.Inch).AddParagraph().AddRun().AddText("x")
+	fn := p.AddFootnote("x")
+	fn.AddParagraph().AddRun().AddTextBox(measurement.Inch, measurement.Inch).AddParagraph().AddRun().AddText("x")
+	p.AddEndnote("x")
+
+	isX := func(r document.Run) bool { return strings.TrimSpace(r.Text()) == "x" }
+	if n := doc.RemoveRuns(isX); n != 8 {
+		t.Errorf("expected 8 runs to be removed, got %d", n)
+	}
+	if n := doc.RemoveRuns(isX); n != 0 {
+		t.Errorf("expected no runs to be left, got %d", n)
+	}
+	if got := marshalBody(t, doc); !strings.Contains(got, "keep") {
+		t.Errorf("expected other runs to be kept, got %s", got)
+	}
+}
+
+func TestRunAddTextAutoLink(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.Properties().SetBold(true)
+	r.AddTextAutoLink("see https://example.com/a?b=1, or mail a@b.com.")
+
+	got := marshalBody(t, doc)
+	for _, exp := range []string{
+		`<w:t xml:space="preserve">see </w:t>`,
+		`<w:hyperlink r:id="rId4"><w:r><w:rPr><w:b></w:b><w:bCs></w:bCs></w:rPr><w:t>https://example.com/a?b=1</w:t></w:r></w:hyperlink>`,
+		`<w:t xml:space="preserve">, or mail </w:t>`,
+		`<w:hyperlink r:id="rId5"><w:r><w:rPr><w:b></w:b><w:bCs></w:bCs></w:rPr><w:t>a@b.com</w:t></w:r></w:hyperlink>`,
+		`<w:t>.</w:t>`,
+	} {
+		if !strings.Contains(got, exp) {
+			t.Errorf("expected %s in %s", exp, got)
+		}
+	}
+}
+
+func TestAddAltChunk(t *testing.T) {
+	doc := document.New()
+	if err := doc.AddAltChunk([]byte("<html></html>"), ""); err == nil {
+		t.Errorf("expected an error without a content type")
+	}
+	if err := doc.AddAltChunk([]byte("<html></html>"), "text/html"); err != nil {
+		t.Fatalf("error adding alt chunk: %s", err)
+	}
+	if got := marshalBody(t, doc); !strings.Contains(got, `<w:altChunk r:id="rId4">`) {
+		t.Errorf("expected an altChunk reference, got %s", got)
+	}
+	if len(doc.ExtraFiles) != 1 || doc.ExtraFiles[0].ZipPath != "word/afchunk1.htm" {
+		t.Fatalf("expected the chunk to be stored as word/afchunk1.htm, got %v", doc.ExtraFiles)
+	}
+	if b, err := ioutil.ReadFile(doc.ExtraFiles[0].DiskPath); err != nil || string(b) != "<html></html>" {
+		t.Errorf("expected the chunk content to be stored, got %q (%v)", b, err)
+	}
+	if !hasOverride(doc, "/word/afchunk1.htm") {
+		t.Errorf("expected a content type override for the chunk")
+	}
+}
+
+func TestRunAddDrawingAnchoredFit(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 200, Y: 100})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	ad, err := doc.AddParagraph().AddRun().AddDrawingAnchoredFit(img, measurement.Inch, measurement.Inch)
+	if err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	ext := ad.X().Extent
+	if ext.CxAttr != 914400 || ext.CyAttr != 457200 {
+		t.Errorf("expected the image to be scaled to 1x0.5in, got %dx%d EMU", ext.CxAttr, ext.CyAttr)
+	}
+	pic := ad.X().Graphic.GraphicData.Any[0].(*picture.Pic)
+	if pic.SpPr.Xfrm.Ext.CxAttr != ext.CxAttr || pic.SpPr.Xfrm.Ext.CyAttr != ext.CyAttr {
+		t.Errorf("expected the shape extent to match the anchor extent, got %dx%d",
+			pic.SpPr.Xfrm.Ext.CxAttr, pic.SpPr.Xfrm.Ext.CyAttr)
+	}
+}
+
+func TestRunAddDateAndTimeFields(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	p.AddRun().AddDateField("MMMM d, yyyy")
+	p.AddRun().AddTimeField("")
+
+	got := marshalBody(t, doc)
+	if !strings.Contains(got, `<w:instrText>DATE \@ &#34;MMMM d, yyyy&#34;</w:instrText>`) {
+		t.Errorf("expected a DATE field with a date-picture switch, got %s", got)
+	}
+	if !strings.Contains(got, `<w:instrText>TIME</w:instrText>`) {
+		t.Errorf("expected a TIME field without a switch, got %s", got)
+	}
+}
+
+func TestRunAddPageOfPages(t *testing.T) {
+	doc := document.New()
+	doc.AddParagraph().AddRun().AddPageOfPages()
+
+	got := marshalBody(t, doc)
+	if !strings.Contains(got, `<w:t xml:space="preserve">Page </w:t><w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar><w:instrText>PAGE</w:instrText>`) ||
+		!strings.Contains(got, `<w:t xml:space="preserve"> of </w:t><w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar><w:instrText>NUMPAGES</w:instrText>`) {
+		t.Errorf("expected dirty fields within Page X of Y, got %s", got)
+	}
+}
+
+func TestAnchoredDrawingSetWrapTight(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	ad, err := doc.AddParagraph().AddRun().AddDrawingAnchored(img)
+	if err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	ad.SetWrapTight([]image.Point{{0, 0}, {21600, 0}, {10800, 21600}})
+	wt := ad.X().Choice.WrapTight
+	if wt == nil || wt.WrapPolygon == nil {
+		t.Fatalf("expected a tight wrap polygon")
+	}
+	pts := wt.WrapPolygon.LineTo
+	if *wt.WrapPolygon.Start.XAttr.ST_CoordinateUnqualified != 0 || len(pts) != 3 ||
+		*pts[0].XAttr.ST_CoordinateUnqualified != 21600 || *pts[1].YAttr.ST_CoordinateUnqualified != 21600 ||
+		*pts[2].XAttr.ST_CoordinateUnqualified != 0 || *pts[2].YAttr.ST_CoordinateUnqualified != 0 {
+		t.Errorf("expected a closed triangle")
+	}
+
+	ad.SetWrapTight(nil)
+	if n := len(ad.X().Choice.WrapTight.WrapPolygon.LineTo); n != 4 {
+		t.Errorf("expected the drawing bounds to be used, got %d lines", n)
+	}
+}
+
+func TestRunPropertiesTextEffectsExclusive(t *testing.T) {
+	doc := document.New()
+	rp := doc.AddParagraph().AddRun().Properties()
+	rp.SetShadow(true).SetOutline(true)
+	if rp.X().Shadow == nil || rp.X().Outline == nil {
+		t.Errorf("expected shadow and outline to be combined")
+	}
+	rp.SetEmboss(true)
+	if rp.X().Emboss == nil || rp.X().Shadow != nil || rp.X().Outline != nil {
+		t.Errorf("expected emboss to clear shadow and outline")
+	}
+	rp.SetImprint(true)
+	if rp.X().Imprint == nil || rp.X().Emboss != nil {
+		t.Errorf("expected imprint to clear emboss")
+	}
+	rp.SetShadow(true)
+	if rp.X().Shadow == nil || rp.X().Imprint != nil {
+		t.Errorf("expected shadow to clear imprint")
+	}
+	rp.SetEmboss(false)
+	if rp.X().Shadow == nil {
+		t.Errorf("expected turning emboss off to keep other effects")
+	}
+}
+
+func TestRunTextWithBreaks(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.AddText("a")
+	r.AddTab()
+	r.AddText("b")
+	r.AddBreak()
+	r.AddText("c")
+	r.AddPageBreak()
+	r.AddText("d")
+	if got := r.TextWithBreaks(); got != "a\tb\nc\fd" {
+		t.Errorf("expected breaks and tabs to be kept, got %q", got)
+	}
+}
+
+func TestRunSetScale(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.SetScale(150)
+	if w := r.X().RPr.W; w == nil || *w.ValAttr.ST_TextScaleDecimal != 150 {
+		t.Fatalf("expected a scale of 150%%")
+	}
+	r.SetScale(1000)
+	if *r.X().RPr.W.ValAttr.ST_TextScaleDecimal != 600 {
+		t.Errorf("expected the scale to be limited to 600%%")
+	}
+	r.SetScale(100)
+	if r.X().RPr != nil {
+		t.Errorf("expected a scale of 100%% to remove the scaling")
+	}
+}
+
+func TestRunEdit(t *testing.T) {
+	if _, err := (document.Run{}).Edit(); err != document.ErrInvalidRun {
+		t.Errorf("expected ErrInvalidRun for a run without an element, got %v", err)
+	}
+
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	rp, err := r.Edit()
+	if err != nil {
+		t.Fatalf("error editing run: %s", err)
+	}
+	rp.SetBold(true)
+	if !r.Properties().IsBold() {
+		t.Errorf("expected the returned properties to edit the run")
+	}
+
+	r.X().RPr.U = wml.NewCT_Underline()
+	r.X().RPr.U.ValAttr = wml.ST_Underline(99)
+	if _, err := r.Edit(); err == nil {
+		t.Errorf("expected an error for invalid run properties")
+	}
+}
+
+func TestRunSetRsidR(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	if err := r.SetRsidR("00a1b2c3"); err != nil {
+		t.Fatalf("error setting rsid: %s", err)
+	}
+	if got := r.RsidR(); got != "00A1B2C3" {
+		t.Errorf("expected 00A1B2C3, got %s", got)
+	}
+	r.SetRsidR("00A1B2C3")
+	n := 0
+	for _, rsid := range doc.Settings.X().Rsids.Rsid {
+		if rsid.ValAttr == "00A1B2C3" {
+			n++
+		}
+	}
+	if n != 1 {
+		t.Errorf("expected the rsid to be registered once in the settings, got %d", n)
+	}
+	for _, bad := range []string{"00A1B2", "00A1B2CZ"} {
+		if err := r.SetRsidR(bad); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+	if got := r.RsidR(); got != "00A1B2C3" {
+		t.Errorf("expected an invalid rsid to be ignored, got %s", got)
+	}
+	r.SetRsidR("")
+	if r.RsidR() != "" {
+		t.Errorf("expected an empty rsid to remove the identifier")
+	}
+}
+
+func TestAnchoredDrawingSetLocks(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	ad, err := doc.AddParagraph().AddRun().AddDrawingAnchored(img)
+	if err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	ad.SetLocks(true, false, true)
+	locks := ad.X().CNvGraphicFramePr.GraphicFrameLocks
+	if locks == nil || locks.NoSelectAttr == nil || !*locks.NoSelectAttr || locks.NoMoveAttr != nil ||
+		locks.NoResizeAttr == nil || !*locks.NoResizeAttr {
+		t.Fatalf("expected select and resize locks, got %+v", locks)
+	}
+	ad.SetLocks(false, false, false)
+	if ad.X().CNvGraphicFramePr.GraphicFrameLocks != nil {
+		t.Errorf("expected the locks to be removed")
+	}
+}
+
+func TestRunSetColorHex(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	for _, hex := range []string{"#1F497D", "1f497d"} {
+		if err := r.SetColorHex(hex); err != nil {
+			t.Fatalf("error setting color %s: %s", hex, err)
+		}
+		if c := r.X().RPr.Color; c == nil || c.ValAttr.ST_HexColorRGB == nil || !strings.EqualFold(*c.ValAttr.ST_HexColorRGB, "1F497D") {
+			t.Errorf("expected the color 1F497D for %s", hex)
+		}
+	}
+	for _, bad := range []string{"", "#12345", "GG0000", "#1234567"} {
+		if err := r.SetColorHex(bad); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+	if !strings.EqualFold(*r.X().RPr.Color.ValAttr.ST_HexColorRGB, "1F497D") {
+		t.Errorf("expected an invalid color to leave the run unchanged")
+	}
+}
+
+func TestParagraphMoveRun(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	a := p.AddRun()
+	a.AddText("a")
+	p.AddHyperLink().AddRun().AddText("b")
+	c := p.AddRun()
+	c.AddText("c")
+
+	p.MoveRun(c, 0)
+	if got := marshalBody(t, doc); strings.Index(got, ">c<") > strings.Index(got, ">a<") {
+		t.Errorf("expected c to be moved to the start, got %s", got)
+	}
+	p.MoveRun(a, 10)
+	got := marshalBody(t, doc)
+	if !(strings.Index(got, ">c<") < strings.Index(got, "<w:hyperlink") && strings.Index(got, "</w:hyperlink>") < strings.Index(got, ">a<")) {
+		t.Errorf("expected the order c, b, a with b in a hyperlink, got %s", got)
+	}
+	other := document.New().AddParagraph().AddRun()
+	p.MoveRun(other, 0)
+	if marshalBody(t, doc) != got {
+		t.Errorf("expected a run of another paragraph to be ignored")
+	}
+}
+
+func TestRunAddFieldRaw(t *testing.T) {
+	doc := document.New()
+	instr := ` HYPERLINK "http://example.com" \o "tip" `
+	doc.AddParagraph().AddRun().AddFieldRaw(instr)
+	got := marshalBody(t, doc)
+	if !strings.Contains(got, `<w:instrText xml:space="preserve"> HYPERLINK &#34;http://example.com&#34; \o &#34;tip&#34; </w:instrText>`) {
+		t.Errorf("expected the instruction to be written verbatim, got %s", got)
+	}
+	if !strings.Contains(got, `<w:fldChar w:fldCharType="begin" w:dirty="true">`) {
+		t.Errorf("expected the field to be dirty, got %s", got)
+	}
+}
+
+func TestRunDrawings(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 72, Y: 36})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	r := doc.AddParagraph().AddRun()
+	r.AddText("text")
+	if _, err := r.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	if _, err := r.AddDrawingAnchored(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+
+	drawings := r.Drawings()
+	if len(drawings) != 2 || drawings[0].IsAnchored() || !drawings[1].IsAnchored() {
+		t.Fatalf("expected an inline and an anchored drawing, got %v", drawings)
+	}
+	for _, d := range drawings {
+		if w, h := d.Size(); w != measurement.Inch || h != measurement.Inch/2 {
+			t.Errorf("expected a size of 1x0.5in, got %vx%v", w, h)
+		}
+		if got, ok := d.GetImage(); !ok || got.RelID() != img.RelID() {
+			t.Errorf("expected the drawing image to be found")
+		}
+	}
+	if _, ok := drawings[0].Inline(); !ok {
+		t.Errorf("expected the first drawing to be inline")
+	}
+	if _, ok := drawings[1].Anchored(); !ok {
+		t.Errorf("expected the second drawing to be anchored")
+	}
+}
+
+func TestParagraphSetAutoSpace(t *testing.T) {
+	doc := document.New()
+	p := doc.AddParagraph()
+	p.SetAutoSpaceDE(false)
+	p.SetAutoSpaceDN(true)
+	got := marshalBody(t, doc)
+	if !strings.Contains(got, `<w:autoSpaceDE w:val="false">`) {
+		t.Errorf("expected autoSpaceDE to be turned off, got %s", got)
+	}
+	if !strings.Contains(got, `<w:autoSpaceDN>`) {
+		t.Errorf("expected autoSpaceDN to be written when enabled, got %s", got)
+	}
+	p.SetAutoSpaceDE(true)
+	if v := p.X().PPr.AutoSpaceDE; v == nil || v.ValAttr != nil {
+		t.Errorf("expected autoSpaceDE to be turned on")
+	}
+}
+
+func TestRunTruncateText(t *testing.T) {
+	doc := document.New()
+	r := doc.AddParagraph().AddRun()
+	r.AddText("Hello ")
+	r.AddBreak()
+	r.AddText("wörld")
+	r.TruncateText(20, "…")
+	if got := r.Text(); got != "Hello wörld" {
+		t.Errorf("expected text that fits to be unchanged, got %q", got)
+	}
+	r.TruncateText(8, "…")
+	if got := r.TextWithBreaks(); got != "Hello \nw…" {
+		t.Errorf("expected the text to be truncated to 8 characters, got %q", got)
+	}
+	r.TruncateText(4, "...")
+	if got := r.TextWithBreaks(); got != "H...\n" {
+		t.Errorf("expected the break to be kept, got %q", got)
+	}
+	r.TruncateText(2, "...")
+	if got := r.Text(); got != ".." {
+		t.Errorf("expected the ellipsis to be shortened, got %q", got)
+	}
+}
+
+func TestParagraphAddEquation(t *testing.T) {
+	doc := document.New()
+	eq := doc.AddParagraph().AddEquation()
+	num, den := eq.AddFraction()
+	base, sup := num.AddSuperscript()
+	base.AddText("x")
+	sup.AddText("2")
+	deg, e := den.AddRadical()
+	deg.AddText("3")
+	e.AddText("y")
+
+	got := marshalBody(t, doc)
+	for _, exp := range []string{
+		"<w:p><m:oMath ", "<m:f><m:num><m:sSup><m:e><m:r><m:t>x</m:t></m:r></m:e><m:sup><m:r><m:t>2</m:t></m:r></m:sup></m:sSup></m:num>",
+		"<m:den><m:rad>", "<m:deg><m:r><m:t>3</m:t></m:r></m:deg><m:e><m:r><m:t>y</m:t></m:r></m:e></m:rad></m:den>",
+	} {
+		if !strings.Contains(got, exp) {
+			t.Errorf("expected %s in %s", exp, got)
+		}
+	}
+}
+
+func TestRunAST(t *testing.T) {
+	doc := document.New()
+	img, err := doc.AddImageWithContentType([]byte{0}, "image/png", image.Point{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	r := doc.AddParagraph().AddRun()
+	r.AddText("a")
+	r.AddTab()
+	r.AddBreak()
+	r.AddPageBreak()
+	if _, err := r.AddDrawingInline(img); err != nil {
+		t.Fatalf("error adding drawing: %s", err)
+	}
+	r.AddFieldRaw("PAGE")
+
+	nodes := r.AST()
+	if len(nodes) != 8 {
+		t.Fatalf("expected 8 nodes, got %d: %v", len(nodes), nodes)
+	}
+	if n, ok := nodes[0].(document.TextNode); !ok || n.Text != "a" {
+		t.Errorf("expected a text node, got %v", nodes[0])
+	}
+	if _, ok := nodes[1].(document.TabNode); !ok {
+		t.Errorf("expected a tab node, got %v", nodes[1])
+	}
+	if n, ok := nodes[2].(document.BreakNode); !ok || n.Type != wml.ST_BrTypeTextWrapping {
+		t.Errorf("expected a line break node, got %v", nodes[2])
+	}
+	if n, ok := nodes[3].(document.BreakNode); !ok || n.Type != wml.ST_BrTypePage {
+		t.Errorf("expected a page break node, got %v", nodes[3])
+	}
+	if n, ok := nodes[4].(document.DrawingNode); !ok || n.Drawing.IsAnchored() {
+		t.Errorf("expected an inline drawing node, got %v", nodes[4])
+	}
+	if n, ok := nodes[5].(document.FieldNode); !ok || n.Char != wml.ST_FldCharTypeBegin {
+		t.Errorf("expected a field begin node, got %v", nodes[5])
+	}
+	if n, ok := nodes[6].(document.FieldNode); !ok || n.Instruction != "PAGE" {
+		t.Errorf("expected a field instruction node, got %v", nodes[6])
+	}
+	if n, ok := nodes[7].(document.FieldNode); !ok || n.Char != wml.ST_FldCharTypeEnd {
+		t.Errorf("expected a field end node, got %v", nodes[7])
+	}
+}
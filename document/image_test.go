@@ -0,0 +1,80 @@
+package document
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/unidoc/unioffice/common"
+)
+
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			img.Set(x, y, color.RGBA{uint8(x * 7), uint8(y * 13), uint8(x * y), 255})
+		}
+	}
+	return img
+}
+
+func addTestImage(t *testing.T, doc *Document, data []byte, format string) common.ImageRef {
+	img, err := doc.AddImage(common.Image{Data: &data, Format: format, Size: image.Point{X: 40, Y: 20}})
+	if err != nil {
+		t.Fatalf("error adding image: %s", err)
+	}
+	return img
+}
+
+func TestRecompressImageDownscale(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := png.Encode(&buf, testImage(40, 20)); err != nil {
+		t.Fatal(err)
+	}
+	doc := New()
+	img := addTestImage(t, doc, buf.Bytes(), "png")
+
+	if _, ok := doc.recompressImage(img, ImageSaveOptions{JPEGQuality: 10}); ok {
+		t.Errorf("expected a PNG image to be kept without downscaling")
+	}
+	rc, ok := doc.recompressImage(img, ImageSaveOptions{MaxDimension: 10})
+	if !ok {
+		t.Fatalf("expected the image to be downscaled")
+	}
+	dec, format, err := image.Decode(bytes.NewReader(*rc.Data()))
+	if err != nil {
+		t.Fatalf("error decoding recompressed image: %s", err)
+	}
+	if format != "png" || dec.Bounds().Dx() != 10 || dec.Bounds().Dy() != 5 {
+		t.Errorf("expected a 10x5 png, got a %dx%d %s", dec.Bounds().Dx(), dec.Bounds().Dy(), format)
+	}
+	if rc.RelID() != img.RelID() || rc.Size() != img.Size() {
+		t.Errorf("expected the relationship and displayed size to be kept")
+	}
+}
+
+func TestRecompressImageJPEGQuality(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := jpeg.Encode(&buf, testImage(40, 20), &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatal(err)
+	}
+	doc := New()
+	img := addTestImage(t, doc, buf.Bytes(), "jpeg")
+
+	if _, ok := doc.recompressImage(img, ImageSaveOptions{}); ok {
+		t.Errorf("expected the image to be kept without options")
+	}
+	rc, ok := doc.recompressImage(img, ImageSaveOptions{JPEGQuality: 20})
+	if !ok {
+		t.Fatalf("expected the image to be re-encoded")
+	}
+	if len(*rc.Data()) >= buf.Len() {
+		t.Errorf("expected a smaller image, got %d bytes from %d", len(*rc.Data()), buf.Len())
+	}
+	if dec, err := jpeg.Decode(bytes.NewReader(*rc.Data())); err != nil || dec.Bounds().Dx() != 40 {
+		t.Errorf("expected a 40 pixel wide jpeg, got %v", err)
+	}
+}
@@ -9,10 +9,26 @@
 // Use of this source code is governed by the UniDoc End User License Agreement
 // terms that can be accessed at https://unidoc.io/eula/
 
-package measurement ;
+package measurement ;import _d "strconv";
 
-// ToEMU converts float64 distance units to int64 EMU.
-func ToEMU (m float64 )int64 {return int64 (914400.0/Inch *m )};const (Zero Distance =0;Point =1;Pixel72 =1.0/72.0*Inch ;Pixel96 =1.0/96.0*Inch ;HalfPoint =1.0/2.0*Point ;Character =7*Point ;Millimeter =2.83465*Point ;Centimeter =10*Millimeter ;Inch =72*Point ;Foot =12*Inch ;Twips =1.0/20.0*Point ;EMU =1.0/914400.0*Inch ;HundredthPoint =1/100.0;Dxa =Twips ;);
+// Format returns the distance formatted in the given unit with up to two
+// decimal places, e.g. "2.54cm".  Supported units are Point, Inch, Foot,
+// Centimeter, Millimeter, Twips, EMU and Pixel96. Other units format
+// the distance in points.
+func (_aab Distance )Format (unit Distance )string {_acee :="";switch unit {case Point :_acee ="\u0070\u0074";case Inch :_acee ="\u0069\u006e";case Foot :_acee ="\u0066\u0074";case Centimeter :_acee ="\u0063\u006d";case Millimeter :_acee ="\u006d\u006d";case Twips :_acee ="\u0074\u0077\u0069p";case EMU :_acee ="\u0065\u006d\u0075";case Pixel96 :_acee ="\u0070x";default:unit =Point ;_acee ="\u0070\u0074";};_bce :=_d .FormatFloat (float64 (_aab /unit ),'f',2,64);for _bce [len (_bce )-1]=='0'{_bce =_bce [:len (_bce )-1];};if _bce [len (_bce )-1]=='.'{_bce =_bce [:len (_bce )-1];};if _bce =="\u002d\u0030"{_bce ="\u0030";};return _bce +_acee ;};
+
+// ToEMU converts float64 distance units to int64 EMU, rounding to the nearest
+// EMU.
+func ToEMU (m float64 )int64 {_bdf :=914400.0/Inch *m ;if _bdf < 0{return int64 (_bdf -0.5);};return int64 (_bdf +0.5);};const (Zero Distance =0;Point =1;Pixel72 =1.0/72.0*Inch ;Pixel96 =1.0/96.0*Inch ;HalfPoint =1.0/2.0*Point ;Character =7*Point ;Millimeter =2.83465*Point ;Centimeter =10*Millimeter ;Inch =72*Point ;Foot =12*Inch ;Twips =1.0/20.0*Point ;EMU =1.0/914400.0*Inch ;HundredthPoint =1/100.0;Dxa =Twips ;);
+
+// String returns the distance in points, e.g. "12pt". Note that this changes
+// the output of the %v and %s verbs, which printed the bare number of points
+// before Distance implemented fmt.Stringer.
+func (_bbcgd Distance )String ()string {return _bbcgd .Format (Point )};
+
+// PixelsToEMU converts a pixel count at the given resolution in dots per inch
+// to EMU, rounding to the nearest EMU.  A dpi of zero is treated as 72.
+func PixelsToEMU (px int ,dpi float64 )int64 {if dpi <=0{dpi =72;};_egbcb :=float64 (px )/dpi *914400.0;if _egbcb < 0{return int64 (_egbcb -0.5);};return int64 (_egbcb +0.5);};
 
 // Distance represents a distance and is automatically converted
 // to the units needed internally in the various ECMA 376 formats.
@@ -0,0 +1,81 @@
+package measurement_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/unidoc/unioffice/measurement"
+)
+
+func TestDistanceFormat(t *testing.T) {
+	td := []struct {
+		d    measurement.Distance
+		unit measurement.Distance
+		exp  string
+	}{
+		{12 * measurement.Point, measurement.Point, "12pt"},
+		{1 * measurement.Inch, measurement.Inch, "1in"},
+		{1 * measurement.Inch, measurement.Centimeter, "2.54cm"},
+		{1 * measurement.Inch, measurement.Millimeter, "25.4mm"},
+		{1 * measurement.Point, measurement.Twips, "20twip"},
+		{1.5 * measurement.Point, measurement.Point, "1.5pt"},
+		{-3 * measurement.Point, measurement.Point, "-3pt"},
+		{0, measurement.Point, "0pt"},
+		{1 * measurement.Inch, measurement.Pixel72, "72pt"},
+	}
+	for _, tc := range td {
+		if got := tc.d.Format(tc.unit); got != tc.exp {
+			t.Errorf("expected %s, got %s", tc.exp, got)
+		}
+	}
+}
+
+func TestDistanceString(t *testing.T) {
+	d := measurement.Distance(12 * measurement.Point)
+	if got := d.String(); got != "12pt" {
+		t.Errorf("expected 12pt, got %s", got)
+	}
+	if got := fmt.Sprintf("%v", d); got != "12pt" {
+		t.Errorf("expected %%v to use String, got %s", got)
+	}
+}
+
+func TestToEMU(t *testing.T) {
+	td := []struct {
+		m   float64
+		exp int64
+	}{
+		{1 * measurement.Inch, 914400},
+		{1 * measurement.Point, 12700},
+		{0.5 * measurement.Point, 6350},
+		{1 * measurement.Millimeter, 36000},
+		{-1 * measurement.Millimeter, -36000},
+		{1.0 / 12700 * 0.6, 1},
+	}
+	for _, tc := range td {
+		if got := measurement.ToEMU(tc.m); got != tc.exp {
+			t.Errorf("expected %v to be %d EMU, got %d", tc.m, tc.exp, got)
+		}
+	}
+}
+
+func TestPixelsToEMU(t *testing.T) {
+	td := []struct {
+		px  int
+		dpi float64
+		exp int64
+	}{
+		{72, 72, 914400},
+		{96, 96, 914400},
+		{1, 96, 9525},
+		{1, 300, 3048},
+		{7, 2540, 2520},
+		{100, 0, 1270000},
+		{-1, 72, -12700},
+	}
+	for _, tc := range td {
+		if got := measurement.PixelsToEMU(tc.px, tc.dpi); got != tc.exp {
+			t.Errorf("expected %d px at %v dpi to be %d EMU, got %d", tc.px, tc.dpi, tc.exp, got)
+		}
+	}
+}
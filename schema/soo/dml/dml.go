@@ -246,7 +246,12 @@ func (_fcbeg *CT_Table )ValidateWithPath (path string )error {if _fcbeg .TblPr !
 func (_cga *CT_AlphaModulateFixedEffect )Validate ()error {return _cga .ValidateWithPath ("C\u0054\u005f\u0041\u006c\u0070\u0068a\u004d\u006f\u0064\u0075\u006c\u0061\u0074\u0065\u0046i\u0078\u0065\u0064E\u0066f\u0065\u0063\u0074");};
 
 // ValidateWithPath validates the CT_OfficeArtExtension and its children, prefixing error messages with path
-func (_efaag *CT_OfficeArtExtension )ValidateWithPath (path string )error {return nil };type ST_EffectContainerType byte ;func (_fegf *CT_GroupTransform2D )MarshalXML (e *_b .Encoder ,start _b .StartElement )error {if _fegf .RotAttr !=nil {start .Attr =append (start .Attr ,_b .Attr {Name :_b .Name {Local :"\u0072\u006f\u0074"},Value :_bb .Sprintf ("\u0025\u0076",*_fegf .RotAttr )});};if _fegf .FlipHAttr !=nil {start .Attr =append (start .Attr ,_b .Attr {Name :_b .Name {Local :"\u0066\u006c\u0069p\u0048"},Value :_bb .Sprintf ("\u0025\u0064",_bcgfg (*_fegf .FlipHAttr ))});};if _fegf .FlipVAttr !=nil {start .Attr =append (start .Attr ,_b .Attr {Name :_b .Name {Local :"\u0066\u006c\u0069p\u0056"},Value :_bb .Sprintf ("\u0025\u0064",_bcgfg (*_fegf .FlipVAttr ))});};e .EncodeToken (start );if _fegf .Off !=nil {_ecef :=_b .StartElement {Name :_b .Name {Local :"\u0061\u003a\u006ff\u0066"}};e .EncodeElement (_fegf .Off ,_ecef );};if _fegf .Ext !=nil {_ecgca :=_b .StartElement {Name :_b .Name {Local :"\u0061\u003a\u0065x\u0074"}};e .EncodeElement (_fegf .Ext ,_ecgca );};if _fegf .ChOff !=nil {_cbdd :=_b .StartElement {Name :_b .Name {Local :"\u0061:\u0063\u0068\u004f\u0066\u0066"}};e .EncodeElement (_fegf .ChOff ,_cbdd );};if _fegf .ChExt !=nil {_bcfb :=_b .StartElement {Name :_b .Name {Local :"\u0061:\u0063\u0068\u0045\u0078\u0074"}};e .EncodeElement (_fegf .ChExt ,_bcfb );};e .EncodeToken (_b .EndElement {Name :start .Name });return nil ;};func ParseStdlibTime (s string )(_ea .Time ,error ){return _ea .Time {},nil };func (_bdebag *CT_GvmlGroupShapeNonVisual )UnmarshalXML (d *_b .Decoder ,start _b .StartElement )error {_bdebag .CNvPr =NewCT_NonVisualDrawingProps ();_bdebag .CNvGrpSpPr =NewCT_NonVisualGroupDrawingShapeProps ();_dbbgf :for {_deeg ,_aadgg :=d .Token ();if _aadgg !=nil {return _aadgg ;};switch _gcfea :=_deeg .(type ){case _b .StartElement :switch _gcfea .Name {case _b .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076P\u0072"},_b .Name {Space :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072g\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076P\u0072"}:if _eefbd :=d .DecodeElement (_bdebag .CNvPr ,&_gcfea );_eefbd !=nil {return _eefbd ;};case _b .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076\u0047\u0072\u0070\u0053\u0070\u0050\u0072"},_b .Name {Space :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072g\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076\u0047\u0072\u0070\u0053\u0070\u0050\u0072"}:if _cdgb :=d .DecodeElement (_bdebag .CNvGrpSpPr ,&_gcfea );_cdgb !=nil {return _cdgb ;};default:_f .Log ("s\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006et\u0020o\u006e\u0020\u0043\u0054_\u0047\u0076m\u006c\u0047\u0072\u006f\u0075\u0070\u0053\u0068\u0061\u0070\u0065\u004e\u006f\u006e\u0056\u0069\u0073\u0075\u0061\u006c\u0020\u0025\u0076",_gcfea .Name );if _dafdd :=d .Skip ();_dafdd !=nil {return _dafdd ;};};case _b .EndElement :break _dbbgf ;case _b .CharData :};};return nil ;};func (_bfggb ST_TextFontScalePercentOrPercentString )MarshalXML (e *_b .Encoder ,start _b .StartElement )error {e .EncodeToken (start );if _bfggb .ST_TextFontScalePercent !=nil {e .EncodeToken (_b .CharData (_bb .Sprintf ("\u0025\u0064",*_bfggb .ST_TextFontScalePercent )));};if _bfggb .ST_Percentage !=nil {e .EncodeToken (_b .CharData (*_bfggb .ST_Percentage ));};return e .EncodeToken (_b .EndElement {Name :start .Name });};type CT_HSLEffect struct{HueAttr *int32 ;SatAttr *ST_FixedPercentage ;LumAttr *ST_FixedPercentage ;};func (_ecgfd ST_TextTabAlignType )String ()string {switch _ecgfd {case 0:return "";case 1:return "\u006c";case 2:return "\u0063\u0074\u0072";case 3:return "\u0072";case 4:return "\u0064\u0065\u0063";};return "";};
+func (_efaag *CT_OfficeArtExtension )ValidateWithPath (path string )error {return nil };type ST_EffectContainerType byte ;func (_fegf *CT_GroupTransform2D )MarshalXML (e *_b .Encoder ,start _b .StartElement )error {if _fegf .RotAttr !=nil {start .Attr =append (start .Attr ,_b .Attr {Name :_b .Name {Local :"\u0072\u006f\u0074"},Value :_bb .Sprintf ("\u0025\u0076",*_fegf .RotAttr )});};if _fegf .FlipHAttr !=nil {start .Attr =append (start .Attr ,_b .Attr {Name :_b .Name {Local :"\u0066\u006c\u0069p\u0048"},Value :_bb .Sprintf ("\u0025\u0064",_bcgfg (*_fegf .FlipHAttr ))});};if _fegf .FlipVAttr !=nil {start .Attr =append (start .Attr ,_b .Attr {Name :_b .Name {Local :"\u0066\u006c\u0069p\u0056"},Value :_bb .Sprintf ("\u0025\u0064",_bcgfg (*_fegf .FlipVAttr ))});};e .EncodeToken (start );if _fegf .Off !=nil {_ecef :=_b .StartElement {Name :_b .Name {Local :"\u0061\u003a\u006ff\u0066"}};e .EncodeElement (_fegf .Off ,_ecef );};if _fegf .Ext !=nil {_ecgca :=_b .StartElement {Name :_b .Name {Local :"\u0061\u003a\u0065x\u0074"}};e .EncodeElement (_fegf .Ext ,_ecgca );};if _fegf .ChOff !=nil {_cbdd :=_b .StartElement {Name :_b .Name {Local :"\u0061:\u0063\u0068\u004f\u0066\u0066"}};e .EncodeElement (_fegf .ChOff ,_cbdd );};if _fegf .ChExt !=nil {_bcfb :=_b .StartElement {Name :_b .Name {Local :"\u0061:\u0063\u0068\u0045\u0078\u0074"}};e .EncodeElement (_fegf .ChExt ,_bcfb );};e .EncodeToken (_b .EndElement {Name :start .Name });return nil ;};
+
+// ParseStdlibTime parses an xsd:dateTime value. Values that can't be parsed
+// are logged and returned as the zero time, so that a malformed date doesn't
+// prevent the document from being read.
+func ParseStdlibTime (s string )(_ea .Time ,error ){for _ ,_fgbcee :=range []string {_ea .RFC3339 ,"20\u00306\u002d\u00301-\u00302T\u0031\u0035\u003a\u0030\u0034\u003a\u00305","\u003200\u0036\u002d\u0030\u0031\u002d\u00302\u0054\u0031\u0035:0\u0034\u005a\u0030\u0037\u003a00","\u00320\u0030\u0036\u002d\u0030\u0031\u002d\u0030\u0032\u0054\u0031\u0035\u003a\u0030\u0034","\u0032\u0030\u0030\u0036\u002d\u0030\u0031\u002d\u0030\u0032"}{if _ebcfge ,_dagcfe :=_ea .Parse (_fgbcee ,s );_dagcfe ==nil {return _ebcfge ,nil ;};};_f .Log ("\u0075\u006e\u0061\u0062\u006c\u0065 \u0074o\u0020\u0070\u0061\u0072\u0073\u0065 t\u0069m\u0065\u0020\u0025s\u002c u\u0073\u0069\u006e\u0067\u0020\u0074h\u0065\u0020\u007a\u0065\u0072\u006f \u0074im\u0065",s );return _ea .Time {},nil ;};func (_bdebag *CT_GvmlGroupShapeNonVisual )UnmarshalXML (d *_b .Decoder ,start _b .StartElement )error {_bdebag .CNvPr =NewCT_NonVisualDrawingProps ();_bdebag .CNvGrpSpPr =NewCT_NonVisualGroupDrawingShapeProps ();_dbbgf :for {_deeg ,_aadgg :=d .Token ();if _aadgg !=nil {return _aadgg ;};switch _gcfea :=_deeg .(type ){case _b .StartElement :switch _gcfea .Name {case _b .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076P\u0072"},_b .Name {Space :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072g\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076P\u0072"}:if _eefbd :=d .DecodeElement (_bdebag .CNvPr ,&_gcfea );_eefbd !=nil {return _eefbd ;};case _b .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076\u0047\u0072\u0070\u0053\u0070\u0050\u0072"},_b .Name {Space :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072g\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u006d\u0061\u0069\u006e",Local :"\u0063\u004e\u0076\u0047\u0072\u0070\u0053\u0070\u0050\u0072"}:if _cdgb :=d .DecodeElement (_bdebag .CNvGrpSpPr ,&_gcfea );_cdgb !=nil {return _cdgb ;};default:_f .Log ("s\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006et\u0020o\u006e\u0020\u0043\u0054_\u0047\u0076m\u006c\u0047\u0072\u006f\u0075\u0070\u0053\u0068\u0061\u0070\u0065\u004e\u006f\u006e\u0056\u0069\u0073\u0075\u0061\u006c\u0020\u0025\u0076",_gcfea .Name );if _dafdd :=d .Skip ();_dafdd !=nil {return _dafdd ;};};case _b .EndElement :break _dbbgf ;case _b .CharData :};};return nil ;};func (_bfggb ST_TextFontScalePercentOrPercentString )MarshalXML (e *_b .Encoder ,start _b .StartElement )error {e .EncodeToken (start );if _bfggb .ST_TextFontScalePercent !=nil {e .EncodeToken (_b .CharData (_bb .Sprintf ("\u0025\u0064",*_bfggb .ST_TextFontScalePercent )));};if _bfggb .ST_Percentage !=nil {e .EncodeToken (_b .CharData (*_bfggb .ST_Percentage ));};return e .EncodeToken (_b .EndElement {Name :start .Name });};type CT_HSLEffect struct{HueAttr *int32 ;SatAttr *ST_FixedPercentage ;LumAttr *ST_FixedPercentage ;};func (_ecgfd ST_TextTabAlignType )String ()string {switch _ecgfd {case 0:return "";case 1:return "\u006c";case 2:return "\u0063\u0074\u0072";case 3:return "\u0072";case 4:return "\u0064\u0065\u0063";};return "";};
 
 // Validate validates the CT_GvmlConnector and its children
 func (_dggbb *CT_GvmlConnector )Validate ()error {return _dggbb .ValidateWithPath ("\u0043\u0054_\u0047\u0076\u006dl\u0043\u006f\u006e\u006e\u0065\u0063\u0074\u006f\u0072");};func (_adagc ST_BevelPresetType )ValidateWithPath (path string )error {switch _adagc {case 0,1,2,3,4,5,6,7,8,9,10,11,12:default:return _bb .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_adagc ));};return nil ;};func NewCT_FlatText ()*CT_FlatText {_baaa :=&CT_FlatText {};return _baaa };
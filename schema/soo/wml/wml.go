@@ -9,7 +9,12 @@
 // Use of this source code is governed by the UniDoc End User License Agreement
 // terms that can be accessed at https://unidoc.io/eula/
 
-package wml ;import (_g "encoding/xml";_gd "fmt";_ga "github.com/unidoc/unioffice";_e "github.com/unidoc/unioffice/schema/soo/dml";_a "github.com/unidoc/unioffice/schema/soo/dml/picture";_ec "github.com/unidoc/unioffice/schema/soo/ofc/math";_gc "github.com/unidoc/unioffice/schema/soo/ofc/sharedTypes";_da "github.com/unidoc/unioffice/schema/soo/schemaLibrary";_c "regexp";_b "strconv";_f "time";);type EG_PContentMath struct{EG_PContentBase []*EG_PContentBase ;EG_ContentRunContentBase []*EG_ContentRunContentBase ;};type Hdr struct{CT_HdrFtr };func (_bfdac ST_HAnchor )Validate ()error {return _bfdac .ValidateWithPath ("")};func NewAG_SectPrAttributes ()*AG_SectPrAttributes {_ecfb :=&AG_SectPrAttributes {};return _ecfb };func (_agcbad *ST_TabTlc )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_agcbad =0;case "\u006e\u006f\u006e\u0065":*_agcbad =1;case "\u0064\u006f\u0074":*_agcbad =2;case "\u0068\u0079\u0070\u0068\u0065\u006e":*_agcbad =3;case "\u0075\u006e\u0064\u0065\u0072\u0073\u0063\u006f\u0072\u0065":*_agcbad =4;case "\u0068\u0065\u0061v\u0079":*_agcbad =5;case "\u006di\u0064\u0064\u006c\u0065\u0044\u006ft":*_agcbad =6;};return nil ;};func NewEG_RPrMath ()*EG_RPrMath {_bdbff :=&EG_RPrMath {};return _bdbff };func (_ccgcf *CT_ShapeDefaults )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _ccgcf .Any !=nil {for _ ,_fddfe :=range _ccgcf .Any {_fddfe .MarshalXML (e ,_g .StartElement {});};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_ffbba ST_StyleSort )Validate ()error {return _ffbba .ValidateWithPath ("")};
+package wml ;import (_g "encoding/xml";_gd "fmt";_ga "github.com/unidoc/unioffice";_e "github.com/unidoc/unioffice/schema/soo/dml";_a "github.com/unidoc/unioffice/schema/soo/dml/picture";_ec "github.com/unidoc/unioffice/schema/soo/ofc/math";_gc "github.com/unidoc/unioffice/schema/soo/ofc/sharedTypes";_da "github.com/unidoc/unioffice/schema/soo/schemaLibrary";_c "regexp";_b "strconv";_f "time";);type EG_PContentMath struct{EG_PContentBase []*EG_PContentBase ;EG_ContentRunContentBase []*EG_ContentRunContentBase ;};type Hdr struct{CT_HdrFtr };func (_bfdac ST_HAnchor )Validate ()error {return _bfdac .ValidateWithPath ("")};func NewAG_SectPrAttributes ()*AG_SectPrAttributes {_ecfb :=&AG_SectPrAttributes {};return _ecfb };func (_agcbad *ST_TabTlc )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_agcbad =0;case "\u006e\u006f\u006e\u0065":*_agcbad =1;case "\u0064\u006f\u0074":*_agcbad =2;case "\u0068\u0079\u0070\u0068\u0065\u006e":*_agcbad =3;case "\u0075\u006e\u0064\u0065\u0072\u0073\u0063\u006f\u0072\u0065":*_agcbad =4;case "\u0068\u0065\u0061v\u0079":*_agcbad =5;case "\u006di\u0064\u0064\u006c\u0065\u0044\u006ft":*_agcbad =6;};return nil ;};func NewEG_RPrMath ()*EG_RPrMath {_bdbff :=&EG_RPrMath {};return _bdbff };
+
+// isEmpty returns true if no run properties are set, in which case the
+// properties are omitted when the run is written.
+func (_gcfbd *CT_RPr )isEmpty ()bool {return _gcfbd .RStyle ==nil &&_gcfbd .RFonts ==nil &&_gcfbd .B ==nil &&_gcfbd .BCs ==nil &&_gcfbd .I ==nil &&_gcfbd .ICs ==nil &&_gcfbd .Caps ==nil &&_gcfbd .SmallCaps ==nil &&_gcfbd .Strike ==nil &&_gcfbd .Dstrike ==nil &&_gcfbd .Outline ==nil &&_gcfbd .Shadow ==nil &&_gcfbd .Emboss ==nil &&_gcfbd .Imprint ==nil &&_gcfbd .NoProof ==nil &&_gcfbd .SnapToGrid ==nil &&_gcfbd .Vanish ==nil &&_gcfbd .WebHidden ==nil &&_gcfbd .Color ==nil &&_gcfbd .Spacing ==nil &&_gcfbd .W ==nil &&_gcfbd .Kern ==nil &&_gcfbd .Position ==nil &&_gcfbd .Sz ==nil &&_gcfbd .SzCs ==nil &&_gcfbd .Highlight ==nil &&_gcfbd .U ==nil &&_gcfbd .Effect ==nil &&_gcfbd .Bdr ==nil &&_gcfbd .Shd ==nil &&_gcfbd .FitText ==nil &&_gcfbd .VertAlign ==nil &&_gcfbd .Rtl ==nil &&_gcfbd .Cs ==nil &&_gcfbd .Em ==nil &&_gcfbd .Lang ==nil &&_gcfbd .EastAsianLayout ==nil &&_gcfbd .SpecVanish ==nil &&_gcfbd .OMath ==nil &&_gcfbd .RPrChange ==nil &&len (_gcfbd .Extra )==0;};
+func (_ccgcf *CT_ShapeDefaults )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _ccgcf .Any !=nil {for _ ,_fddfe :=range _ccgcf .Any {_fddfe .MarshalXML (e ,_g .StartElement {});};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_ffbba ST_StyleSort )Validate ()error {return _ffbba .ValidateWithPath ("")};
 
 // ValidateWithPath validates the CT_DocPart and its children, prefixing error messages with path
 func (_egaa *CT_DocPart )ValidateWithPath (path string )error {if _egaa .DocPartPr !=nil {if _ecfgb :=_egaa .DocPartPr .ValidateWithPath (path +"\u002f\u0044\u006f\u0063\u0050\u0061\u0072\u0074\u0050\u0072");_ecfgb !=nil {return _ecfgb ;};};if _egaa .DocPartBody !=nil {if _cfda :=_egaa .DocPartBody .ValidateWithPath (path +"\u002f\u0044\u006fc\u0050\u0061\u0072\u0074\u0042\u006f\u0064\u0079");_cfda !=nil {return _cfda ;};};return nil ;};func (_cdbgfa *CT_VerticalAlignRun )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cdbgfa .ValAttr =_gc .ST_VerticalAlignRun (1);for _ ,_afcac :=range start .Attr {if _afcac .Name .Local =="\u0076\u0061\u006c"{_cdbgfa .ValAttr .UnmarshalXMLAttr (_afcac );continue ;};};for {_gffddd ,_fbbgf :=d .Token ();if _fbbgf !=nil {return _gd .Errorf ("\u0070\u0061\u0072s\u0069\u006e\u0067\u0020C\u0054\u005f\u0056\u0065\u0072\u0074\u0069c\u0061\u006c\u0041\u006c\u0069\u0067\u006e\u0052\u0075\u006e\u003a\u0020\u0025\u0073",_fbbgf );};if _debcc ,_cacggg :=_gffddd .(_g .EndElement );_cacggg &&_debcc .Name ==start .Name {break ;};};return nil ;};func (_efafe *CT_SdtEndPr )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_dbbae :for {_fafgd ,_aaacg :=d .Token ();if _aaacg !=nil {return _aaacg ;};switch _gceae :=_fafgd .(type ){case _g .StartElement :switch _gceae .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0050\u0072"}:_cadagd :=NewCT_RPr ();if _bdcea :=d .DecodeElement (_cadagd ,&_gceae );_bdcea !=nil {return _bdcea ;};_efafe .RPr =append (_efafe .RPr ,_cadagd );default:_ga .Log ("\u0073\u006bi\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0053\u0064\u0074\u0045\u006e\u0064\u0050\u0072\u0020\u0025\u0076",_gceae .Name );if _caffd :=d .Skip ();_caffd !=nil {return _caffd ;};};case _g .EndElement :break _dbbae ;case _g .CharData :};};return nil ;};
@@ -291,7 +296,7 @@ FldData *CT_Text ;EG_PContent []*EG_PContent ;};func (_bfefg ST_CaptionPos )Stri
 func (_bffga *GlossaryDocument )Validate ()error {return _bffga .ValidateWithPath ("\u0047\u006co\u0073\u0073\u0061r\u0079\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074");};func (_bgefcf ST_TextDirection )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_bgefcf .String (),start );};func (_dcdcabc ST_DocPartType )ValidateWithPath (path string )error {switch _dcdcabc {case 0,1,2,3,4,5,6,7:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_dcdcabc ));};return nil ;};func (_ecfae *ST_Shd )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_geaaf ,_gbeabb :=d .Token ();if _gbeabb !=nil {return _gbeabb ;};if _gacaag ,_cfdeda :=_geaaf .(_g .EndElement );_cfdeda &&_gacaag .Name ==start .Name {*_ecfae =1;return nil ;};if _bfbgf ,_gabdg :=_geaaf .(_g .CharData );!_gabdg {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_geaaf );}else {switch string (_bfbgf ){case "":*_ecfae =0;case "\u006e\u0069\u006c":*_ecfae =1;case "\u0063\u006c\u0065a\u0072":*_ecfae =2;case "\u0073\u006f\u006ci\u0064":*_ecfae =3;case "\u0068\u006f\u0072\u007a\u0053\u0074\u0072\u0069\u0070\u0065":*_ecfae =4;case "\u0076\u0065\u0072\u0074\u0053\u0074\u0072\u0069\u0070\u0065":*_ecfae =5;case "\u0072\u0065\u0076\u0065\u0072\u0073\u0065\u0044\u0069\u0061\u0067\u0053t\u0072\u0069\u0070\u0065":*_ecfae =6;case "\u0064\u0069\u0061\u0067\u0053\u0074\u0072\u0069\u0070\u0065":*_ecfae =7;case "\u0068o\u0072\u007a\u0043\u0072\u006f\u0073s":*_ecfae =8;case "\u0064i\u0061\u0067\u0043\u0072\u006f\u0073s":*_ecfae =9;case "\u0074\u0068\u0069\u006e\u0048\u006f\u0072\u007a\u0053t\u0072\u0069\u0070\u0065":*_ecfae =10;case "\u0074\u0068\u0069\u006e\u0056\u0065\u0072\u0074\u0053t\u0072\u0069\u0070\u0065":*_ecfae =11;case "t\u0068\u0069\u006e\u0052ev\u0065r\u0073\u0065\u0044\u0069\u0061g\u0053\u0074\u0072\u0069\u0070\u0065":*_ecfae =12;case "\u0074\u0068\u0069\u006e\u0044\u0069\u0061\u0067\u0053t\u0072\u0069\u0070\u0065":*_ecfae =13;case "\u0074\u0068\u0069\u006e\u0048\u006f\u0072\u007a\u0043\u0072\u006f\u0073\u0073":*_ecfae =14;case "\u0074\u0068\u0069\u006e\u0044\u0069\u0061\u0067\u0043\u0072\u006f\u0073\u0073":*_ecfae =15;case "\u0070\u0063\u0074\u0035":*_ecfae =16;case "\u0070\u0063\u00741\u0030":*_ecfae =17;case "\u0070\u0063\u00741\u0032":*_ecfae =18;case "\u0070\u0063\u00741\u0035":*_ecfae =19;case "\u0070\u0063\u00742\u0030":*_ecfae =20;case "\u0070\u0063\u00742\u0035":*_ecfae =21;case "\u0070\u0063\u00743\u0030":*_ecfae =22;case "\u0070\u0063\u00743\u0035":*_ecfae =23;case "\u0070\u0063\u00743\u0037":*_ecfae =24;case "\u0070\u0063\u00744\u0030":*_ecfae =25;case "\u0070\u0063\u00744\u0035":*_ecfae =26;case "\u0070\u0063\u00745\u0030":*_ecfae =27;case "\u0070\u0063\u00745\u0035":*_ecfae =28;case "\u0070\u0063\u00746\u0030":*_ecfae =29;case "\u0070\u0063\u00746\u0032":*_ecfae =30;case "\u0070\u0063\u00746\u0035":*_ecfae =31;case "\u0070\u0063\u00747\u0030":*_ecfae =32;case "\u0070\u0063\u00747\u0035":*_ecfae =33;case "\u0070\u0063\u00748\u0030":*_ecfae =34;case "\u0070\u0063\u00748\u0035":*_ecfae =35;case "\u0070\u0063\u00748\u0037":*_ecfae =36;case "\u0070\u0063\u00749\u0030":*_ecfae =37;case "\u0070\u0063\u00749\u0035":*_ecfae =38;};};_geaaf ,_gbeabb =d .Token ();if _gbeabb !=nil {return _gbeabb ;};if _ffgga ,_fabce :=_geaaf .(_g .EndElement );_fabce &&_ffgga .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_geaaf );};type CT_DecimalNumberOrPrecent struct{
 
 // Value in Percent
-ValAttr ST_DecimalNumberOrPercent ;};func (_adbdg *CT_NumPicBullet )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003an\u0075\u006d\u0050i\u0063\u0042\u0075\u006c\u006c\u0065\u0074\u0049\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_adbdg .NumPicBulletIdAttr )});e .EncodeToken (start );if _adbdg .Pict !=nil {_dcdeaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0069\u0063\u0074"}};e .EncodeElement (_adbdg .Pict ,_dcdeaa );};if _adbdg .Drawing !=nil {_egbcg :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0064\u0072\u0061\u0077\u0069\u006eg"}};e .EncodeElement (_adbdg .Drawing ,_egbcg );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_SdtRun ()*CT_SdtRun {_bbaa :=&CT_SdtRun {};return _bbaa };func (_fecdfd *CT_RunTrackChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fecdfd .AuthorAttr )});if _fecdfd .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_fecdfd .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fecdfd .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fgaff *ST_SectionMark )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cggade ,_eababf :=d .Token ();if _eababf !=nil {return _eababf ;};if _gdedd ,_feeecb :=_cggade .(_g .EndElement );_feeecb &&_gdedd .Name ==start .Name {*_fgaff =1;return nil ;};if _eefgg ,_dbbba :=_cggade .(_g .CharData );!_dbbba {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_cggade );}else {switch string (_eefgg ){case "":*_fgaff =0;case "\u006e\u0065\u0078\u0074\u0050\u0061\u0067\u0065":*_fgaff =1;case "\u006e\u0065\u0078\u0074\u0043\u006f\u006c\u0075\u006d\u006e":*_fgaff =2;case "\u0063\u006f\u006e\u0074\u0069\u006e\u0075\u006f\u0075\u0073":*_fgaff =3;case "\u0065\u0076\u0065\u006e\u0050\u0061\u0067\u0065":*_fgaff =4;case "\u006fd\u0064\u0050\u0061\u0067\u0065":*_fgaff =5;};};_cggade ,_eababf =d .Token ();if _eababf !=nil {return _eababf ;};if _fccac ,_bfbfe :=_cggade .(_g .EndElement );_bfbfe &&_fccac .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_cggade );};func (_gbeegf ST_TextAlignment )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_cgeece :=_g .Attr {};_cgeece .Name =name ;switch _gbeegf {case ST_TextAlignmentUnset :_cgeece .Value ="";case ST_TextAlignmentTop :_cgeece .Value ="\u0074\u006f\u0070";case ST_TextAlignmentCenter :_cgeece .Value ="\u0063\u0065\u006e\u0074\u0065\u0072";case ST_TextAlignmentBaseline :_cgeece .Value ="\u0062\u0061\u0073\u0065\u006c\u0069\u006e\u0065";case ST_TextAlignmentBottom :_cgeece .Value ="\u0062\u006f\u0074\u0074\u006f\u006d";case ST_TextAlignmentAuto :_cgeece .Value ="\u0061\u0075\u0074\u006f";};return _cgeece ,nil ;};func NewCT_RubyPr ()*CT_RubyPr {_fdbdfa :=&CT_RubyPr {};_fdbdfa .RubyAlign =NewCT_RubyAlign ();_fdbdfa .Hps =NewCT_HpsMeasure ();_fdbdfa .HpsRaise =NewCT_HpsMeasure ();_fdbdfa .HpsBaseText =NewCT_HpsMeasure ();_fdbdfa .Lid =NewCT_Lang ();return _fdbdfa ;};func (_gbddb *WdCT_WordprocessingGroupChoice )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gbddb .Wsp !=nil {_ggdcb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0077\u0073\u0070"}};for _ ,_bdaage :=range _gbddb .Wsp {e .EncodeElement (_bdaage ,_ggdcb );};};if _gbddb .GrpSp !=nil {_gafdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0067\u0072\u0070\u0053\u0070"}};for _ ,_gcbcfa :=range _gbddb .GrpSp {e .EncodeElement (_gcbcfa ,_gafdg );};};if _gbddb .GraphicFrame !=nil {_agbgf :=_g .StartElement {Name :_g .Name {Local :"\u0077p\u003ag\u0072\u0061\u0070\u0068\u0069\u0063\u0046\u0072\u0061\u006d\u0065"}};for _ ,_dcfeee :=range _gbddb .GraphicFrame {e .EncodeElement (_dcfeee ,_agbgf );};};if _gbddb .Pic !=nil {_dgbcec :=_g .StartElement {Name :_g .Name {Local :"\u0070i\u0063\u003a\u0070\u0069\u0063"}};for _ ,_cgcfc :=range _gbddb .Pic {e .EncodeElement (_cgcfc ,_dgbcec );};};if _gbddb .ContentPart !=nil {_eaagc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0063\u006f\u006e\u0074\u0065\u006et\u0050\u0061\u0072\u0074"}};for _ ,_cgfef :=range _gbddb .ContentPart {e .EncodeElement (_cgfef ,_eaagc );};};return nil ;};func (_fffgd ST_ThemeColor )Validate ()error {return _fffgd .ValidateWithPath ("")};
+ValAttr ST_DecimalNumberOrPercent ;};func (_adbdg *CT_NumPicBullet )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003an\u0075\u006d\u0050i\u0063\u0042\u0075\u006c\u006c\u0065\u0074\u0049\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_adbdg .NumPicBulletIdAttr )});e .EncodeToken (start );if _adbdg .Pict !=nil {_dcdeaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0069\u0063\u0074"}};e .EncodeElement (_adbdg .Pict ,_dcdeaa );};if _adbdg .Drawing !=nil {_egbcg :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0064\u0072\u0061\u0077\u0069\u006eg"}};e .EncodeElement (_adbdg .Drawing ,_egbcg );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_SdtRun ()*CT_SdtRun {_bbaa :=&CT_SdtRun {};return _bbaa };func (_fecdfd *CT_RunTrackChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fecdfd .AuthorAttr )});if _fecdfd .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_fecdfd .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fecdfd .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fgaff *ST_SectionMark )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cggade ,_eababf :=d .Token ();if _eababf !=nil {return _eababf ;};if _gdedd ,_feeecb :=_cggade .(_g .EndElement );_feeecb &&_gdedd .Name ==start .Name {*_fgaff =1;return nil ;};if _eefgg ,_dbbba :=_cggade .(_g .CharData );!_dbbba {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_cggade );}else {switch string (_eefgg ){case "":*_fgaff =0;case "\u006e\u0065\u0078\u0074\u0050\u0061\u0067\u0065":*_fgaff =1;case "\u006e\u0065\u0078\u0074\u0043\u006f\u006c\u0075\u006d\u006e":*_fgaff =2;case "\u0063\u006f\u006e\u0074\u0069\u006e\u0075\u006f\u0075\u0073":*_fgaff =3;case "\u0065\u0076\u0065\u006e\u0050\u0061\u0067\u0065":*_fgaff =4;case "\u006fd\u0064\u0050\u0061\u0067\u0065":*_fgaff =5;};};_cggade ,_eababf =d .Token ();if _eababf !=nil {return _eababf ;};if _fccac ,_bfbfe :=_cggade .(_g .EndElement );_bfbfe &&_fccac .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_cggade );};func (_gbeegf ST_TextAlignment )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_cgeece :=_g .Attr {};_cgeece .Name =name ;switch _gbeegf {case ST_TextAlignmentUnset :_cgeece .Value ="";case ST_TextAlignmentTop :_cgeece .Value ="\u0074\u006f\u0070";case ST_TextAlignmentCenter :_cgeece .Value ="\u0063\u0065\u006e\u0074\u0065\u0072";case ST_TextAlignmentBaseline :_cgeece .Value ="\u0062\u0061\u0073\u0065\u006c\u0069\u006e\u0065";case ST_TextAlignmentBottom :_cgeece .Value ="\u0062\u006f\u0074\u0074\u006f\u006d";case ST_TextAlignmentAuto :_cgeece .Value ="\u0061\u0075\u0074\u006f";};return _cgeece ,nil ;};func NewCT_RubyPr ()*CT_RubyPr {_fdbdfa :=&CT_RubyPr {};_fdbdfa .RubyAlign =NewCT_RubyAlign ();_fdbdfa .Hps =NewCT_HpsMeasure ();_fdbdfa .HpsRaise =NewCT_HpsMeasure ();_fdbdfa .HpsBaseText =NewCT_HpsMeasure ();_fdbdfa .Lid =NewCT_Lang ();return _fdbdfa ;};func (_gbddb *WdCT_WordprocessingGroupChoice )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gbddb .Wsp !=nil {_ggdcb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0077\u0073\u0070"}};for _ ,_bdaage :=range _gbddb .Wsp {e .EncodeElement (_bdaage ,_ggdcb );};};if _gbddb .GrpSp !=nil {_gafdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0067\u0072\u0070\u0053\u0070"}};for _ ,_gcbcfa :=range _gbddb .GrpSp {e .EncodeElement (_gcbcfa ,_gafdg );};};if _gbddb .GraphicFrame !=nil {_agbgf :=_g .StartElement {Name :_g .Name {Local :"\u0077p\u003ag\u0072\u0061\u0070\u0068\u0069\u0063\u0046\u0072\u0061\u006d\u0065"}};for _ ,_dcfeee :=range _gbddb .GraphicFrame {e .EncodeElement (_dcfeee ,_agbgf );};};if _gbddb .Pic !=nil {_dgbcec :=_g .StartElement {Name :_g .Name {Local :"\u0070i\u0063\u003a\u0070\u0069\u0063"}};for _ ,_cgcfc :=range _gbddb .Pic {e .EncodeElement (_cgcfc ,_dgbcec );};};if _gbddb .ContentPart !=nil {_eaagc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0063\u006f\u006e\u0074\u0065\u006et\u0050\u0061\u0072\u0074"}};for _ ,_cgfef :=range _gbddb .ContentPart {e .EncodeElement (_cgfef ,_eaagc );};};return nil ;};func (_fffgd ST_ThemeColor )Validate ()error {return _fffgd .ValidateWithPath ("")};
 
 // Validate validates the CT_TcPrBase and its children
 func (_ecaba *CT_TcPrBase )Validate ()error {return _ecaba .ValidateWithPath ("C\u0054\u005f\u0054\u0063\u0050\u0072\u0042\u0061\u0073\u0065");};func NewCT_TrPr ()*CT_TrPr {_edfce :=&CT_TrPr {};return _edfce };func (_ebbf *CT_JcTable )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ebbf .ValAttr =ST_JcTable (1);for _ ,_ebde :=range start .Attr {if _ebde .Name .Local =="\u0076\u0061\u006c"{_ebbf .ValAttr .UnmarshalXMLAttr (_ebde );continue ;};};for {_aegcb ,_bfgda :=d .Token ();if _bfgda !=nil {return _gd .Errorf ("\u0070\u0061\u0072\u0073in\u0067\u0020\u0043\u0054\u005f\u004a\u0063\u0054\u0061\u0062\u006c\u0065\u003a\u0020%\u0073",_bfgda );};if _dbdgg ,_agbbc :=_aegcb .(_g .EndElement );_agbbc &&_dbdgg .Name ==start .Name {break ;};};return nil ;};func (_acedg *CT_FramePr )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _acedg .DropCapAttr !=ST_DropCapUnset {_cbbad ,_cdbcg :=_acedg .DropCapAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0064\u0072\u006f\u0070\u0043\u0061p"});if _cdbcg !=nil {return _cdbcg ;};start .Attr =append (start .Attr ,_cbbad );};if _acedg .LinesAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u006c\u0069\u006e\u0065\u0073"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .LinesAttr )});};if _acedg .WAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0077"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .WAttr )});};if _acedg .HAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0068"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .HAttr )});};if _acedg .VSpaceAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076\u0053\u0070\u0061\u0063\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .VSpaceAttr )});};if _acedg .HSpaceAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0068\u0053\u0070\u0061\u0063\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .HSpaceAttr )});};if _acedg .WrapAttr !=ST_WrapUnset {_fgffg ,_afagd :=_acedg .WrapAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0077\u0072\u0061\u0070"});if _afagd !=nil {return _afagd ;};start .Attr =append (start .Attr ,_fgffg );};if _acedg .HAnchorAttr !=ST_HAnchorUnset {_aaee ,_cdef :=_acedg .HAnchorAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0068\u0041\u006e\u0063\u0068\u006fr"});if _cdef !=nil {return _cdef ;};start .Attr =append (start .Attr ,_aaee );};if _acedg .VAnchorAttr !=ST_VAnchorUnset {_dfgec ,_bbccg :=_acedg .VAnchorAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0076\u0041\u006e\u0063\u0068\u006fr"});if _bbccg !=nil {return _bbccg ;};start .Attr =append (start .Attr ,_dfgec );};if _acedg .XAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0078"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .XAttr )});};if _acedg .XAlignAttr !=_gc .ST_XAlignUnset {_ccgfa ,_fcgcf :=_acedg .XAlignAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0078\u0041\u006c\u0069\u0067\u006e"});if _fcgcf !=nil {return _fcgcf ;};start .Attr =append (start .Attr ,_ccgfa );};if _acedg .YAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0079"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .YAttr )});};if _acedg .YAlignAttr !=_gc .ST_YAlignUnset {_gbfbd ,_gced :=_acedg .YAlignAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0079\u0041\u006c\u0069\u0067\u006e"});if _gced !=nil {return _gced ;};start .Attr =append (start .Attr ,_gbfbd );};if _acedg .HRuleAttr !=ST_HeightRuleUnset {_bfbad ,_deeee :=_acedg .HRuleAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0068\u0052\u0075\u006c\u0065"});if _deeee !=nil {return _deeee ;};start .Attr =append (start .Attr ,_bfbad );};if _acedg .AnchorLockAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061n\u0063\u0068\u006f\u0072\u004c\u006f\u0063\u006b"},Value :_gd .Sprintf ("\u0025\u0076",*_acedg .AnchorLockAttr )});};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
@@ -1230,7 +1235,7 @@ func (_edbe *CT_Attr )Validate ()error {return _edbe .ValidateWithPath ("\u0043T
 func (_fggae *CT_TblLayoutType )Validate ()error {return _fggae .ValidateWithPath ("\u0043\u0054_\u0054\u0062\u006cL\u0061\u0079\u006f\u0075\u0074\u0054\u0079\u0070\u0065");};func NewCT_FitText ()*CT_FitText {_eggac :=&CT_FitText {};return _eggac };func (_bbdeg *ST_TblWidth )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ggcag ,_fbebe :=d .Token ();if _fbebe !=nil {return _fbebe ;};if _gcgab ,_bedfd :=_ggcag .(_g .EndElement );_bedfd &&_gcgab .Name ==start .Name {*_bbdeg =1;return nil ;};if _ddddbc ,_bdgggf :=_ggcag .(_g .CharData );!_bdgggf {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_ggcag );}else {switch string (_ddddbc ){case "":*_bbdeg =0;case "\u006e\u0069\u006c":*_bbdeg =1;case "\u0070\u0063\u0074":*_bbdeg =2;case "\u0064\u0078\u0061":*_bbdeg =3;case "\u0061\u0075\u0074\u006f":*_bbdeg =4;};};_ggcag ,_fbebe =d .Token ();if _fbebe !=nil {return _fbebe ;};if _adcda ,_abecc :=_ggcag .(_g .EndElement );_abecc &&_adcda .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_ggcag );};func NewCT_R ()*CT_R {_eccbc :=&CT_R {};return _eccbc };func NewCT_DocPartBehaviors ()*CT_DocPartBehaviors {_fagbdd :=&CT_DocPartBehaviors {};return _fagbdd };func (_aebdd *EG_BlockLevelElts )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ddacfa :for {_bbbge ,_eaaea :=d .Token ();if _eaaea !=nil {return _eaaea ;};switch _geacb :=_bbbge .(type ){case _g .StartElement :switch _geacb .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u006c\u0074\u0043\u0068\u0075\u006e\u006b"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u006c\u0074\u0043\u0068\u0075\u006e\u006b"}:_bfcg :=NewCT_AltChunk ();if _feegaf :=d .DecodeElement (_bfcg ,&_geacb );_feegaf !=nil {return _feegaf ;};_aebdd .AltChunk =append (_aebdd .AltChunk ,_bfcg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_gfgdg :=NewEG_ContentBlockContent ();_gfgdg .CustomXml =NewCT_CustomXmlBlock ();if _dgbdg :=d .DecodeElement (_gfgdg .CustomXml ,&_geacb );_dgbdg !=nil {return _dgbdg ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_gfgdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_fecgb :=NewEG_ContentBlockContent ();_fecgb .Sdt =NewCT_SdtBlock ();if _gcbag :=d .DecodeElement (_fecgb .Sdt ,&_geacb );_gcbag !=nil {return _gcbag ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_fecgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070"}:_cfcdad :=NewEG_ContentBlockContent ();_bacab :=NewCT_P ();if _bgfgg :=d .DecodeElement (_bacab ,&_geacb );_bgfgg !=nil {return _bgfgg ;};_cfcdad .P =append (_cfcdad .P ,_bacab );_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_cfcdad );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0062\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0062\u006c"}:_fegfc :=NewEG_ContentBlockContent ();_dadgd :=NewCT_Tbl ();if _ddebef :=d .DecodeElement (_dadgd ,&_geacb );_ddebef !=nil {return _ddebef ;};_fegfc .Tbl =append (_fegfc .Tbl ,_dadgd );_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_fegfc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_bdecad :=NewEG_ContentBlockContent ();_egdgg :=NewEG_RunLevelElts ();_egdgg .ProofErr =NewCT_ProofErr ();if _egecc :=d .DecodeElement (_egdgg .ProofErr ,&_geacb );_egecc !=nil {return _egecc ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_bdecad );_bdecad .EG_RunLevelElts =append (_bdecad .EG_RunLevelElts ,_egdgg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_effffc :=NewEG_ContentBlockContent ();_fdeeg :=NewEG_RunLevelElts ();_fdeeg .PermStart =NewCT_PermStart ();if _dbeaaf :=d .DecodeElement (_fdeeg .PermStart ,&_geacb );_dbeaaf !=nil {return _dbeaaf ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_effffc );_effffc .EG_RunLevelElts =append (_effffc .EG_RunLevelElts ,_fdeeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_edgbe :=NewEG_ContentBlockContent ();_dggae :=NewEG_RunLevelElts ();_dggae .PermEnd =NewCT_Perm ();if _gaffe :=d .DecodeElement (_dggae .PermEnd ,&_geacb );_gaffe !=nil {return _gaffe ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_edgbe );_edgbe .EG_RunLevelElts =append (_edgbe .EG_RunLevelElts ,_dggae );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_fgbef :=NewEG_ContentBlockContent ();_beagf :=NewEG_RunLevelElts ();_beagf .Ins =NewCT_RunTrackChange ();if _bbeeff :=d .DecodeElement (_beagf .Ins ,&_geacb );_bbeeff !=nil {return _bbeeff ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_fgbef );_fgbef .EG_RunLevelElts =append (_fgbef .EG_RunLevelElts ,_beagf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_fcagdg :=NewEG_ContentBlockContent ();_fecgeg :=NewEG_RunLevelElts ();_fecgeg .Del =NewCT_RunTrackChange ();if _afdeb :=d .DecodeElement (_fecgeg .Del ,&_geacb );_afdeb !=nil {return _afdeb ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_fcagdg );_fcagdg .EG_RunLevelElts =append (_fcagdg .EG_RunLevelElts ,_fecgeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_gaeeb :=NewEG_ContentBlockContent ();_ecadb :=NewEG_RunLevelElts ();_ecadb .MoveFrom =NewCT_RunTrackChange ();if _cgffd :=d .DecodeElement (_ecadb .MoveFrom ,&_geacb );_cgffd !=nil {return _cgffd ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_gaeeb );_gaeeb .EG_RunLevelElts =append (_gaeeb .EG_RunLevelElts ,_ecadb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_fedca :=NewEG_ContentBlockContent ();_bdaefe :=NewEG_RunLevelElts ();_bdaefe .MoveTo =NewCT_RunTrackChange ();if _cfcgef :=d .DecodeElement (_bdaefe .MoveTo ,&_geacb );_cfcgef !=nil {return _cfcgef ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_fedca );_fedca .EG_RunLevelElts =append (_fedca .EG_RunLevelElts ,_bdaefe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_gfebb :=NewEG_ContentBlockContent ();_egcfef :=NewEG_RunLevelElts ();_abecdd :=NewEG_RangeMarkupElements ();_abecdd .BookmarkStart =NewCT_Bookmark ();if _edecec :=d .DecodeElement (_abecdd .BookmarkStart ,&_geacb );_edecec !=nil {return _edecec ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_gfebb );_gfebb .EG_RunLevelElts =append (_gfebb .EG_RunLevelElts ,_egcfef );_egcfef .EG_RangeMarkupElements =append (_egcfef .EG_RangeMarkupElements ,_abecdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_gabgc :=NewEG_ContentBlockContent ();_fdcbdc :=NewEG_RunLevelElts ();_gfcgc :=NewEG_RangeMarkupElements ();_gfcgc .BookmarkEnd =NewCT_MarkupRange ();if _dfcec :=d .DecodeElement (_gfcgc .BookmarkEnd ,&_geacb );_dfcec !=nil {return _dfcec ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_gabgc );_gabgc .EG_RunLevelElts =append (_gabgc .EG_RunLevelElts ,_fdcbdc );_fdcbdc .EG_RangeMarkupElements =append (_fdcbdc .EG_RangeMarkupElements ,_gfcgc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_dcfde :=NewEG_ContentBlockContent ();_eebff :=NewEG_RunLevelElts ();_gadfbc :=NewEG_RangeMarkupElements ();_gadfbc .MoveFromRangeStart =NewCT_MoveBookmark ();if _bdcadd :=d .DecodeElement (_gadfbc .MoveFromRangeStart ,&_geacb );_bdcadd !=nil {return _bdcadd ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_dcfde );_dcfde .EG_RunLevelElts =append (_dcfde .EG_RunLevelElts ,_eebff );_eebff .EG_RangeMarkupElements =append (_eebff .EG_RangeMarkupElements ,_gadfbc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_dbddbe :=NewEG_ContentBlockContent ();_ccadb :=NewEG_RunLevelElts ();_ggcbe :=NewEG_RangeMarkupElements ();_ggcbe .MoveFromRangeEnd =NewCT_MarkupRange ();if _cgfdc :=d .DecodeElement (_ggcbe .MoveFromRangeEnd ,&_geacb );_cgfdc !=nil {return _cgfdc ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_dbddbe );_dbddbe .EG_RunLevelElts =append (_dbddbe .EG_RunLevelElts ,_ccadb );_ccadb .EG_RangeMarkupElements =append (_ccadb .EG_RangeMarkupElements ,_ggcbe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_baedg :=NewEG_ContentBlockContent ();_fbabc :=NewEG_RunLevelElts ();_bbgbab :=NewEG_RangeMarkupElements ();_bbgbab .MoveToRangeStart =NewCT_MoveBookmark ();if _aeefgb :=d .DecodeElement (_bbgbab .MoveToRangeStart ,&_geacb );_aeefgb !=nil {return _aeefgb ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_baedg );_baedg .EG_RunLevelElts =append (_baedg .EG_RunLevelElts ,_fbabc );_fbabc .EG_RangeMarkupElements =append (_fbabc .EG_RangeMarkupElements ,_bbgbab );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_fbgbd :=NewEG_ContentBlockContent ();_acbaa :=NewEG_RunLevelElts ();_daefcc :=NewEG_RangeMarkupElements ();_daefcc .MoveToRangeEnd =NewCT_MarkupRange ();if _fgfeef :=d .DecodeElement (_daefcc .MoveToRangeEnd ,&_geacb );_fgfeef !=nil {return _fgfeef ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_fbgbd );_fbgbd .EG_RunLevelElts =append (_fbgbd .EG_RunLevelElts ,_acbaa );_acbaa .EG_RangeMarkupElements =append (_acbaa .EG_RangeMarkupElements ,_daefcc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_cfdea :=NewEG_ContentBlockContent ();_dgfef :=NewEG_RunLevelElts ();_cadeb :=NewEG_RangeMarkupElements ();_cadeb .CommentRangeStart =NewCT_MarkupRange ();if _ecdea :=d .DecodeElement (_cadeb .CommentRangeStart ,&_geacb );_ecdea !=nil {return _ecdea ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_cfdea );_cfdea .EG_RunLevelElts =append (_cfdea .EG_RunLevelElts ,_dgfef );_dgfef .EG_RangeMarkupElements =append (_dgfef .EG_RangeMarkupElements ,_cadeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_gegefe :=NewEG_ContentBlockContent ();_gdbab :=NewEG_RunLevelElts ();_bgbcad :=NewEG_RangeMarkupElements ();_bgbcad .CommentRangeEnd =NewCT_MarkupRange ();if _adgce :=d .DecodeElement (_bgbcad .CommentRangeEnd ,&_geacb );_adgce !=nil {return _adgce ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_gegefe );_gegefe .EG_RunLevelElts =append (_gegefe .EG_RunLevelElts ,_gdbab );_gdbab .EG_RangeMarkupElements =append (_gdbab .EG_RangeMarkupElements ,_bgbcad );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_ggabb :=NewEG_ContentBlockContent ();_aadaf :=NewEG_RunLevelElts ();_bagea :=NewEG_RangeMarkupElements ();_bagea .CustomXmlInsRangeStart =NewCT_TrackChange ();if _dbddc :=d .DecodeElement (_bagea .CustomXmlInsRangeStart ,&_geacb );_dbddc !=nil {return _dbddc ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_ggabb );_ggabb .EG_RunLevelElts =append (_ggabb .EG_RunLevelElts ,_aadaf );_aadaf .EG_RangeMarkupElements =append (_aadaf .EG_RangeMarkupElements ,_bagea );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_dcffe :=NewEG_ContentBlockContent ();_ecbcb :=NewEG_RunLevelElts ();_feaggb :=NewEG_RangeMarkupElements ();_feaggb .CustomXmlInsRangeEnd =NewCT_Markup ();if _abbcd :=d .DecodeElement (_feaggb .CustomXmlInsRangeEnd ,&_geacb );_abbcd !=nil {return _abbcd ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_dcffe );_dcffe .EG_RunLevelElts =append (_dcffe .EG_RunLevelElts ,_ecbcb );_ecbcb .EG_RangeMarkupElements =append (_ecbcb .EG_RangeMarkupElements ,_feaggb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_abeed :=NewEG_ContentBlockContent ();_cbdcd :=NewEG_RunLevelElts ();_edage :=NewEG_RangeMarkupElements ();_edage .CustomXmlDelRangeStart =NewCT_TrackChange ();if _ffdba :=d .DecodeElement (_edage .CustomXmlDelRangeStart ,&_geacb );_ffdba !=nil {return _ffdba ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_abeed );_abeed .EG_RunLevelElts =append (_abeed .EG_RunLevelElts ,_cbdcd );_cbdcd .EG_RangeMarkupElements =append (_cbdcd .EG_RangeMarkupElements ,_edage );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_dcbdgb :=NewEG_ContentBlockContent ();_acbedgf :=NewEG_RunLevelElts ();_ggbdd :=NewEG_RangeMarkupElements ();_ggbdd .CustomXmlDelRangeEnd =NewCT_Markup ();if _cfbbbe :=d .DecodeElement (_ggbdd .CustomXmlDelRangeEnd ,&_geacb );_cfbbbe !=nil {return _cfbbbe ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_dcbdgb );_dcbdgb .EG_RunLevelElts =append (_dcbdgb .EG_RunLevelElts ,_acbedgf );_acbedgf .EG_RangeMarkupElements =append (_acbedgf .EG_RangeMarkupElements ,_ggbdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_ebggg :=NewEG_ContentBlockContent ();_cgbfd :=NewEG_RunLevelElts ();_geaba :=NewEG_RangeMarkupElements ();_geaba .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _bbeea :=d .DecodeElement (_geaba .CustomXmlMoveFromRangeStart ,&_geacb );_bbeea !=nil {return _bbeea ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_ebggg );_ebggg .EG_RunLevelElts =append (_ebggg .EG_RunLevelElts ,_cgbfd );_cgbfd .EG_RangeMarkupElements =append (_cgbfd .EG_RangeMarkupElements ,_geaba );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_cdcdfc :=NewEG_ContentBlockContent ();_aebddc :=NewEG_RunLevelElts ();_bbbdf :=NewEG_RangeMarkupElements ();_bbbdf .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _cafdb :=d .DecodeElement (_bbbdf .CustomXmlMoveFromRangeEnd ,&_geacb );_cafdb !=nil {return _cafdb ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_cdcdfc );_cdcdfc .EG_RunLevelElts =append (_cdcdfc .EG_RunLevelElts ,_aebddc );_aebddc .EG_RangeMarkupElements =append (_aebddc .EG_RangeMarkupElements ,_bbbdf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_agdba :=NewEG_ContentBlockContent ();_dgfeee :=NewEG_RunLevelElts ();_ecadf :=NewEG_RangeMarkupElements ();_ecadf .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _agdfda :=d .DecodeElement (_ecadf .CustomXmlMoveToRangeStart ,&_geacb );_agdfda !=nil {return _agdfda ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_agdba );_agdba .EG_RunLevelElts =append (_agdba .EG_RunLevelElts ,_dgfeee );_dgfeee .EG_RangeMarkupElements =append (_dgfeee .EG_RangeMarkupElements ,_ecadf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_dgcfe :=NewEG_ContentBlockContent ();_fbabcf :=NewEG_RunLevelElts ();_gaede :=NewEG_RangeMarkupElements ();_gaede .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _ceeac :=d .DecodeElement (_gaede .CustomXmlMoveToRangeEnd ,&_geacb );_ceeac !=nil {return _ceeac ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_dgcfe );_dgcfe .EG_RunLevelElts =append (_dgcfe .EG_RunLevelElts ,_fbabcf );_fbabcf .EG_RangeMarkupElements =append (_fbabcf .EG_RangeMarkupElements ,_gaede );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_ebefdc :=NewEG_ContentBlockContent ();_dabbe :=NewEG_RunLevelElts ();_egbdca :=NewEG_MathContent ();_egbdca .OMathPara =_ec .NewOMathPara ();if _aebfec :=d .DecodeElement (_egbdca .OMathPara ,&_geacb );_aebfec !=nil {return _aebfec ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_ebefdc );_ebefdc .EG_RunLevelElts =append (_ebefdc .EG_RunLevelElts ,_dabbe );_dabbe .EG_MathContent =append (_dabbe .EG_MathContent ,_egbdca );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_eegbb :=NewEG_ContentBlockContent ();_aeggf :=NewEG_RunLevelElts ();_cfdge :=NewEG_MathContent ();_cfdge .OMath =_ec .NewOMath ();if _cfbba :=d .DecodeElement (_cfdge .OMath ,&_geacb );_cfbba !=nil {return _cfbba ;};_aebdd .EG_ContentBlockContent =append (_aebdd .EG_ContentBlockContent ,_eegbb );_eegbb .EG_RunLevelElts =append (_eegbb .EG_RunLevelElts ,_aeggf );_aeggf .EG_MathContent =append (_aeggf .EG_MathContent ,_cfdge );default:_ga .Log ("\u0073\u006bi\u0070\u0070\u0069\u006e\u0067 \u0075\u006e\u0073\u0075\u0070p\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0045\u0047\u005f\u0042\u006c\u006f\u0063\u006b\u004c\u0065\u0076\u0065\u006c\u0045\u006c\u0074\u0073\u0020\u0025\u0076",_geacb .Name );if _dcaac :=d .Skip ();_dcaac !=nil {return _dcaac ;};};case _g .EndElement :break _ddacfa ;case _g .CharData :};};return nil ;};const (ST_SectionMarkUnset ST_SectionMark =0;ST_SectionMarkNextPage ST_SectionMark =1;ST_SectionMarkNextColumn ST_SectionMark =2;ST_SectionMarkContinuous ST_SectionMark =3;ST_SectionMarkEvenPage ST_SectionMark =4;ST_SectionMarkOddPage ST_SectionMark =5;);
 
 // Validate validates the CT_Picture and its children
-func (_fgbcb *CT_Picture )Validate ()error {return _fgbcb .ValidateWithPath ("\u0043\u0054\u005f\u0050\u0069\u0063\u0074\u0075\u0072\u0065");};func (_ecgg *CT_Comment )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ecgg .InitialsAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u006e\u0069\u0074\u0069\u0061\u006c\u0073"},Value :_gd .Sprintf ("\u0025\u0076",*_ecgg .InitialsAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_ecgg .AuthorAttr )});if _ecgg .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_ecgg .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_ecgg .IdAttr )});e .EncodeToken (start );if _ecgg .EG_BlockLevelElts !=nil {for _ ,_caef :=range _ecgg .EG_BlockLevelElts {_caef .MarshalXML (e ,_g .StartElement {});};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+func (_fgbcb *CT_Picture )Validate ()error {return _fgbcb .ValidateWithPath ("\u0043\u0054\u005f\u0050\u0069\u0063\u0074\u0075\u0072\u0065");};func (_ecgg *CT_Comment )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ecgg .InitialsAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u006e\u0069\u0074\u0069\u0061\u006c\u0073"},Value :_gd .Sprintf ("\u0025\u0076",*_ecgg .InitialsAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_ecgg .AuthorAttr )});if _ecgg .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_ecgg .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_ecgg .IdAttr )});e .EncodeToken (start );if _ecgg .EG_BlockLevelElts !=nil {for _ ,_caef :=range _ecgg .EG_BlockLevelElts {_caef .MarshalXML (e ,_g .StartElement {});};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // Validate validates the WdEG_WrapTypeChoice and its children
 func (_gecacc *WdEG_WrapTypeChoice )Validate ()error {return _gecacc .ValidateWithPath ("\u0057\u0064\u0045\u0047_W\u0072\u0061\u0070\u0054\u0079\u0070\u0065\u0043\u0068\u006f\u0069\u0063\u0065");};func (_eadcc *CT_SdtContentCell )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _eadcc .Tc !=nil {_decde :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0063"}};for _ ,_aebbf :=range _eadcc .Tc {e .EncodeElement (_aebbf ,_decde );};};if _eadcc .CustomXml !=nil {_bfgeb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0063\u0075\u0073\u0074\u006f\u006d\u0058\u006d\u006c"}};e .EncodeElement (_eadcc .CustomXml ,_bfgeb );};if _eadcc .Sdt !=nil {_ceecf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073d\u0074"}};e .EncodeElement (_eadcc .Sdt ,_ceecf );};if _eadcc .EG_RunLevelElts !=nil {for _ ,_deege :=range _eadcc .EG_RunLevelElts {_deege .MarshalXML (e ,_g .StartElement {});};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fgffb *EG_RPrMath )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_geddge :for {_fcdag ,_ggaee :=d .Token ();if _ggaee !=nil {return _ggaee ;};switch _dbcec :=_fcdag .(type ){case _g .StartElement :switch _dbcec .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_fgffb .Ins =NewCT_MathCtrlIns ();if _geffe :=d .DecodeElement (_fgffb .Ins ,&_dbcec );_geffe !=nil {return _geffe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_fgffb .Del =NewCT_MathCtrlDel ();if _abcdbe :=d .DecodeElement (_fgffb .Del ,&_dbcec );_abcdbe !=nil {return _abcdbe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0050\u0072"}:_fgffb .RPr =NewCT_RPr ();if _gdgggf :=d .DecodeElement (_fgffb .RPr ,&_dbcec );_gdgggf !=nil {return _gdgggf ;};default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067 \u0075\u006e\u0073\u0075\u0070\u0070\u006fr\u0074\u0065\u0064\u0020\u0065\u006ce\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0045\u0047\u005fR\u0050\u0072\u004d\u0061\u0074\u0068\u0020\u0025\u0076",_dbcec .Name );if _gfeaa :=d .Skip ();_gfeaa !=nil {return _gfeaa ;};};case _g .EndElement :break _geddge ;case _g .CharData :};};return nil ;};func (_dacgg *CT_Headers )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ccbeb :for {_egdbe ,_gebc :=d .Token ();if _gebc !=nil {return _gebc ;};switch _gcfaa :=_egdbe .(type ){case _g .StartElement :switch _gcfaa .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068\u0065\u0061\u0064\u0065\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068\u0065\u0061\u0064\u0065\u0072"}:_fecgf :=NewCT_String ();if _aeece :=d .DecodeElement (_fecgf ,&_gcfaa );_aeece !=nil {return _aeece ;};_dacgg .Header =append (_dacgg .Header ,_fecgf );default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067 \u0075\u006e\u0073\u0075\u0070\u0070\u006fr\u0074\u0065\u0064\u0020\u0065\u006ce\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005fH\u0065\u0061\u0064\u0065\u0072\u0073\u0020\u0025\u0076",_gcfaa .Name );if _dacfb :=d .Skip ();_dacfb !=nil {return _dacfb ;};};case _g .EndElement :break _ccbeb ;case _g .CharData :};};return nil ;};
@@ -1416,7 +1421,7 @@ func (_cabfc *CT_TrPr )Validate ()error {return _cabfc .ValidateWithPath ("\u004
 func (_dcacf *CT_MailMergeDocType )ValidateWithPath (path string )error {if _dcacf .ValAttr ==ST_MailMergeDocTypeUnset {return _gd .Errorf ("\u0025\u0073\u002fV\u0061\u006c\u0041\u0074t\u0072\u0020\u0069\u0073\u0020\u0061\u0020m\u0061\u006e\u0064\u0061\u0074\u006f\u0072\u0079\u0020\u0066\u0069\u0065\u006c\u0064",path );};if _fbdg :=_dcacf .ValAttr .ValidateWithPath (path +"\u002f\u0056\u0061\u006c\u0041\u0074\u0074\u0072");_fbdg !=nil {return _fbdg ;};return nil ;};func (_gbeeg *CT_FontsList )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bfeb :for {_fabbb ,_dccbc :=d .Token ();if _dccbc !=nil {return _dccbc ;};switch _acgee :=_fabbb .(type ){case _g .StartElement :switch _acgee .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066\u006f\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066\u006f\u006e\u0074"}:_dfbbd :=NewCT_Font ();if _dedfe :=d .DecodeElement (_dfbbd ,&_acgee );_dedfe !=nil {return _dedfe ;};_gbeeg .Font =append (_gbeeg .Font ,_dfbbd );default:_ga .Log ("s\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075n\u0073\u0075\u0070\u0070\u006f\u0072\u0074ed\u0020\u0065\u006c\u0065m\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054_F\u006f\u006et\u0073\u004c\u0069\u0073\u0074\u0020\u0025\u0076",_acgee .Name );if _adcbe :=d .Skip ();_adcbe !=nil {return _adcbe ;};};case _g .EndElement :break _bfeb ;case _g .CharData :};};return nil ;};func (_bdcafc ST_ChapterSep )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_cedfc :=_g .Attr {};_cedfc .Name =name ;switch _bdcafc {case ST_ChapterSepUnset :_cedfc .Value ="";case ST_ChapterSepHyphen :_cedfc .Value ="\u0068\u0079\u0070\u0068\u0065\u006e";case ST_ChapterSepPeriod :_cedfc .Value ="\u0070\u0065\u0072\u0069\u006f\u0064";case ST_ChapterSepColon :_cedfc .Value ="\u0063\u006f\u006co\u006e";case ST_ChapterSepEmDash :_cedfc .Value ="\u0065\u006d\u0044\u0061\u0073\u0068";case ST_ChapterSepEnDash :_cedfc .Value ="\u0065\u006e\u0044\u0061\u0073\u0068";};return _cedfc ,nil ;};type CT_SectType struct{
 
 // Section Type Setting
-ValAttr ST_SectionMark ;};func (_eeadd *CT_LineNumber )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _eeadd .CountByAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0063\u006f\u0075\u006e\u0074\u0042y"},Value :_gd .Sprintf ("\u0025\u0076",*_eeadd .CountByAttr )});};if _eeadd .StartAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0073\u0074\u0061\u0072\u0074"},Value :_gd .Sprintf ("\u0025\u0076",*_eeadd .StartAttr )});};if _eeadd .DistanceAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0069\u0073\u0074\u0061\u006e\u0063\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_eeadd .DistanceAttr )});};if _eeadd .RestartAttr !=ST_LineNumberRestartUnset {_gfead ,_fbacc :=_eeadd .RestartAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0072\u0065\u0073\u0074\u0061\u0072t"});if _fbacc !=nil {return _fbacc ;};start .Attr =append (start .Attr ,_gfead );};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_Pitch ()*CT_Pitch {_bbbfg :=&CT_Pitch {};_bbbfg .ValAttr =ST_Pitch (1);return _bbbfg };func (_baddf *CT_Empty )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for {_cgged ,_bdcbd :=d .Token ();if _bdcbd !=nil {return _gd .Errorf ("p\u0061r\u0073\u0069\u006e\u0067\u0020\u0043\u0054\u005fE\u006d\u0070\u0074\u0079: \u0025\u0073",_bdcbd );};if _faeed ,_cdccb :=_cgged .(_g .EndElement );_cdccb &&_faeed .Name ==start .Name {break ;};};return nil ;};func (_gbcg *CT_CellMergeTrackChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gbcg .VMergeAttr !=ST_AnnotationVMergeUnset {_fafed ,_gcda :=_gbcg .VMergeAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076\u004d\u0065\u0072\u0067\u0065"});if _gcda !=nil {return _gcda ;};start .Attr =append (start .Attr ,_fafed );};if _gbcg .VMergeOrigAttr !=ST_AnnotationVMergeUnset {_aedg ,_gbgf :=_gbcg .VMergeOrigAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076M\u0065\u0072\u0067\u0065\u004f\u0072\u0069\u0067"});if _gbgf !=nil {return _gbgf ;};start .Attr =append (start .Attr ,_aedg );};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gbcg .AuthorAttr )});if _gbcg .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_gbcg .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gbcg .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_dcage *CT_SdtRow )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_aacfc :for {_bdedf ,_egeafa :=d .Token ();if _egeafa !=nil {return _egeafa ;};switch _fcfda :=_bdedf .(type ){case _g .StartElement :switch _fcfda .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074P\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074P\u0072"}:_dcage .SdtPr =NewCT_SdtPr ();if _efefbf :=d .DecodeElement (_dcage .SdtPr ,&_fcfda );_efefbf !=nil {return _efefbf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074\u0045\u006e\u0064\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074\u0045\u006e\u0064\u0050\u0072"}:_dcage .SdtEndPr =NewCT_SdtEndPr ();if _abagfd :=d .DecodeElement (_dcage .SdtEndPr ,&_fcfda );_abagfd !=nil {return _abagfd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074"}:_dcage .SdtContent =NewCT_SdtContentRow ();if _geabf :=d .DecodeElement (_dcage .SdtContent ,&_fcfda );_geabf !=nil {return _geabf ;};default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067\u0020u\u006e\u0073\u0075p\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006cem\u0065\u006e\u0074 \u006f\u006e \u0043\u0054\u005f\u0053\u0064\u0074R\u006f\u0077 \u0025\u0076",_fcfda .Name );if _fdgeg :=d .Skip ();_fdgeg !=nil {return _fdgeg ;};};case _g .EndElement :break _aacfc ;case _g .CharData :};};return nil ;};func (_bagfd *WdCT_GraphicFrame )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bagfd .CNvPr =_e .NewCT_NonVisualDrawingProps ();_bagfd .CNvFrPr =_e .NewCT_NonVisualGraphicFrameProperties ();_bagfd .Xfrm =_e .NewCT_Transform2D ();_bagfd .Graphic =_e .NewGraphic ();_aeded :for {_fefdgf ,_dgeda :=d .Token ();if _dgeda !=nil {return _dgeda ;};switch _dcdede :=_fefdgf .(type ){case _g .StartElement :switch _dcdede .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063\u004e\u0076P\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063\u004e\u0076P\u0072"}:if _bgcfe :=d .DecodeElement (_bagfd .CNvPr ,&_dcdede );_bgcfe !=nil {return _bgcfe ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063N\u0076\u0046\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063N\u0076\u0046\u0072\u0050\u0072"}:if _bcebbb :=d .DecodeElement (_bagfd .CNvFrPr ,&_dcdede );_bcebbb !=nil {return _bcebbb ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0078\u0066\u0072\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0078\u0066\u0072\u006d"}:if _effec :=d .DecodeElement (_bagfd .Xfrm ,&_dcdede );_effec !=nil {return _effec ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0067r\u0061\u0070\u0068\u0069\u0063"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072g\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u006d\u0061\u0069\u006e",Local :"\u0067r\u0061\u0070\u0068\u0069\u0063"}:if _bfeac :=d .DecodeElement (_bagfd .Graphic ,&_dcdede );_bfeac !=nil {return _bfeac ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"}:_bagfd .ExtLst =_e .NewCT_OfficeArtExtensionList ();if _cggef :=d .DecodeElement (_bagfd .ExtLst ,&_dcdede );_cggef !=nil {return _cggef ;};default:_ga .Log ("\u0073\u006bi\u0070\u0070\u0069\u006e\u0067 \u0075\u006e\u0073\u0075\u0070p\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0057\u0064\u0043\u0054\u005f\u0047\u0072\u0061\u0070\u0068\u0069\u0063\u0046\u0072\u0061\u006d\u0065\u0020\u0025\u0076",_dcdede .Name );if _bgfbc :=d .Skip ();_bgfbc !=nil {return _bgfbc ;};};case _g .EndElement :break _aeded ;case _g .CharData :};};return nil ;};
+ValAttr ST_SectionMark ;};func (_eeadd *CT_LineNumber )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _eeadd .CountByAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0063\u006f\u0075\u006e\u0074\u0042y"},Value :_gd .Sprintf ("\u0025\u0076",*_eeadd .CountByAttr )});};if _eeadd .StartAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0073\u0074\u0061\u0072\u0074"},Value :_gd .Sprintf ("\u0025\u0076",*_eeadd .StartAttr )});};if _eeadd .DistanceAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0069\u0073\u0074\u0061\u006e\u0063\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_eeadd .DistanceAttr )});};if _eeadd .RestartAttr !=ST_LineNumberRestartUnset {_gfead ,_fbacc :=_eeadd .RestartAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0072\u0065\u0073\u0074\u0061\u0072t"});if _fbacc !=nil {return _fbacc ;};start .Attr =append (start .Attr ,_gfead );};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_Pitch ()*CT_Pitch {_bbbfg :=&CT_Pitch {};_bbbfg .ValAttr =ST_Pitch (1);return _bbbfg };func (_baddf *CT_Empty )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for {_cgged ,_bdcbd :=d .Token ();if _bdcbd !=nil {return _gd .Errorf ("p\u0061r\u0073\u0069\u006e\u0067\u0020\u0043\u0054\u005fE\u006d\u0070\u0074\u0079: \u0025\u0073",_bdcbd );};if _faeed ,_cdccb :=_cgged .(_g .EndElement );_cdccb &&_faeed .Name ==start .Name {break ;};};return nil ;};func (_gbcg *CT_CellMergeTrackChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gbcg .VMergeAttr !=ST_AnnotationVMergeUnset {_fafed ,_gcda :=_gbcg .VMergeAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076\u004d\u0065\u0072\u0067\u0065"});if _gcda !=nil {return _gcda ;};start .Attr =append (start .Attr ,_fafed );};if _gbcg .VMergeOrigAttr !=ST_AnnotationVMergeUnset {_aedg ,_gbgf :=_gbcg .VMergeOrigAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076M\u0065\u0072\u0067\u0065\u004f\u0072\u0069\u0067"});if _gbgf !=nil {return _gbgf ;};start .Attr =append (start .Attr ,_aedg );};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gbcg .AuthorAttr )});if _gbcg .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gbcg .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gbcg .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_dcage *CT_SdtRow )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_aacfc :for {_bdedf ,_egeafa :=d .Token ();if _egeafa !=nil {return _egeafa ;};switch _fcfda :=_bdedf .(type ){case _g .StartElement :switch _fcfda .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074P\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074P\u0072"}:_dcage .SdtPr =NewCT_SdtPr ();if _efefbf :=d .DecodeElement (_dcage .SdtPr ,&_fcfda );_efefbf !=nil {return _efefbf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074\u0045\u006e\u0064\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074\u0045\u006e\u0064\u0050\u0072"}:_dcage .SdtEndPr =NewCT_SdtEndPr ();if _abagfd :=d .DecodeElement (_dcage .SdtEndPr ,&_fcfda );_abagfd !=nil {return _abagfd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074"}:_dcage .SdtContent =NewCT_SdtContentRow ();if _geabf :=d .DecodeElement (_dcage .SdtContent ,&_fcfda );_geabf !=nil {return _geabf ;};default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067\u0020u\u006e\u0073\u0075p\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006cem\u0065\u006e\u0074 \u006f\u006e \u0043\u0054\u005f\u0053\u0064\u0074R\u006f\u0077 \u0025\u0076",_fcfda .Name );if _fdgeg :=d .Skip ();_fdgeg !=nil {return _fdgeg ;};};case _g .EndElement :break _aacfc ;case _g .CharData :};};return nil ;};func (_bagfd *WdCT_GraphicFrame )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bagfd .CNvPr =_e .NewCT_NonVisualDrawingProps ();_bagfd .CNvFrPr =_e .NewCT_NonVisualGraphicFrameProperties ();_bagfd .Xfrm =_e .NewCT_Transform2D ();_bagfd .Graphic =_e .NewGraphic ();_aeded :for {_fefdgf ,_dgeda :=d .Token ();if _dgeda !=nil {return _dgeda ;};switch _dcdede :=_fefdgf .(type ){case _g .StartElement :switch _dcdede .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063\u004e\u0076P\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063\u004e\u0076P\u0072"}:if _bgcfe :=d .DecodeElement (_bagfd .CNvPr ,&_dcdede );_bgcfe !=nil {return _bgcfe ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063N\u0076\u0046\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0063N\u0076\u0046\u0072\u0050\u0072"}:if _bcebbb :=d .DecodeElement (_bagfd .CNvFrPr ,&_dcdede );_bcebbb !=nil {return _bcebbb ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0078\u0066\u0072\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0078\u0066\u0072\u006d"}:if _effec :=d .DecodeElement (_bagfd .Xfrm ,&_dcdede );_effec !=nil {return _effec ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0067r\u0061\u0070\u0068\u0069\u0063"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072g\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u006d\u0061\u0069\u006e",Local :"\u0067r\u0061\u0070\u0068\u0069\u0063"}:if _bfeac :=d .DecodeElement (_bagfd .Graphic ,&_dcdede );_bfeac !=nil {return _bfeac ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"}:_bagfd .ExtLst =_e .NewCT_OfficeArtExtensionList ();if _cggef :=d .DecodeElement (_bagfd .ExtLst ,&_dcdede );_cggef !=nil {return _cggef ;};default:_ga .Log ("\u0073\u006bi\u0070\u0070\u0069\u006e\u0067 \u0075\u006e\u0073\u0075\u0070p\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0057\u0064\u0043\u0054\u005f\u0047\u0072\u0061\u0070\u0068\u0069\u0063\u0046\u0072\u0061\u006d\u0065\u0020\u0025\u0076",_dcdede .Name );if _bgfbc :=d .Skip ();_bgfbc !=nil {return _bgfbc ;};};case _g .EndElement :break _aeded ;case _g .CharData :};};return nil ;};
 
 // Validate validates the CT_RubyPr and its children
 func (_daefb *CT_RubyPr )Validate ()error {return _daefb .ValidateWithPath ("\u0043T\u005f\u0052\u0075\u0062\u0079\u0050r");};func (_adbeb ST_MultiLevelType )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_acdadc :=_g .Attr {};_acdadc .Name =name ;switch _adbeb {case ST_MultiLevelTypeUnset :_acdadc .Value ="";case ST_MultiLevelTypeSingleLevel :_acdadc .Value ="s\u0069\u006e\u0067\u006c\u0065\u004c\u0065\u0076\u0065\u006c";case ST_MultiLevelTypeMultilevel :_acdadc .Value ="\u006d\u0075\u006c\u0074\u0069\u006c\u0065\u0076\u0065\u006c";case ST_MultiLevelTypeHybridMultilevel :_acdadc .Value ="\u0068\u0079b\u0072\u0069\u0064M\u0075\u006c\u0074\u0069\u006c\u0065\u0076\u0065\u006c";};return _acdadc ,nil ;};func (_dfbdf *CT_StyleSort )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_cdaga ,_cdacg :=_dfbdf .ValAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076a\u006c"});if _cdacg !=nil {return _cdacg ;};start .Attr =append (start .Attr ,_cdaga );e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_edfea *CT_DocPartBehaviors )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _edfea .Behavior !=nil {_egeaf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062\u0065\u0068\u0061\u0076\u0069\u006f\u0072"}};for _ ,_ddabf :=range _edfea .Behavior {e .EncodeElement (_ddabf ,_egeaf );};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
@@ -1874,6 +1879,11 @@ ListSeparator *CT_String ;Extra []_ga .Any ;};type ST_FrameScrollbar byte ;func
 // Validate validates the CT_VerticalJc and its children
 func (_cadgf *CT_VerticalJc )Validate ()error {return _cadgf .ValidateWithPath ("\u0043\u0054\u005f\u0056\u0065\u0072\u0074\u0069\u0063\u0061\u006c\u004a\u0063");};
 
+// ParseHighlightColor parses a highlight color name as used in documents
+// (e.g. "yellow" or "darkBlue").  An empty string returns
+// ST_HighlightColorUnset.
+func ParseHighlightColor (s string )(ST_HighlightColor ,error ){var _abeac ST_HighlightColor ;if _fgace :=_abeac .UnmarshalXMLAttr (_g .Attr {Value :s });_fgace !=nil {return ST_HighlightColorUnset ,_fgace ;};if _abeac ==ST_HighlightColorUnset &&s !=""{return ST_HighlightColorUnset ,_gd .Errorf ("\u0075\u006e\u006b\u006e\u006f\u0077\u006e\u0020\u0068\u0069\u0067\u0068\u006c\u0069\u0067\u0068\u0074\u0020\u0063\u006f\u006c\u006fr\u0020\u0025\u0071",s );};return _abeac ,nil ;};
+
 // ValidateWithPath validates the CT_TblPrEx and its children, prefixing error messages with path
 func (_daabcb *CT_TblPrEx )ValidateWithPath (path string )error {if _daabcb .TblW !=nil {if _cgeag :=_daabcb .TblW .ValidateWithPath (path +"\u002f\u0054\u0062l\u0057");_cgeag !=nil {return _cgeag ;};};if _daabcb .Jc !=nil {if _gfebd :=_daabcb .Jc .ValidateWithPath (path +"\u002f\u004a\u0063");_gfebd !=nil {return _gfebd ;};};if _daabcb .TblCellSpacing !=nil {if _bbebcf :=_daabcb .TblCellSpacing .ValidateWithPath (path +"\u002fT\u0062l\u0043\u0065\u006c\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067");_bbebcf !=nil {return _bbebcf ;};};if _daabcb .TblInd !=nil {if _deebb :=_daabcb .TblInd .ValidateWithPath (path +"\u002fT\u0062\u006c\u0049\u006e\u0064");_deebb !=nil {return _deebb ;};};if _daabcb .TblBorders !=nil {if _cegcda :=_daabcb .TblBorders .ValidateWithPath (path +"/\u0054\u0062\u006c\u0042\u006f\u0072\u0064\u0065\u0072\u0073");_cegcda !=nil {return _cegcda ;};};if _daabcb .Shd !=nil {if _dcbef :=_daabcb .Shd .ValidateWithPath (path +"\u002f\u0053\u0068\u0064");_dcbef !=nil {return _dcbef ;};};if _daabcb .TblLayout !=nil {if _fefdbf :=_daabcb .TblLayout .ValidateWithPath (path +"\u002f\u0054\u0062\u006c\u004c\u0061\u0079\u006f\u0075\u0074");_fefdbf !=nil {return _fefdbf ;};};if _daabcb .TblCellMar !=nil {if _gdadcg :=_daabcb .TblCellMar .ValidateWithPath (path +"/\u0054\u0062\u006c\u0043\u0065\u006c\u006c\u004d\u0061\u0072");_gdadcg !=nil {return _gdadcg ;};};if _daabcb .TblLook !=nil {if _efcgfc :=_daabcb .TblLook .ValidateWithPath (path +"\u002f\u0054\u0062\u006c\u004c\u006f\u006f\u006b");_efcgfc !=nil {return _efcgfc ;};};if _daabcb .TblPrExChange !=nil {if _gagbg :=_daabcb .TblPrExChange .ValidateWithPath (path +"\u002f\u0054\u0062\u006c\u0050\u0072\u0045\u0078\u0043h\u0061\u006e\u0067\u0065");_gagbg !=nil {return _gagbg ;};};return nil ;};
 
@@ -1941,7 +1951,7 @@ func (_fcdcf *CT_SdtContentRun )ValidateWithPath (path string )error {for _gaaaa
 func (_cceef *WdCT_WrapSquare )ValidateWithPath (path string )error {if _cceef .WrapTextAttr ==WdST_WrapTextUnset {return _gd .Errorf ("\u0025\u0073/W\u0072\u0061\u0070T\u0065\u0078\u0074\u0041ttr\u0020is\u0020\u0061\u0020\u006d\u0061\u006e\u0064at\u006f\u0072\u0079\u0020\u0066\u0069\u0065l\u0064",path );};if _gdccfg :=_cceef .WrapTextAttr .ValidateWithPath (path +"\u002f\u0057\u0072\u0061\u0070\u0054\u0065\u0078\u0074\u0041\u0074\u0074\u0072");_gdccfg !=nil {return _gdccfg ;};if _cceef .EffectExtent !=nil {if _cafcab :=_cceef .EffectExtent .ValidateWithPath (path +"\u002f\u0045\u0066\u0066\u0065\u0063\u0074\u0045\u0078\u0074\u0065\u006e\u0074");_cafcab !=nil {return _cafcab ;};};return nil ;};
 
 // ValidateWithPath validates the CT_ParaRPrChange and its children, prefixing error messages with path
-func (_afegd *CT_ParaRPrChange )ValidateWithPath (path string )error {if _cdbdf :=_afegd .RPr .ValidateWithPath (path +"\u002f\u0052\u0050\u0072");_cdbdf !=nil {return _cdbdf ;};return nil ;};func (_fcac *CT_MathCtrlIns )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fcac .AuthorAttr )});if _fcac .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_fcac .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fcac .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fcfgb ST_Em )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_ceefac :=_g .Attr {};_ceefac .Name =name ;switch _fcfgb {case ST_EmUnset :_ceefac .Value ="";case ST_EmNone :_ceefac .Value ="\u006e\u006f\u006e\u0065";case ST_EmDot :_ceefac .Value ="\u0064\u006f\u0074";case ST_EmComma :_ceefac .Value ="\u0063\u006f\u006dm\u0061";case ST_EmCircle :_ceefac .Value ="\u0063\u0069\u0072\u0063\u006c\u0065";case ST_EmUnderDot :_ceefac .Value ="\u0075\u006e\u0064\u0065\u0072\u0044\u006f\u0074";};return _ceefac ,nil ;};
+func (_afegd *CT_ParaRPrChange )ValidateWithPath (path string )error {if _cdbdf :=_afegd .RPr .ValidateWithPath (path +"\u002f\u0052\u0050\u0072");_cdbdf !=nil {return _cdbdf ;};return nil ;};func (_fcac *CT_MathCtrlIns )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fcac .AuthorAttr )});if _fcac .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_fcac .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fcac .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fcfgb ST_Em )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_ceefac :=_g .Attr {};_ceefac .Name =name ;switch _fcfgb {case ST_EmUnset :_ceefac .Value ="";case ST_EmNone :_ceefac .Value ="\u006e\u006f\u006e\u0065";case ST_EmDot :_ceefac .Value ="\u0064\u006f\u0074";case ST_EmComma :_ceefac .Value ="\u0063\u006f\u006dm\u0061";case ST_EmCircle :_ceefac .Value ="\u0063\u0069\u0072\u0063\u006c\u0065";case ST_EmUnderDot :_ceefac .Value ="\u0075\u006e\u0064\u0065\u0072\u0044\u006f\u0074";};return _ceefac ,nil ;};
 
 // ValidateWithPath validates the EG_ContentRowContent and its children, prefixing error messages with path
 func (_cdegc *EG_ContentRowContent )ValidateWithPath (path string )error {for _fdacd ,_cbcfbd :=range _cdegc .Tr {if _cgeab :=_cbcfbd .ValidateWithPath (_gd .Sprintf ("\u0025s\u002f\u0054\u0072\u005b\u0025\u0064]",path ,_fdacd ));_cgeab !=nil {return _cgeab ;};};if _cdegc .CustomXml !=nil {if _dbdbea :=_cdegc .CustomXml .ValidateWithPath (path +"\u002f\u0043\u0075\u0073\u0074\u006f\u006d\u0058\u006d\u006c");_dbdbea !=nil {return _dbdbea ;};};if _cdegc .Sdt !=nil {if _eacbfd :=_cdegc .Sdt .ValidateWithPath (path +"\u002f\u0053\u0064\u0074");_eacbfd !=nil {return _eacbfd ;};};for _ffbgg ,_febafb :=range _cdegc .EG_RunLevelElts {if _efdba :=_febafb .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0045G_\u0052\u0075\u006e\u004c\u0065\u0076\u0065\u006c\u0045\u006c\u0074\u0073\u005b\u0025d\u005d",path ,_ffbgg ));_efdba !=nil {return _efdba ;};};return nil ;};
@@ -2187,7 +2197,7 @@ func (_cabaef *EG_BlockLevelChunkElts )ValidateWithPath (path string )error {for
 func (_gfdce *CT_LongHexNumber )Validate ()error {return _gfdce .ValidateWithPath ("\u0043\u0054_\u004c\u006f\u006eg\u0048\u0065\u0078\u004e\u0075\u006d\u0062\u0065\u0072");};func (_geefg *CT_Picture )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_aeccba :for {_beedc ,_ddgba :=d .Token ();if _ddgba !=nil {return _ddgba ;};switch _bedgc :=_beedc .(type ){case _g .StartElement :switch _bedgc .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076i\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076i\u0065"}:_geefg .Movie =NewCT_Rel ();if _cbcde :=d .DecodeElement (_geefg .Movie ,&_bedgc );_cbcde !=nil {return _cbcde ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006e\u0074\u0072\u006f\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006e\u0074\u0072\u006f\u006c"}:_geefg .Control =NewCT_Control ();if _ddfcd :=d .DecodeElement (_geefg .Control ,&_bedgc );_ddfcd !=nil {return _ddfcd ;};default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067 \u0075\u006e\u0073\u0075\u0070\u0070\u006fr\u0074\u0065\u0064\u0020\u0065\u006ce\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005fP\u0069\u0063\u0074\u0075\u0072\u0065\u0020\u0025\u0076",_bedgc .Name );if _aeafg :=d .Skip ();_aeafg !=nil {return _aeafg ;};};case _g .EndElement :break _aeccba ;case _g .CharData :};};return nil ;};func NewCT_PixelsMeasure ()*CT_PixelsMeasure {_fadgg :=&CT_PixelsMeasure {};return _fadgg };type ST_Underline byte ;
 
 // ValidateWithPath validates the CT_NumLvl and its children, prefixing error messages with path
-func (_ggeff *CT_NumLvl )ValidateWithPath (path string )error {if _ggeff .StartOverride !=nil {if _bgdaa :=_ggeff .StartOverride .ValidateWithPath (path +"\u002f\u0053\u0074\u0061\u0072\u0074\u004f\u0076\u0065r\u0072\u0069\u0064\u0065");_bgdaa !=nil {return _bgdaa ;};};if _ggeff .Lvl !=nil {if _gaabf :=_ggeff .Lvl .ValidateWithPath (path +"\u002f\u004c\u0076\u006c");_gaabf !=nil {return _gaabf ;};};return nil ;};func (_egbagg *ST_MeasurementOrPercent )Validate ()error {return _egbagg .ValidateWithPath ("")};func (_geaec *CT_TrPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_geaec .AuthorAttr )});if _geaec .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_geaec .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_geaec .IdAttr )});e .EncodeToken (start );_bbbbf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0072\u0050\u0072"}};e .EncodeElement (_geaec .TrPr ,_bbbbf );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+func (_ggeff *CT_NumLvl )ValidateWithPath (path string )error {if _ggeff .StartOverride !=nil {if _bgdaa :=_ggeff .StartOverride .ValidateWithPath (path +"\u002f\u0053\u0074\u0061\u0072\u0074\u004f\u0076\u0065r\u0072\u0069\u0064\u0065");_bgdaa !=nil {return _bgdaa ;};};if _ggeff .Lvl !=nil {if _gaabf :=_ggeff .Lvl .ValidateWithPath (path +"\u002f\u004c\u0076\u006c");_gaabf !=nil {return _gaabf ;};};return nil ;};func (_egbagg *ST_MeasurementOrPercent )Validate ()error {return _egbagg .ValidateWithPath ("")};func (_geaec *CT_TrPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_geaec .AuthorAttr )});if _geaec .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_geaec .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_geaec .IdAttr )});e .EncodeToken (start );_bbbbf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0072\u0050\u0072"}};e .EncodeElement (_geaec .TrPr ,_bbbbf );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // ValidateWithPath validates the CT_TrPrBase and its children, prefixing error messages with path
 func (_gbaac *CT_TrPrBase )ValidateWithPath (path string )error {for _cbddc ,_dadbae :=range _gbaac .CnfStyle {if _gfdgg :=_dadbae .ValidateWithPath (_gd .Sprintf ("\u0025s\u002fC\u006e\u0066\u0053\u0074\u0079\u006c\u0065\u005b\u0025\u0064\u005d",path ,_cbddc ));_gfdgg !=nil {return _gfdgg ;};};for _caefgg ,_caggd :=range _gbaac .DivId {if _babfe :=_caggd .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002fD\u0069\u0076\u0049\u0064\u005b\u0025\u0064\u005d",path ,_caefgg ));_babfe !=nil {return _babfe ;};};for _ebece ,_bgbcc :=range _gbaac .GridBefore {if _adaded :=_bgbcc .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0047\u0072\u0069\u0064\u0042\u0065\u0066\u006f\u0072e\u005b\u0025\u0064\u005d",path ,_ebece ));_adaded !=nil {return _adaded ;};};for _eafce ,_fcagb :=range _gbaac .GridAfter {if _cfaec :=_fcagb .ValidateWithPath (_gd .Sprintf ("\u0025\u0073/\u0047\u0072\u0069d\u0041\u0066\u0074\u0065\u0072\u005b\u0025\u0064\u005d",path ,_eafce ));_cfaec !=nil {return _cfaec ;};};for _becgea ,_gaacba :=range _gbaac .WBefore {if _daadd :=_gaacba .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0057\u0042\u0065\u0066\u006f\u0072e\u005b\u0025\u0064\u005d",path ,_becgea ));_daadd !=nil {return _daadd ;};};for _dfebga ,_gfggc :=range _gbaac .WAfter {if _ebadgf :=_gfggc .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0057\u0041\u0066\u0074\u0065\u0072\u005b\u0025\u0064\u005d",path ,_dfebga ));_ebadgf !=nil {return _ebadgf ;};};for _dbfab ,_dabb :=range _gbaac .CantSplit {if _fegebe :=_dabb .ValidateWithPath (_gd .Sprintf ("\u0025\u0073/\u0043\u0061\u006et\u0053\u0070\u006c\u0069\u0074\u005b\u0025\u0064\u005d",path ,_dbfab ));_fegebe !=nil {return _fegebe ;};};for _fcece ,_agfbc :=range _gbaac .TrHeight {if _efcefg :=_agfbc .ValidateWithPath (_gd .Sprintf ("\u0025s\u002fT\u0072\u0048\u0065\u0069\u0067\u0068\u0074\u005b\u0025\u0064\u005d",path ,_fcece ));_efcefg !=nil {return _efcefg ;};};for _ggedb ,_bdgda :=range _gbaac .TblHeader {if _cbdgf :=_bdgda .ValidateWithPath (_gd .Sprintf ("\u0025\u0073/\u0054\u0062\u006cH\u0065\u0061\u0064\u0065\u0072\u005b\u0025\u0064\u005d",path ,_ggedb ));_cbdgf !=nil {return _cbdgf ;};};for _bfcedc ,_ecabab :=range _gbaac .TblCellSpacing {if _gdcfgf :=_ecabab .ValidateWithPath (_gd .Sprintf ("%\u0073\u002f\u0054\u0062lC\u0065l\u006c\u0053\u0070\u0061\u0063i\u006e\u0067\u005b\u0025\u0064\u005d",path ,_bfcedc ));_gdcfgf !=nil {return _gdcfgf ;};};for _faagef ,_ggfcf :=range _gbaac .Jc {if _ggcecd :=_ggfcf .ValidateWithPath (_gd .Sprintf ("\u0025s\u002f\u004a\u0063\u005b\u0025\u0064]",path ,_faagef ));_ggcecd !=nil {return _ggcecd ;};};for _fbaage ,_egcdcf :=range _gbaac .Hidden {if _afegde :=_egcdcf .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0048\u0069\u0064\u0064\u0065\u006e\u005b\u0025\u0064\u005d",path ,_fbaage ));_afegde !=nil {return _afegde ;};};return nil ;};func (_gcfc *CT_DocVar )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for _ ,_ecgd :=range start .Attr {if _ecgd .Name .Local =="\u006e\u0061\u006d\u0065"{_faecfa ,_eccfgg :=_ecgd .Value ,error (nil );if _eccfgg !=nil {return _eccfgg ;};_gcfc .NameAttr =_faecfa ;continue ;};if _ecgd .Name .Local =="\u0076\u0061\u006c"{_bdfdd ,_gcga :=_ecgd .Value ,error (nil );if _gcga !=nil {return _gcga ;};_gcfc .ValAttr =_bdfdd ;continue ;};};for {_ccaac ,_gegaa :=d .Token ();if _gegaa !=nil {return _gd .Errorf ("p\u0061\u0072\u0073\u0069ng\u0020C\u0054\u005f\u0044\u006f\u0063V\u0061\u0072\u003a\u0020\u0025\u0073",_gegaa );};if _fgga ,_bfcd :=_ccaac .(_g .EndElement );_bfcd &&_fgga .Name ==start .Name {break ;};};return nil ;};
@@ -2853,7 +2863,7 @@ func (_fbcgg *CT_MultiLevelType )Validate ()error {return _fbcgg .ValidateWithPa
 func (_fbace *CT_FtnEdnSepRef )ValidateWithPath (path string )error {return nil };func (_fgaab *CT_RubyContent )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _fgaab .R !=nil {_afbcg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072"}};e .EncodeElement (_fgaab .R ,_afbcg );};if _fgaab .EG_RunLevelElts !=nil {for _ ,_eedfa :=range _fgaab .EG_RunLevelElts {_eedfa .MarshalXML (e ,_g .StartElement {});};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_MailMerge ()*CT_MailMerge {_dfed :=&CT_MailMerge {};_dfed .MainDocumentType =NewCT_MailMergeDocType ();_dfed .DataType =NewCT_MailMergeDataType ();return _dfed ;};
 
 // ValidateWithPath validates the CT_CustomXmlPr and its children, prefixing error messages with path
-func (_geaa *CT_CustomXmlPr )ValidateWithPath (path string )error {if _geaa .Placeholder !=nil {if _cedba :=_geaa .Placeholder .ValidateWithPath (path +"\u002f\u0050\u006ca\u0063\u0065\u0068\u006f\u006c\u0064\u0065\u0072");_cedba !=nil {return _cedba ;};};for _gbdd ,_eaceg :=range _geaa .Attr {if _fdgd :=_eaceg .ValidateWithPath (_gd .Sprintf ("%\u0073\u002f\u0041\u0074\u0074\u0072\u005b\u0025\u0064\u005d",path ,_gbdd ));_fdgd !=nil {return _fdgd ;};};return nil ;};func (_dgcgaf *ST_PTabAlignment )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_dgcgaf =0;case "\u006c\u0065\u0066\u0074":*_dgcgaf =1;case "\u0063\u0065\u006e\u0074\u0065\u0072":*_dgcgaf =2;case "\u0072\u0069\u0067h\u0074":*_dgcgaf =3;};return nil ;};func (_eccge *CT_TblPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_eccge .AuthorAttr )});if _eccge .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_eccge .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_eccge .IdAttr )});e .EncodeToken (start );_dbdcb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u0050\u0072"}};e .EncodeElement (_eccge .TblPr ,_dbdcb );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_cfdce ST_TextDirection )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_bfeaec :=_g .Attr {};_bfeaec .Name =name ;switch _cfdce {case ST_TextDirectionUnset :_bfeaec .Value ="";case ST_TextDirectionTb :_bfeaec .Value ="\u0074\u0062";case ST_TextDirectionRl :_bfeaec .Value ="\u0072\u006c";case ST_TextDirectionLr :_bfeaec .Value ="\u006c\u0072";case ST_TextDirectionTbV :_bfeaec .Value ="\u0074\u0062\u0056";case ST_TextDirectionRlV :_bfeaec .Value ="\u0072\u006c\u0056";case ST_TextDirectionLrV :_bfeaec .Value ="\u006c\u0072\u0056";case ST_TextDirectionBtLr :_bfeaec .Value ="\u0062\u0074\u004c\u0072";case ST_TextDirectionLrTb :_bfeaec .Value ="\u006c\u0072\u0054\u0062";case ST_TextDirectionLrTbV :_bfeaec .Value ="\u006c\u0072\u0054b\u0056";case ST_TextDirectionTbLrV :_bfeaec .Value ="\u0074\u0062\u004cr\u0056";case ST_TextDirectionTbRl :_bfeaec .Value ="\u0074\u0062\u0052\u006c";case ST_TextDirectionTbRlV :_bfeaec .Value ="\u0074\u0062\u0052l\u0056";};return _bfeaec ,nil ;};func (_gbbgc *CT_SectPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gbbgc .AuthorAttr )});if _gbbgc .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_gbbgc .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gbbgc .IdAttr )});e .EncodeToken (start );if _gbbgc .SectPr !=nil {_cacbd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0065\u0063\u0074\u0050\u0072"}};e .EncodeElement (_gbbgc .SectPr ,_cacbd );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_TblPrExChange ()*CT_TblPrExChange {_fbcbg :=&CT_TblPrExChange {};_fbcbg .TblPrEx =NewCT_TblPrExBase ();return _fbcbg ;};func (_bdgfgb ST_DropCap )ValidateWithPath (path string )error {switch _bdgfgb {case 0,1,2,3:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_bdgfgb ));};return nil ;};type CT_FramePr struct{
+func (_geaa *CT_CustomXmlPr )ValidateWithPath (path string )error {if _geaa .Placeholder !=nil {if _cedba :=_geaa .Placeholder .ValidateWithPath (path +"\u002f\u0050\u006ca\u0063\u0065\u0068\u006f\u006c\u0064\u0065\u0072");_cedba !=nil {return _cedba ;};};for _gbdd ,_eaceg :=range _geaa .Attr {if _fdgd :=_eaceg .ValidateWithPath (_gd .Sprintf ("%\u0073\u002f\u0041\u0074\u0074\u0072\u005b\u0025\u0064\u005d",path ,_gbdd ));_fdgd !=nil {return _fdgd ;};};return nil ;};func (_dgcgaf *ST_PTabAlignment )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_dgcgaf =0;case "\u006c\u0065\u0066\u0074":*_dgcgaf =1;case "\u0063\u0065\u006e\u0074\u0065\u0072":*_dgcgaf =2;case "\u0072\u0069\u0067h\u0074":*_dgcgaf =3;};return nil ;};func (_eccge *CT_TblPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_eccge .AuthorAttr )});if _eccge .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_eccge .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_eccge .IdAttr )});e .EncodeToken (start );_dbdcb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u0050\u0072"}};e .EncodeElement (_eccge .TblPr ,_dbdcb );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_cfdce ST_TextDirection )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_bfeaec :=_g .Attr {};_bfeaec .Name =name ;switch _cfdce {case ST_TextDirectionUnset :_bfeaec .Value ="";case ST_TextDirectionTb :_bfeaec .Value ="\u0074\u0062";case ST_TextDirectionRl :_bfeaec .Value ="\u0072\u006c";case ST_TextDirectionLr :_bfeaec .Value ="\u006c\u0072";case ST_TextDirectionTbV :_bfeaec .Value ="\u0074\u0062\u0056";case ST_TextDirectionRlV :_bfeaec .Value ="\u0072\u006c\u0056";case ST_TextDirectionLrV :_bfeaec .Value ="\u006c\u0072\u0056";case ST_TextDirectionBtLr :_bfeaec .Value ="\u0062\u0074\u004c\u0072";case ST_TextDirectionLrTb :_bfeaec .Value ="\u006c\u0072\u0054\u0062";case ST_TextDirectionLrTbV :_bfeaec .Value ="\u006c\u0072\u0054b\u0056";case ST_TextDirectionTbLrV :_bfeaec .Value ="\u0074\u0062\u004cr\u0056";case ST_TextDirectionTbRl :_bfeaec .Value ="\u0074\u0062\u0052\u006c";case ST_TextDirectionTbRlV :_bfeaec .Value ="\u0074\u0062\u0052l\u0056";};return _bfeaec ,nil ;};func (_gbbgc *CT_SectPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gbbgc .AuthorAttr )});if _gbbgc .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gbbgc .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gbbgc .IdAttr )});e .EncodeToken (start );if _gbbgc .SectPr !=nil {_cacbd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0065\u0063\u0074\u0050\u0072"}};e .EncodeElement (_gbbgc .SectPr ,_cacbd );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_TblPrExChange ()*CT_TblPrExChange {_fbcbg :=&CT_TblPrExChange {};_fbcbg .TblPrEx =NewCT_TblPrExBase ();return _fbcbg ;};func (_bdgfgb ST_DropCap )ValidateWithPath (path string )error {switch _bdgfgb {case 0,1,2,3:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_bdgfgb ));};return nil ;};type CT_FramePr struct{
 
 // Drop Cap Frame
 DropCapAttr ST_DropCap ;
@@ -3018,7 +3028,7 @@ func (_baeff *WdCT_TextboxInfo )Validate ()error {return _baeff .ValidateWithPat
 func (_aaffea *CT_SdtRun )ValidateWithPath (path string )error {if _aaffea .SdtPr !=nil {if _gdacda :=_aaffea .SdtPr .ValidateWithPath (path +"\u002f\u0053\u0064\u0074\u0050\u0072");_gdacda !=nil {return _gdacda ;};};if _aaffea .SdtEndPr !=nil {if _gggag :=_aaffea .SdtEndPr .ValidateWithPath (path +"\u002fS\u0064\u0074\u0045\u006e\u0064\u0050r");_gggag !=nil {return _gggag ;};};if _aaffea .SdtContent !=nil {if _gadabd :=_aaffea .SdtContent .ValidateWithPath (path +"/\u0053\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074");_gadabd !=nil {return _gadabd ;};};return nil ;};func (_aaegad *EG_RPr )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_eecbd :for {_addgb ,_baaeg :=d .Token ();if _baaeg !=nil {return _baaeg ;};switch _edfgf :=_addgb .(type ){case _g .StartElement :switch _edfgf .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0050\u0072"}:_aaegad .RPr =NewCT_RPr ();if _fcead :=d .DecodeElement (_aaegad .RPr ,&_edfgf );_fcead !=nil {return _fcead ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0045\u0047\u005f\u0052\u0050\u0072\u0020\u0025\u0076",_edfgf .Name );if _aaeed :=d .Skip ();_aaeed !=nil {return _aaeed ;};};case _g .EndElement :break _eecbd ;case _g .CharData :};};return nil ;};func ParseUnionST_OnOff (s string )(_gc .ST_OnOff ,error ){return _gc .ParseUnionST_OnOff (s )};func (_eeagad *EG_HdrFtrReferences )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _eeagad .HeaderReference !=nil {_ebdgd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0068\u0065\u0061\u0064\u0065\u0072\u0052\u0065\u0066\u0065r\u0065\u006e\u0063\u0065"}};e .EncodeElement (_eeagad .HeaderReference ,_ebdgd );};if _eeagad .FooterReference !=nil {_cdffac :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0066\u006f\u006f\u0074\u0065\u0072\u0052\u0065\u0066\u0065r\u0065\u006e\u0063\u0065"}};e .EncodeElement (_eeagad .FooterReference ,_cdffac );};return nil ;};func (_dceecg *WdST_AlignV )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_acbdcd ,_bdefgg :=d .Token ();if _bdefgg !=nil {return _bdefgg ;};if _cdbeee ,_afbgcb :=_acbdcd .(_g .EndElement );_afbgcb &&_cdbeee .Name ==start .Name {*_dceecg =1;return nil ;};if _gafcde ,_edggb :=_acbdcd .(_g .CharData );!_edggb {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_acbdcd );}else {switch string (_gafcde ){case "":*_dceecg =0;case "\u0074\u006f\u0070":*_dceecg =1;case "\u0062\u006f\u0074\u0074\u006f\u006d":*_dceecg =2;case "\u0063\u0065\u006e\u0074\u0065\u0072":*_dceecg =3;case "\u0069\u006e\u0073\u0069\u0064\u0065":*_dceecg =4;case "\u006fu\u0074\u0073\u0069\u0064\u0065":*_dceecg =5;};};_acbdcd ,_bdefgg =d .Token ();if _bdefgg !=nil {return _bdefgg ;};if _ffddg ,_cfcbgg :=_acbdcd .(_g .EndElement );_cfcbgg &&_ffddg .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_acbdcd );};func NewCT_DataBinding ()*CT_DataBinding {_febgb :=&CT_DataBinding {};return _febgb };func (_edgdea *CT_Lvl )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u006c\u0076\u006c"},Value :_gd .Sprintf ("\u0025\u0076",_edgdea .IlvlAttr )});if _edgdea .TplcAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0074\u0070\u006c\u0063"},Value :_gd .Sprintf ("\u0025\u0076",*_edgdea .TplcAttr )});};if _edgdea .TentativeAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"w\u003a\u0074\u0065\u006e\u0074\u0061\u0074\u0069\u0076\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_edgdea .TentativeAttr )});};e .EncodeToken (start );if _edgdea .Start !=nil {_aefbe :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0074\u0061\u0072\u0074"}};e .EncodeElement (_edgdea .Start ,_aefbe );};if _edgdea .NumFmt !=nil {_fadbd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006e\u0075\u006d\u0046\u006d\u0074"}};e .EncodeElement (_edgdea .NumFmt ,_fadbd );};if _edgdea .LvlRestart !=nil {_bdfed :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006cv\u006c\u0052\u0065\u0073\u0074\u0061\u0072\u0074"}};e .EncodeElement (_edgdea .LvlRestart ,_bdfed );};if _edgdea .PStyle !=nil {_dddfaf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_edgdea .PStyle ,_dddfaf );};if _edgdea .IsLgl !=nil {_adgab :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u0073\u004c\u0067\u006c"}};e .EncodeElement (_edgdea .IsLgl ,_adgab );};if _edgdea .Suff !=nil {_gcgffe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0075\u0066\u0066"}};e .EncodeElement (_edgdea .Suff ,_gcgffe );};if _edgdea .LvlText !=nil {_cdbee :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006c\u0076\u006c\u0054\u0065\u0078t"}};e .EncodeElement (_edgdea .LvlText ,_cdbee );};if _edgdea .LvlPicBulletId !=nil {_ceceb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003al\u0076\u006c\u0050i\u0063\u0042\u0075\u006c\u006c\u0065\u0074\u0049\u0064"}};e .EncodeElement (_edgdea .LvlPicBulletId ,_ceceb );};if _edgdea .Legacy !=nil {_fccga :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006c\u0065\u0067\u0061\u0063\u0079"}};e .EncodeElement (_edgdea .Legacy ,_fccga );};if _edgdea .LvlJc !=nil {_cfcbc :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006c\u0076\u006c\u004a\u0063"}};e .EncodeElement (_edgdea .LvlJc ,_cfcbc );};if _edgdea .PPr !=nil {_ggcc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070P\u0072"}};e .EncodeElement (_edgdea .PPr ,_ggcc );};if _edgdea .RPr !=nil {_eeafc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_edgdea .RPr ,_eeafc );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // ST_MeasurementOrPercent is a union type
-type ST_MeasurementOrPercent struct{ST_DecimalNumberOrPercent *ST_DecimalNumberOrPercent ;ST_UniversalMeasure *string ;};func (_bdada ST_Em )Validate ()error {return _bdada .ValidateWithPath ("")};func (_bbdbc *CT_RPr )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _bbdbc .RStyle !=nil {_gaagf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_bbdbc .RStyle ,_gaagf );};if _bbdbc .RFonts !=nil {_bedgca :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072\u0046\u006f\u006e\u0074\u0073"}};e .EncodeElement (_bbdbc .RFonts ,_bedgca );};if _bbdbc .B !=nil {_cedgb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062"}};e .EncodeElement (_bbdbc .B ,_cedgb );};if _bbdbc .BCs !=nil {_cdbgf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062C\u0073"}};e .EncodeElement (_bbdbc .BCs ,_cdbgf );};if _bbdbc .I !=nil {_acfcd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0069"}};e .EncodeElement (_bbdbc .I ,_acfcd );};if _bbdbc .ICs !=nil {_eaafa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0069C\u0073"}};e .EncodeElement (_bbdbc .ICs ,_eaafa );};if _bbdbc .Caps !=nil {_dbabe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u0061\u0070\u0073"}};e .EncodeElement (_bbdbc .Caps ,_dbabe );};if _bbdbc .SmallCaps !=nil {_dfagc :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0073\u006d\u0061\u006c\u006c\u0043\u0061\u0070\u0073"}};e .EncodeElement (_bbdbc .SmallCaps ,_dfagc );};if _bbdbc .Strike !=nil {_cafeb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0074\u0072\u0069\u006b\u0065"}};e .EncodeElement (_bbdbc .Strike ,_cafeb );};if _bbdbc .Dstrike !=nil {_edfef :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0064\u0073\u0074\u0072\u0069\u006be"}};e .EncodeElement (_bbdbc .Dstrike ,_edfef );};if _bbdbc .Outline !=nil {_cdfcd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006f\u0075\u0074\u006c\u0069\u006ee"}};e .EncodeElement (_bbdbc .Outline ,_cdfcd );};if _bbdbc .Shadow !=nil {_aabbc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0068\u0061\u0064\u006f\u0077"}};e .EncodeElement (_bbdbc .Shadow ,_aabbc );};if _bbdbc .Emboss !=nil {_dgfgc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u006d\u0062\u006f\u0073\u0073"}};e .EncodeElement (_bbdbc .Emboss ,_dgfgc );};if _bbdbc .Imprint !=nil {_fdbdf :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u006d\u0070\u0072\u0069\u006et"}};e .EncodeElement (_bbdbc .Imprint ,_fdbdf );};if _bbdbc .NoProof !=nil {_cacgd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006e\u006f\u0050\u0072\u006f\u006ff"}};e .EncodeElement (_bbdbc .NoProof ,_cacgd );};if _bbdbc .SnapToGrid !=nil {_ebafe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073n\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"}};e .EncodeElement (_bbdbc .SnapToGrid ,_ebafe );};if _bbdbc .Vanish !=nil {_egdgd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0076\u0061\u006e\u0069\u0073\u0068"}};e .EncodeElement (_bbdbc .Vanish ,_egdgd );};if _bbdbc .WebHidden !=nil {_cabfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0077\u0065\u0062\u0048\u0069\u0064\u0064\u0065\u006e"}};e .EncodeElement (_bbdbc .WebHidden ,_cabfb );};if _bbdbc .Color !=nil {_cbbec :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0063\u006f\u006c\u006f\u0072"}};e .EncodeElement (_bbdbc .Color ,_cbbec );};if _bbdbc .Spacing !=nil {_acgab :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0070\u0061\u0063\u0069\u006eg"}};e .EncodeElement (_bbdbc .Spacing ,_acgab );};if _bbdbc .W !=nil {_cbaaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0077"}};e .EncodeElement (_bbdbc .W ,_cbaaa );};if _bbdbc .Kern !=nil {_aaffe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006b\u0065\u0072\u006e"}};e .EncodeElement (_bbdbc .Kern ,_aaffe );};if _bbdbc .Position !=nil {_cgeaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u006f\u0073\u0069\u0074\u0069\u006f\u006e"}};e .EncodeElement (_bbdbc .Position ,_cgeaa );};if _bbdbc .Sz !=nil {_dacfg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u007a"}};e .EncodeElement (_bbdbc .Sz ,_dacfg );};if _bbdbc .SzCs !=nil {_dfeaf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u007a\u0043\u0073"}};e .EncodeElement (_bbdbc .SzCs ,_dfeaf );};if _bbdbc .Highlight !=nil {_dagcc :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0068\u0069\u0067\u0068\u006c\u0069\u0067\u0068\u0074"}};e .EncodeElement (_bbdbc .Highlight ,_dagcc );};if _bbdbc .U !=nil {_gefdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0075"}};e .EncodeElement (_bbdbc .U ,_gefdg );};if _bbdbc .Effect !=nil {_fcbgb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u0066\u0066\u0065\u0063\u0074"}};e .EncodeElement (_bbdbc .Effect ,_fcbgb );};if _bbdbc .Bdr !=nil {_gfbab :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062d\u0072"}};e .EncodeElement (_bbdbc .Bdr ,_gfbab );};if _bbdbc .Shd !=nil {_geefe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_bbdbc .Shd ,_geefe );};if _bbdbc .FitText !=nil {_fcfdf :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0066\u0069\u0074\u0054\u0065\u0078t"}};e .EncodeElement (_bbdbc .FitText ,_fcfdf );};if _bbdbc .VertAlign !=nil {_bfab :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0076\u0065\u0072\u0074\u0041\u006c\u0069\u0067\u006e"}};e .EncodeElement (_bbdbc .VertAlign ,_bfab );};if _bbdbc .Rtl !=nil {_eafcf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072t\u006c"}};e .EncodeElement (_bbdbc .Rtl ,_eafcf );};if _bbdbc .Cs !=nil {_bfdag :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u0073"}};e .EncodeElement (_bbdbc .Cs ,_bfdag );};if _bbdbc .Em !=nil {_dafdb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u006d"}};e .EncodeElement (_bbdbc .Em ,_dafdb );};if _bbdbc .Lang !=nil {_adee :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006c\u0061\u006e\u0067"}};e .EncodeElement (_bbdbc .Lang ,_adee );};if _bbdbc .EastAsianLayout !=nil {_gbde :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u0061\u0073\u0074\u0041\u0073\u0069\u0061\u006e\u004ca\u0079\u006f\u0075\u0074"}};e .EncodeElement (_bbdbc .EastAsianLayout ,_gbde );};if _bbdbc .SpecVanish !=nil {_bbdbf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073p\u0065\u0063\u0056\u0061\u006e\u0069\u0073\u0068"}};e .EncodeElement (_bbdbc .SpecVanish ,_bbdbf );};if _bbdbc .OMath !=nil {_daeb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006f\u004d\u0061\u0074\u0068"}};e .EncodeElement (_bbdbc .OMath ,_daeb );};if _bbdbc .RPrChange !=nil {_gbbdfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0072\u0050\u0072\u0043\u0068\u0061\u006e\u0067\u0065"}};e .EncodeElement (_bbdbc .RPrChange ,_gbbdfb );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_dbege *CT_Drawing )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _dbege .Anchor !=nil {_ceffc :=_g .StartElement {Name :_g .Name {Local :"\u0077p\u003a\u0061\u006e\u0063\u0068\u006fr"}};for _ ,_dfcb :=range _dbege .Anchor {e .EncodeElement (_dfcb ,_ceffc );};};if _dbege .Inline !=nil {_cedd :=_g .StartElement {Name :_g .Name {Local :"\u0077p\u003a\u0069\u006e\u006c\u0069\u006ee"}};for _ ,_egff :=range _dbege .Inline {e .EncodeElement (_egff ,_cedd );};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+type ST_MeasurementOrPercent struct{ST_DecimalNumberOrPercent *ST_DecimalNumberOrPercent ;ST_UniversalMeasure *string ;};func (_bdada ST_Em )Validate ()error {return _bdada .ValidateWithPath ("")};func (_bbdbc *CT_RPr )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _bbdbc .RStyle !=nil {_gaagf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_bbdbc .RStyle ,_gaagf );};if _bbdbc .RFonts !=nil {_bedgca :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072\u0046\u006f\u006e\u0074\u0073"}};e .EncodeElement (_bbdbc .RFonts ,_bedgca );};if _bbdbc .B !=nil {_cedgb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062"}};e .EncodeElement (_bbdbc .B ,_cedgb );};if _bbdbc .BCs !=nil {_cdbgf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062C\u0073"}};e .EncodeElement (_bbdbc .BCs ,_cdbgf );};if _bbdbc .I !=nil {_acfcd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0069"}};e .EncodeElement (_bbdbc .I ,_acfcd );};if _bbdbc .ICs !=nil {_eaafa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0069C\u0073"}};e .EncodeElement (_bbdbc .ICs ,_eaafa );};if _bbdbc .Caps !=nil {_dbabe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u0061\u0070\u0073"}};e .EncodeElement (_bbdbc .Caps ,_dbabe );};if _bbdbc .SmallCaps !=nil {_dfagc :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0073\u006d\u0061\u006c\u006c\u0043\u0061\u0070\u0073"}};e .EncodeElement (_bbdbc .SmallCaps ,_dfagc );};if _bbdbc .Strike !=nil {_cafeb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0074\u0072\u0069\u006b\u0065"}};e .EncodeElement (_bbdbc .Strike ,_cafeb );};if _bbdbc .Dstrike !=nil {_edfef :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0064\u0073\u0074\u0072\u0069\u006be"}};e .EncodeElement (_bbdbc .Dstrike ,_edfef );};if _bbdbc .Outline !=nil {_cdfcd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006f\u0075\u0074\u006c\u0069\u006ee"}};e .EncodeElement (_bbdbc .Outline ,_cdfcd );};if _bbdbc .Shadow !=nil {_aabbc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0068\u0061\u0064\u006f\u0077"}};e .EncodeElement (_bbdbc .Shadow ,_aabbc );};if _bbdbc .Emboss !=nil {_dgfgc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u006d\u0062\u006f\u0073\u0073"}};e .EncodeElement (_bbdbc .Emboss ,_dgfgc );};if _bbdbc .Imprint !=nil {_fdbdf :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u006d\u0070\u0072\u0069\u006et"}};e .EncodeElement (_bbdbc .Imprint ,_fdbdf );};if _bbdbc .NoProof !=nil {_cacgd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006e\u006f\u0050\u0072\u006f\u006ff"}};e .EncodeElement (_bbdbc .NoProof ,_cacgd );};if _bbdbc .SnapToGrid !=nil {_ebafe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073n\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"}};e .EncodeElement (_bbdbc .SnapToGrid ,_ebafe );};if _bbdbc .Vanish !=nil {_egdgd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0076\u0061\u006e\u0069\u0073\u0068"}};e .EncodeElement (_bbdbc .Vanish ,_egdgd );};if _bbdbc .WebHidden !=nil {_cabfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0077\u0065\u0062\u0048\u0069\u0064\u0064\u0065\u006e"}};e .EncodeElement (_bbdbc .WebHidden ,_cabfb );};if _bbdbc .Color !=nil {_cbbec :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0063\u006f\u006c\u006f\u0072"}};e .EncodeElement (_bbdbc .Color ,_cbbec );};if _bbdbc .Spacing !=nil {_acgab :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0070\u0061\u0063\u0069\u006eg"}};e .EncodeElement (_bbdbc .Spacing ,_acgab );};if _bbdbc .W !=nil {_cbaaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0077"}};e .EncodeElement (_bbdbc .W ,_cbaaa );};if _bbdbc .Kern !=nil {_aaffe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006b\u0065\u0072\u006e"}};e .EncodeElement (_bbdbc .Kern ,_aaffe );};if _bbdbc .Position !=nil {_cgeaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u006f\u0073\u0069\u0074\u0069\u006f\u006e"}};e .EncodeElement (_bbdbc .Position ,_cgeaa );};if _bbdbc .Sz !=nil {_dacfg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u007a"}};e .EncodeElement (_bbdbc .Sz ,_dacfg );};if _bbdbc .SzCs !=nil {_dfeaf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u007a\u0043\u0073"}};e .EncodeElement (_bbdbc .SzCs ,_dfeaf );};if _bbdbc .Highlight !=nil {_dagcc :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0068\u0069\u0067\u0068\u006c\u0069\u0067\u0068\u0074"}};e .EncodeElement (_bbdbc .Highlight ,_dagcc );};if _bbdbc .U !=nil {_gefdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0075"}};e .EncodeElement (_bbdbc .U ,_gefdg );};if _bbdbc .Effect !=nil {_fcbgb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u0066\u0066\u0065\u0063\u0074"}};e .EncodeElement (_bbdbc .Effect ,_fcbgb );};if _bbdbc .Bdr !=nil {_gfbab :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062d\u0072"}};e .EncodeElement (_bbdbc .Bdr ,_gfbab );};if _bbdbc .Shd !=nil {_geefe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_bbdbc .Shd ,_geefe );};if _bbdbc .FitText !=nil {_fcfdf :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0066\u0069\u0074\u0054\u0065\u0078t"}};e .EncodeElement (_bbdbc .FitText ,_fcfdf );};if _bbdbc .VertAlign !=nil {_bfab :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0076\u0065\u0072\u0074\u0041\u006c\u0069\u0067\u006e"}};e .EncodeElement (_bbdbc .VertAlign ,_bfab );};if _bbdbc .Rtl !=nil {_eafcf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072t\u006c"}};e .EncodeElement (_bbdbc .Rtl ,_eafcf );};if _bbdbc .Cs !=nil {_bfdag :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u0073"}};e .EncodeElement (_bbdbc .Cs ,_bfdag );};if _bbdbc .Em !=nil {_dafdb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u006d"}};e .EncodeElement (_bbdbc .Em ,_dafdb );};if _bbdbc .Lang !=nil {_adee :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006c\u0061\u006e\u0067"}};e .EncodeElement (_bbdbc .Lang ,_adee );};if _bbdbc .EastAsianLayout !=nil {_gbde :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065\u0061\u0073\u0074\u0041\u0073\u0069\u0061\u006e\u004ca\u0079\u006f\u0075\u0074"}};e .EncodeElement (_bbdbc .EastAsianLayout ,_gbde );};if _bbdbc .SpecVanish !=nil {_bbdbf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073p\u0065\u0063\u0056\u0061\u006e\u0069\u0073\u0068"}};e .EncodeElement (_bbdbc .SpecVanish ,_bbdbf );};if _bbdbc .OMath !=nil {_daeb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006f\u004d\u0061\u0074\u0068"}};e .EncodeElement (_bbdbc .OMath ,_daeb );};for _ ,_dgcfea :=range _bbdbc .Extra {if _bafcde :=_dgcfea .MarshalXML (e ,_g .StartElement {});_bafcde !=nil {return _bafcde ;};};if _bbdbc .RPrChange !=nil {_gbbdfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0072\u0050\u0072\u0043\u0068\u0061\u006e\u0067\u0065"}};e .EncodeElement (_bbdbc .RPrChange ,_gbbdfb );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_dbege *CT_Drawing )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _dbege .Anchor !=nil {_ceffc :=_g .StartElement {Name :_g .Name {Local :"\u0077p\u003a\u0061\u006e\u0063\u0068\u006fr"}};for _ ,_dfcb :=range _dbege .Anchor {e .EncodeElement (_dfcb ,_ceffc );};};if _dbege .Inline !=nil {_cedd :=_g .StartElement {Name :_g .Name {Local :"\u0077p\u003a\u0069\u006e\u006c\u0069\u006ee"}};for _ ,_egff :=range _dbege .Inline {e .EncodeElement (_egff ,_cedd );};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // ValidateWithPath validates the WdWsp and its children, prefixing error messages with path
 func (_aaffeae *WdWsp )ValidateWithPath (path string )error {if _efcgcc :=_aaffeae .WdCT_WordprocessingShape .ValidateWithPath (path );_efcgcc !=nil {return _efcgcc ;};return nil ;};func NewCT_Headers ()*CT_Headers {_efefb :=&CT_Headers {};return _efefb };func NewCT_Endnotes ()*CT_Endnotes {_bafcf :=&CT_Endnotes {};return _bafcf };type WdEG_WrapType struct{Choice *WdEG_WrapTypeChoice ;};
@@ -3105,7 +3115,7 @@ ValAttr ST_SignedTwipsMeasure ;};type CT_PPrChange struct{AuthorAttr string ;Dat
 IdAttr int64 ;PPr *CT_PPrBase ;};type CT_FFTextType struct{
 
 // Text Box Form Field Type Values
-ValAttr ST_FFTextType ;};func (_edafaa *WdCT_WrapTight )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_fgccfg ,_gfccc :=_edafaa .WrapTextAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u0072\u0061\u0070\u0054\u0065\u0078\u0074"});if _gfccc !=nil {return _gfccc ;};start .Attr =append (start .Attr ,_fgccfg );if _edafaa .DistLAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0064\u0069\u0073t\u004c"},Value :_gd .Sprintf ("\u0025\u0076",*_edafaa .DistLAttr )});};if _edafaa .DistRAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0064\u0069\u0073t\u0052"},Value :_gd .Sprintf ("\u0025\u0076",*_edafaa .DistRAttr )});};e .EncodeToken (start );_begcc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0077\u0072\u0061\u0070\u0050\u006fl\u0079\u0067\u006f\u006e"}};e .EncodeElement (_edafaa .WrapPolygon ,_begcc );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_aebadd *ST_TabTlc )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_adagcf ,_ddfbf :=d .Token ();if _ddfbf !=nil {return _ddfbf ;};if _fbafb ,_edeffaa :=_adagcf .(_g .EndElement );_edeffaa &&_fbafb .Name ==start .Name {*_aebadd =1;return nil ;};if _caeba ,_cebbbb :=_adagcf .(_g .CharData );!_cebbbb {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_adagcf );}else {switch string (_caeba ){case "":*_aebadd =0;case "\u006e\u006f\u006e\u0065":*_aebadd =1;case "\u0064\u006f\u0074":*_aebadd =2;case "\u0068\u0079\u0070\u0068\u0065\u006e":*_aebadd =3;case "\u0075\u006e\u0064\u0065\u0072\u0073\u0063\u006f\u0072\u0065":*_aebadd =4;case "\u0068\u0065\u0061v\u0079":*_aebadd =5;case "\u006di\u0064\u0064\u006c\u0065\u0044\u006ft":*_aebadd =6;};};_adagcf ,_ddfbf =d .Token ();if _ddfbf !=nil {return _ddfbf ;};if _gfedf ,_cagcc :=_adagcf .(_g .EndElement );_cagcc &&_gfedf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_adagcf );};func (_gggbab *WdST_RelFromH )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_abbec ,_ccfae :=d .Token ();if _ccfae !=nil {return _ccfae ;};if _gcdec ,_abfcbf :=_abbec .(_g .EndElement );_abfcbf &&_gcdec .Name ==start .Name {*_gggbab =1;return nil ;};if _abdgb ,_cgfad :=_abbec .(_g .CharData );!_cgfad {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_abbec );}else {switch string (_abdgb ){case "":*_gggbab =0;case "\u006d\u0061\u0072\u0067\u0069\u006e":*_gggbab =1;case "\u0070\u0061\u0067\u0065":*_gggbab =2;case "\u0063\u006f\u006c\u0075\u006d\u006e":*_gggbab =3;case "\u0063h\u0061\u0072\u0061\u0063\u0074\u0065r":*_gggbab =4;case "\u006c\u0065\u0066\u0074\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =5;case "r\u0069\u0067\u0068\u0074\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =6;case "\u0069\u006e\u0073i\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =7;case "\u006f\u0075\u0074\u0073\u0069\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =8;};};_abbec ,_ccfae =d .Token ();if _ccfae !=nil {return _ccfae ;};if _bcafbf ,_fgffae :=_abbec .(_g .EndElement );_fgffae &&_bcafbf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_abbec );};func (_eagffg ST_VAnchor )String ()string {switch _eagffg {case 0:return "";case 1:return "\u0074\u0065\u0078\u0074";case 2:return "\u006d\u0061\u0072\u0067\u0069\u006e";case 3:return "\u0070\u0061\u0067\u0065";};return "";};func (_cfgca *CT_FFTextType )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cfgca .ValAttr =ST_FFTextType (1);for _ ,_cdacc :=range start .Attr {if _cdacc .Name .Local =="\u0076\u0061\u006c"{_cfgca .ValAttr .UnmarshalXMLAttr (_cdacc );continue ;};};for {_cfecc ,_facee :=d .Token ();if _facee !=nil {return _gd .Errorf ("\u0070a\u0072\u0073\u0069\u006eg\u0020\u0043\u0054\u005f\u0046F\u0054e\u0078t\u0054\u0079\u0070\u0065\u003a\u0020\u0025s",_facee );};if _ggdb ,_gfeddf :=_cfecc .(_g .EndElement );_gfeddf &&_ggdb .Name ==start .Name {break ;};};return nil ;};type ST_SdtDateMappingType byte ;func (_abbae ST_TextboxTightWrap )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_feeeg :=_g .Attr {};_feeeg .Name =name ;switch _abbae {case ST_TextboxTightWrapUnset :_feeeg .Value ="";case ST_TextboxTightWrapNone :_feeeg .Value ="\u006e\u006f\u006e\u0065";case ST_TextboxTightWrapAllLines :_feeeg .Value ="\u0061\u006c\u006c\u004c\u0069\u006e\u0065\u0073";case ST_TextboxTightWrapFirstAndLastLine :_feeeg .Value ="\u0066\u0069r\u0073\u0074\u0041n\u0064\u004c\u0061\u0073\u0074\u004c\u0069\u006e\u0065";case ST_TextboxTightWrapFirstLineOnly :_feeeg .Value ="\u0066\u0069\u0072\u0073\u0074\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079";case ST_TextboxTightWrapLastLineOnly :_feeeg .Value ="\u006c\u0061\u0073t\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079";};return _feeeg ,nil ;};func NewCT_MoveBookmark ()*CT_MoveBookmark {_fcegae :=&CT_MoveBookmark {};return _fcegae };func NewCT_MultiLevelType ()*CT_MultiLevelType {_gbdcc :=&CT_MultiLevelType {};_gbdcc .ValAttr =ST_MultiLevelType (1);return _gbdcc ;};func (_fagdb *CT_RPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fagdb .AuthorAttr )});if _fagdb .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_fagdb .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fagdb .IdAttr )});e .EncodeToken (start );_cbfg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_fagdb .RPr ,_cbfg );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type CT_String struct{
+ValAttr ST_FFTextType ;};func (_edafaa *WdCT_WrapTight )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_fgccfg ,_gfccc :=_edafaa .WrapTextAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u0072\u0061\u0070\u0054\u0065\u0078\u0074"});if _gfccc !=nil {return _gfccc ;};start .Attr =append (start .Attr ,_fgccfg );if _edafaa .DistLAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0064\u0069\u0073t\u004c"},Value :_gd .Sprintf ("\u0025\u0076",*_edafaa .DistLAttr )});};if _edafaa .DistRAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0064\u0069\u0073t\u0052"},Value :_gd .Sprintf ("\u0025\u0076",*_edafaa .DistRAttr )});};e .EncodeToken (start );_begcc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u0070\u003a\u0077\u0072\u0061\u0070\u0050\u006fl\u0079\u0067\u006f\u006e"}};e .EncodeElement (_edafaa .WrapPolygon ,_begcc );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_aebadd *ST_TabTlc )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_adagcf ,_ddfbf :=d .Token ();if _ddfbf !=nil {return _ddfbf ;};if _fbafb ,_edeffaa :=_adagcf .(_g .EndElement );_edeffaa &&_fbafb .Name ==start .Name {*_aebadd =1;return nil ;};if _caeba ,_cebbbb :=_adagcf .(_g .CharData );!_cebbbb {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_adagcf );}else {switch string (_caeba ){case "":*_aebadd =0;case "\u006e\u006f\u006e\u0065":*_aebadd =1;case "\u0064\u006f\u0074":*_aebadd =2;case "\u0068\u0079\u0070\u0068\u0065\u006e":*_aebadd =3;case "\u0075\u006e\u0064\u0065\u0072\u0073\u0063\u006f\u0072\u0065":*_aebadd =4;case "\u0068\u0065\u0061v\u0079":*_aebadd =5;case "\u006di\u0064\u0064\u006c\u0065\u0044\u006ft":*_aebadd =6;};};_adagcf ,_ddfbf =d .Token ();if _ddfbf !=nil {return _ddfbf ;};if _gfedf ,_cagcc :=_adagcf .(_g .EndElement );_cagcc &&_gfedf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_adagcf );};func (_gggbab *WdST_RelFromH )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_abbec ,_ccfae :=d .Token ();if _ccfae !=nil {return _ccfae ;};if _gcdec ,_abfcbf :=_abbec .(_g .EndElement );_abfcbf &&_gcdec .Name ==start .Name {*_gggbab =1;return nil ;};if _abdgb ,_cgfad :=_abbec .(_g .CharData );!_cgfad {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_abbec );}else {switch string (_abdgb ){case "":*_gggbab =0;case "\u006d\u0061\u0072\u0067\u0069\u006e":*_gggbab =1;case "\u0070\u0061\u0067\u0065":*_gggbab =2;case "\u0063\u006f\u006c\u0075\u006d\u006e":*_gggbab =3;case "\u0063h\u0061\u0072\u0061\u0063\u0074\u0065r":*_gggbab =4;case "\u006c\u0065\u0066\u0074\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =5;case "r\u0069\u0067\u0068\u0074\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =6;case "\u0069\u006e\u0073i\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =7;case "\u006f\u0075\u0074\u0073\u0069\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e":*_gggbab =8;};};_abbec ,_ccfae =d .Token ();if _ccfae !=nil {return _ccfae ;};if _bcafbf ,_fgffae :=_abbec .(_g .EndElement );_fgffae &&_bcafbf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_abbec );};func (_eagffg ST_VAnchor )String ()string {switch _eagffg {case 0:return "";case 1:return "\u0074\u0065\u0078\u0074";case 2:return "\u006d\u0061\u0072\u0067\u0069\u006e";case 3:return "\u0070\u0061\u0067\u0065";};return "";};func (_cfgca *CT_FFTextType )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cfgca .ValAttr =ST_FFTextType (1);for _ ,_cdacc :=range start .Attr {if _cdacc .Name .Local =="\u0076\u0061\u006c"{_cfgca .ValAttr .UnmarshalXMLAttr (_cdacc );continue ;};};for {_cfecc ,_facee :=d .Token ();if _facee !=nil {return _gd .Errorf ("\u0070a\u0072\u0073\u0069\u006eg\u0020\u0043\u0054\u005f\u0046F\u0054e\u0078t\u0054\u0079\u0070\u0065\u003a\u0020\u0025s",_facee );};if _ggdb ,_gfeddf :=_cfecc .(_g .EndElement );_gfeddf &&_ggdb .Name ==start .Name {break ;};};return nil ;};type ST_SdtDateMappingType byte ;func (_abbae ST_TextboxTightWrap )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_feeeg :=_g .Attr {};_feeeg .Name =name ;switch _abbae {case ST_TextboxTightWrapUnset :_feeeg .Value ="";case ST_TextboxTightWrapNone :_feeeg .Value ="\u006e\u006f\u006e\u0065";case ST_TextboxTightWrapAllLines :_feeeg .Value ="\u0061\u006c\u006c\u004c\u0069\u006e\u0065\u0073";case ST_TextboxTightWrapFirstAndLastLine :_feeeg .Value ="\u0066\u0069r\u0073\u0074\u0041n\u0064\u004c\u0061\u0073\u0074\u004c\u0069\u006e\u0065";case ST_TextboxTightWrapFirstLineOnly :_feeeg .Value ="\u0066\u0069\u0072\u0073\u0074\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079";case ST_TextboxTightWrapLastLineOnly :_feeeg .Value ="\u006c\u0061\u0073t\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079";};return _feeeg ,nil ;};func NewCT_MoveBookmark ()*CT_MoveBookmark {_fcegae :=&CT_MoveBookmark {};return _fcegae };func NewCT_MultiLevelType ()*CT_MultiLevelType {_gbdcc :=&CT_MultiLevelType {};_gbdcc .ValAttr =ST_MultiLevelType (1);return _gbdcc ;};func (_fagdb *CT_RPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fagdb .AuthorAttr )});if _fagdb .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_fagdb .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fagdb .IdAttr )});e .EncodeToken (start );_cbfg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_fagdb .RPr ,_cbfg );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type CT_String struct{
 
 // String Value
 ValAttr string ;};
@@ -3465,7 +3475,7 @@ func (_edbgg *CT_NumPicBullet )ValidateWithPath (path string )error {if _edbgg .
 func (_dccd *CT_BookmarkRange )ValidateWithPath (path string )error {if _fagc :=_dccd .DisplacedByCustomXmlAttr .ValidateWithPath (path +"\u002fD\u0069\u0073\u0070\u006ca\u0063\u0065\u0064\u0042\u0079C\u0075s\u0074o\u006d\u0058\u006d\u006c\u0041\u0074\u0074r");_fagc !=nil {return _fagc ;};return nil ;};func (_fcebc *CT_Recipients )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );_dggbb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0072e\u0063\u0069\u0070\u0069\u0065\u006e\u0074\u0044\u0061\u0074\u0061"}};for _ ,_fegad :=range _fcebc .RecipientData {e .EncodeElement (_fegad ,_dggbb );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // ValidateWithPath validates the CT_Zoom and its children, prefixing error messages with path
-func (_bdcfca *CT_Zoom )ValidateWithPath (path string )error {if _gefbf :=_bdcfca .ValAttr .ValidateWithPath (path +"\u002f\u0056\u0061\u006c\u0041\u0074\u0074\u0072");_gefbf !=nil {return _gefbf ;};if _dgfed :=_bdcfca .PercentAttr .ValidateWithPath (path +"\u002f\u0050\u0065r\u0063\u0065\u006e\u0074\u0041\u0074\u0074\u0072");_dgfed !=nil {return _dgfed ;};return nil ;};func (_agafg WdST_RelFromV )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_dbacgf :=_g .Attr {};_dbacgf .Name =name ;switch _agafg {case WdST_RelFromVUnset :_dbacgf .Value ="";case WdST_RelFromVMargin :_dbacgf .Value ="\u006d\u0061\u0072\u0067\u0069\u006e";case WdST_RelFromVPage :_dbacgf .Value ="\u0070\u0061\u0067\u0065";case WdST_RelFromVParagraph :_dbacgf .Value ="\u0070a\u0072\u0061\u0067\u0072\u0061\u0070h";case WdST_RelFromVLine :_dbacgf .Value ="\u006c\u0069\u006e\u0065";case WdST_RelFromVTopMargin :_dbacgf .Value ="\u0074o\u0070\u004d\u0061\u0072\u0067\u0069n";case WdST_RelFromVBottomMargin :_dbacgf .Value ="\u0062\u006f\u0074t\u006f\u006d\u004d\u0061\u0072\u0067\u0069\u006e";case WdST_RelFromVInsideMargin :_dbacgf .Value ="\u0069\u006e\u0073i\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e";case WdST_RelFromVOutsideMargin :_dbacgf .Value ="\u006f\u0075\u0074\u0073\u0069\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e";};return _dbacgf ,nil ;};func NewCT_TblCellMar ()*CT_TblCellMar {_bcdfgb :=&CT_TblCellMar {};return _bcdfgb };func NewCT_FtnPos ()*CT_FtnPos {_dgggc :=&CT_FtnPos {};_dgggc .ValAttr =ST_FtnPos (1);return _dgggc };func (_ebfcb *CT_TrackChangeNumbering )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ebfcb .OriginalAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u006f\u0072\u0069\u0067\u0069\u006e\u0061\u006c"},Value :_gd .Sprintf ("\u0025\u0076",*_ebfcb .OriginalAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_ebfcb .AuthorAttr )});if _ebfcb .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_ebfcb .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_ebfcb .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};const (ST_NumberFormatUnset ST_NumberFormat =0;ST_NumberFormatDecimal ST_NumberFormat =1;ST_NumberFormatUpperRoman ST_NumberFormat =2;ST_NumberFormatLowerRoman ST_NumberFormat =3;ST_NumberFormatUpperLetter ST_NumberFormat =4;ST_NumberFormatLowerLetter ST_NumberFormat =5;ST_NumberFormatOrdinal ST_NumberFormat =6;ST_NumberFormatCardinalText ST_NumberFormat =7;ST_NumberFormatOrdinalText ST_NumberFormat =8;ST_NumberFormatHex ST_NumberFormat =9;ST_NumberFormatChicago ST_NumberFormat =10;ST_NumberFormatIdeographDigital ST_NumberFormat =11;ST_NumberFormatJapaneseCounting ST_NumberFormat =12;ST_NumberFormatAiueo ST_NumberFormat =13;ST_NumberFormatIroha ST_NumberFormat =14;ST_NumberFormatDecimalFullWidth ST_NumberFormat =15;ST_NumberFormatDecimalHalfWidth ST_NumberFormat =16;ST_NumberFormatJapaneseLegal ST_NumberFormat =17;ST_NumberFormatJapaneseDigitalTenThousand ST_NumberFormat =18;ST_NumberFormatDecimalEnclosedCircle ST_NumberFormat =19;ST_NumberFormatDecimalFullWidth2 ST_NumberFormat =20;ST_NumberFormatAiueoFullWidth ST_NumberFormat =21;ST_NumberFormatIrohaFullWidth ST_NumberFormat =22;ST_NumberFormatDecimalZero ST_NumberFormat =23;ST_NumberFormatBullet ST_NumberFormat =24;ST_NumberFormatGanada ST_NumberFormat =25;ST_NumberFormatChosung ST_NumberFormat =26;ST_NumberFormatDecimalEnclosedFullstop ST_NumberFormat =27;ST_NumberFormatDecimalEnclosedParen ST_NumberFormat =28;ST_NumberFormatDecimalEnclosedCircleChinese ST_NumberFormat =29;ST_NumberFormatIdeographEnclosedCircle ST_NumberFormat =30;ST_NumberFormatIdeographTraditional ST_NumberFormat =31;ST_NumberFormatIdeographZodiac ST_NumberFormat =32;ST_NumberFormatIdeographZodiacTraditional ST_NumberFormat =33;ST_NumberFormatTaiwaneseCounting ST_NumberFormat =34;ST_NumberFormatIdeographLegalTraditional ST_NumberFormat =35;ST_NumberFormatTaiwaneseCountingThousand ST_NumberFormat =36;ST_NumberFormatTaiwaneseDigital ST_NumberFormat =37;ST_NumberFormatChineseCounting ST_NumberFormat =38;ST_NumberFormatChineseLegalSimplified ST_NumberFormat =39;ST_NumberFormatChineseCountingThousand ST_NumberFormat =40;ST_NumberFormatKoreanDigital ST_NumberFormat =41;ST_NumberFormatKoreanCounting ST_NumberFormat =42;ST_NumberFormatKoreanLegal ST_NumberFormat =43;ST_NumberFormatKoreanDigital2 ST_NumberFormat =44;ST_NumberFormatVietnameseCounting ST_NumberFormat =45;ST_NumberFormatRussianLower ST_NumberFormat =46;ST_NumberFormatRussianUpper ST_NumberFormat =47;ST_NumberFormatNone ST_NumberFormat =48;ST_NumberFormatNumberInDash ST_NumberFormat =49;ST_NumberFormatHebrew1 ST_NumberFormat =50;ST_NumberFormatHebrew2 ST_NumberFormat =51;ST_NumberFormatArabicAlpha ST_NumberFormat =52;ST_NumberFormatArabicAbjad ST_NumberFormat =53;ST_NumberFormatHindiVowels ST_NumberFormat =54;ST_NumberFormatHindiConsonants ST_NumberFormat =55;ST_NumberFormatHindiNumbers ST_NumberFormat =56;ST_NumberFormatHindiCounting ST_NumberFormat =57;ST_NumberFormatThaiLetters ST_NumberFormat =58;ST_NumberFormatThaiNumbers ST_NumberFormat =59;ST_NumberFormatThaiCounting ST_NumberFormat =60;ST_NumberFormatBahtText ST_NumberFormat =61;ST_NumberFormatDollarText ST_NumberFormat =62;ST_NumberFormatCustom ST_NumberFormat =63;);const (ST_PageOrientationUnset ST_PageOrientation =0;ST_PageOrientationPortrait ST_PageOrientation =1;ST_PageOrientationLandscape ST_PageOrientation =2;);func (_gcbffg ST_BrClear )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_gcbffg .String (),start );};
+func (_bdcfca *CT_Zoom )ValidateWithPath (path string )error {if _gefbf :=_bdcfca .ValAttr .ValidateWithPath (path +"\u002f\u0056\u0061\u006c\u0041\u0074\u0074\u0072");_gefbf !=nil {return _gefbf ;};if _dgfed :=_bdcfca .PercentAttr .ValidateWithPath (path +"\u002f\u0050\u0065r\u0063\u0065\u006e\u0074\u0041\u0074\u0074\u0072");_dgfed !=nil {return _dgfed ;};return nil ;};func (_agafg WdST_RelFromV )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_dbacgf :=_g .Attr {};_dbacgf .Name =name ;switch _agafg {case WdST_RelFromVUnset :_dbacgf .Value ="";case WdST_RelFromVMargin :_dbacgf .Value ="\u006d\u0061\u0072\u0067\u0069\u006e";case WdST_RelFromVPage :_dbacgf .Value ="\u0070\u0061\u0067\u0065";case WdST_RelFromVParagraph :_dbacgf .Value ="\u0070a\u0072\u0061\u0067\u0072\u0061\u0070h";case WdST_RelFromVLine :_dbacgf .Value ="\u006c\u0069\u006e\u0065";case WdST_RelFromVTopMargin :_dbacgf .Value ="\u0074o\u0070\u004d\u0061\u0072\u0067\u0069n";case WdST_RelFromVBottomMargin :_dbacgf .Value ="\u0062\u006f\u0074t\u006f\u006d\u004d\u0061\u0072\u0067\u0069\u006e";case WdST_RelFromVInsideMargin :_dbacgf .Value ="\u0069\u006e\u0073i\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e";case WdST_RelFromVOutsideMargin :_dbacgf .Value ="\u006f\u0075\u0074\u0073\u0069\u0064\u0065\u004d\u0061\u0072\u0067\u0069\u006e";};return _dbacgf ,nil ;};func NewCT_TblCellMar ()*CT_TblCellMar {_bcdfgb :=&CT_TblCellMar {};return _bcdfgb };func NewCT_FtnPos ()*CT_FtnPos {_dgggc :=&CT_FtnPos {};_dgggc .ValAttr =ST_FtnPos (1);return _dgggc };func (_ebfcb *CT_TrackChangeNumbering )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ebfcb .OriginalAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u006f\u0072\u0069\u0067\u0069\u006e\u0061\u006c"},Value :_gd .Sprintf ("\u0025\u0076",*_ebfcb .OriginalAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_ebfcb .AuthorAttr )});if _ebfcb .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_ebfcb .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_ebfcb .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};const (ST_NumberFormatUnset ST_NumberFormat =0;ST_NumberFormatDecimal ST_NumberFormat =1;ST_NumberFormatUpperRoman ST_NumberFormat =2;ST_NumberFormatLowerRoman ST_NumberFormat =3;ST_NumberFormatUpperLetter ST_NumberFormat =4;ST_NumberFormatLowerLetter ST_NumberFormat =5;ST_NumberFormatOrdinal ST_NumberFormat =6;ST_NumberFormatCardinalText ST_NumberFormat =7;ST_NumberFormatOrdinalText ST_NumberFormat =8;ST_NumberFormatHex ST_NumberFormat =9;ST_NumberFormatChicago ST_NumberFormat =10;ST_NumberFormatIdeographDigital ST_NumberFormat =11;ST_NumberFormatJapaneseCounting ST_NumberFormat =12;ST_NumberFormatAiueo ST_NumberFormat =13;ST_NumberFormatIroha ST_NumberFormat =14;ST_NumberFormatDecimalFullWidth ST_NumberFormat =15;ST_NumberFormatDecimalHalfWidth ST_NumberFormat =16;ST_NumberFormatJapaneseLegal ST_NumberFormat =17;ST_NumberFormatJapaneseDigitalTenThousand ST_NumberFormat =18;ST_NumberFormatDecimalEnclosedCircle ST_NumberFormat =19;ST_NumberFormatDecimalFullWidth2 ST_NumberFormat =20;ST_NumberFormatAiueoFullWidth ST_NumberFormat =21;ST_NumberFormatIrohaFullWidth ST_NumberFormat =22;ST_NumberFormatDecimalZero ST_NumberFormat =23;ST_NumberFormatBullet ST_NumberFormat =24;ST_NumberFormatGanada ST_NumberFormat =25;ST_NumberFormatChosung ST_NumberFormat =26;ST_NumberFormatDecimalEnclosedFullstop ST_NumberFormat =27;ST_NumberFormatDecimalEnclosedParen ST_NumberFormat =28;ST_NumberFormatDecimalEnclosedCircleChinese ST_NumberFormat =29;ST_NumberFormatIdeographEnclosedCircle ST_NumberFormat =30;ST_NumberFormatIdeographTraditional ST_NumberFormat =31;ST_NumberFormatIdeographZodiac ST_NumberFormat =32;ST_NumberFormatIdeographZodiacTraditional ST_NumberFormat =33;ST_NumberFormatTaiwaneseCounting ST_NumberFormat =34;ST_NumberFormatIdeographLegalTraditional ST_NumberFormat =35;ST_NumberFormatTaiwaneseCountingThousand ST_NumberFormat =36;ST_NumberFormatTaiwaneseDigital ST_NumberFormat =37;ST_NumberFormatChineseCounting ST_NumberFormat =38;ST_NumberFormatChineseLegalSimplified ST_NumberFormat =39;ST_NumberFormatChineseCountingThousand ST_NumberFormat =40;ST_NumberFormatKoreanDigital ST_NumberFormat =41;ST_NumberFormatKoreanCounting ST_NumberFormat =42;ST_NumberFormatKoreanLegal ST_NumberFormat =43;ST_NumberFormatKoreanDigital2 ST_NumberFormat =44;ST_NumberFormatVietnameseCounting ST_NumberFormat =45;ST_NumberFormatRussianLower ST_NumberFormat =46;ST_NumberFormatRussianUpper ST_NumberFormat =47;ST_NumberFormatNone ST_NumberFormat =48;ST_NumberFormatNumberInDash ST_NumberFormat =49;ST_NumberFormatHebrew1 ST_NumberFormat =50;ST_NumberFormatHebrew2 ST_NumberFormat =51;ST_NumberFormatArabicAlpha ST_NumberFormat =52;ST_NumberFormatArabicAbjad ST_NumberFormat =53;ST_NumberFormatHindiVowels ST_NumberFormat =54;ST_NumberFormatHindiConsonants ST_NumberFormat =55;ST_NumberFormatHindiNumbers ST_NumberFormat =56;ST_NumberFormatHindiCounting ST_NumberFormat =57;ST_NumberFormatThaiLetters ST_NumberFormat =58;ST_NumberFormatThaiNumbers ST_NumberFormat =59;ST_NumberFormatThaiCounting ST_NumberFormat =60;ST_NumberFormatBahtText ST_NumberFormat =61;ST_NumberFormatDollarText ST_NumberFormat =62;ST_NumberFormatCustom ST_NumberFormat =63;);const (ST_PageOrientationUnset ST_PageOrientation =0;ST_PageOrientationPortrait ST_PageOrientation =1;ST_PageOrientationLandscape ST_PageOrientation =2;);func (_gcbffg ST_BrClear )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_gcbffg .String (),start );};
 
 // ValidateWithPath validates the CT_SdtContentCell and its children, prefixing error messages with path
 func (_gcede *CT_SdtContentCell )ValidateWithPath (path string )error {for _cgceab ,_gabb :=range _gcede .Tc {if _ggdgf :=_gabb .ValidateWithPath (_gd .Sprintf ("\u0025s\u002f\u0054\u0063\u005b\u0025\u0064]",path ,_cgceab ));_ggdgf !=nil {return _ggdgf ;};};if _gcede .CustomXml !=nil {if _aagcb :=_gcede .CustomXml .ValidateWithPath (path +"\u002f\u0043\u0075\u0073\u0074\u006f\u006d\u0058\u006d\u006c");_aagcb !=nil {return _aagcb ;};};if _gcede .Sdt !=nil {if _cdbfc :=_gcede .Sdt .ValidateWithPath (path +"\u002f\u0053\u0064\u0074");_cdbfc !=nil {return _cdbfc ;};};for _dcae ,_fccab :=range _gcede .EG_RunLevelElts {if _deddc :=_fccab .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0045G_\u0052\u0075\u006e\u004c\u0065\u0076\u0065\u006c\u0045\u006c\u0074\u0073\u005b\u0025d\u005d",path ,_dcae ));_deddc !=nil {return _deddc ;};};return nil ;};func (_eggeda ST_HdrFtr )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_eggeda .String (),start );};func (_gfeag ST_BrClear )String ()string {switch _gfeag {case 0:return "";case 1:return "\u006e\u006f\u006e\u0065";case 2:return "\u006c\u0065\u0066\u0074";case 3:return "\u0072\u0069\u0067h\u0074";case 4:return "\u0061\u006c\u006c";};return "";};func (_gbcgfe *CT_MailMergeSourceType )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_aefbb ,_fgdgf :=_gbcgfe .ValAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076a\u006c"});if _fgdgf !=nil {return _fgdgf ;};start .Attr =append (start .Attr ,_aefbb );e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type CT_TblPr struct{
@@ -3633,7 +3643,7 @@ func (_gagf *CT_Charset )Validate ()error {return _gagf .ValidateWithPath ("\u00
 func (_aabe *CT_FramesetChoice )Validate ()error {return _aabe .ValidateWithPath ("\u0043\u0054\u005f\u0046\u0072\u0061\u006d\u0065\u0073\u0065\u0074\u0043h\u006f\u0069\u0063\u0065");};func (_abdfg ST_CaptionPos )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_bgdfacg :=_g .Attr {};_bgdfacg .Name =name ;switch _abdfg {case ST_CaptionPosUnset :_bgdfacg .Value ="";case ST_CaptionPosAbove :_bgdfacg .Value ="\u0061\u0062\u006fv\u0065";case ST_CaptionPosBelow :_bgdfacg .Value ="\u0062\u0065\u006co\u0077";case ST_CaptionPosLeft :_bgdfacg .Value ="\u006c\u0065\u0066\u0074";case ST_CaptionPosRight :_bgdfacg .Value ="\u0072\u0069\u0067h\u0074";};return _bgdfacg ,nil ;};
 
 // Validate validates the EG_ContentCellContent and its children
-func (_caacc *EG_ContentCellContent )Validate ()error {return _caacc .ValidateWithPath ("E\u0047\u005f\u0043\u006fnt\u0065n\u0074\u0043\u0065\u006c\u006cC\u006f\u006e\u0074\u0065\u006e\u0074");};func (_gdbe *CT_DecimalNumberOrPrecent )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for _ ,_fbcf :=range start .Attr {if _fbcf .Name .Local =="\u0076\u0061\u006c"{_ffade ,_fafcbf :=ParseUnionST_DecimalNumberOrPercent (_fbcf .Value );if _fafcbf !=nil {return _fafcbf ;};_gdbe .ValAttr =_ffade ;continue ;};};for {_gbae ,_aebc :=d .Token ();if _aebc !=nil {return _gd .Errorf ("\u0070\u0061r\u0073\u0069\u006e\u0067 \u0043\u0054_\u0044\u0065\u0063\u0069\u006d\u0061\u006c\u004eu\u006d\u0062\u0065\u0072\u004f\u0072\u0050\u0072\u0065\u0063\u0065\u006et\u003a\u0020\u0025\u0073",_aebc );};if _gaadc ,_cefg :=_gbae .(_g .EndElement );_cefg &&_gaadc .Name ==start .Name {break ;};};return nil ;};const (ST_PTabAlignmentUnset ST_PTabAlignment =0;ST_PTabAlignmentLeft ST_PTabAlignment =1;ST_PTabAlignmentCenter ST_PTabAlignment =2;ST_PTabAlignmentRight ST_PTabAlignment =3;);func (_gbffb *CT_TcPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gbffb .AuthorAttr )});if _gbffb .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_gbffb .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gbffb .IdAttr )});e .EncodeToken (start );_dbcba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0063\u0050\u0072"}};e .EncodeElement (_gbffb .TcPr ,_dbcba );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_Frame ()*CT_Frame {_geebac :=&CT_Frame {};return _geebac };func (_afegdg *CT_TblPrEx )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _afegdg .TblW !=nil {_gfgga :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0057"}};e .EncodeElement (_afegdg .TblW ,_gfgga );};if _afegdg .Jc !=nil {_gceba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006a\u0063"}};e .EncodeElement (_afegdg .Jc ,_gceba );};if _afegdg .TblCellSpacing !=nil {_fegacc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003at\u0062\u006c\u0043e\u006c\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067"}};e .EncodeElement (_afegdg .TblCellSpacing ,_fegacc );};if _afegdg .TblInd !=nil {_acgfec :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0049\u006e\u0064"}};e .EncodeElement (_afegdg .TblInd ,_acgfec );};if _afegdg .TblBorders !=nil {_aaecd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0042\u006f\u0072\u0064\u0065\u0072\u0073"}};e .EncodeElement (_afegdg .TblBorders ,_aaecd );};if _afegdg .Shd !=nil {_bbcga :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_afegdg .Shd ,_bbcga );};if _afegdg .TblLayout !=nil {_ddabfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006c\u004c\u0061\u0079\u006f\u0075\u0074"}};e .EncodeElement (_afegdg .TblLayout ,_ddabfb );};if _afegdg .TblCellMar !=nil {_cbagee :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0043\u0065\u006c\u006c\u004d\u0061\u0072"}};e .EncodeElement (_afegdg .TblCellMar ,_cbagee );};if _afegdg .TblLook !=nil {_cbgfge :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u004c\u006f\u006fk"}};e .EncodeElement (_afegdg .TblLook ,_cbgfge );};if _afegdg .TblPrExChange !=nil {_gafaea :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074b\u006c\u0050\u0072\u0045\u0078\u0043\u0068\u0061\u006e\u0067\u0065"}};e .EncodeElement (_afegdg .TblPrExChange ,_gafaea );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_abddcd *EG_PContentBase )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_defeaa :for {_edbeb ,_fgddf :=d .Token ();if _fgddf !=nil {return _fgddf ;};switch _gafcce :=_edbeb .(type ){case _g .StartElement :switch _gafcce .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_abddcd .CustomXml =NewCT_CustomXmlRun ();if _eeaggd :=d .DecodeElement (_abddcd .CustomXml ,&_gafcce );_eeaggd !=nil {return _eeaggd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066l\u0064\u0053\u0069\u006d\u0070\u006ce"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066l\u0064\u0053\u0069\u006d\u0070\u006ce"}:_afeeg :=NewCT_SimpleField ();if _ffcbec :=d .DecodeElement (_afeeg ,&_gafcce );_ffcbec !=nil {return _ffcbec ;};_abddcd .FldSimple =append (_abddcd .FldSimple ,_afeeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068y\u0070\u0065\u0072\u006c\u0069\u006ek"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068y\u0070\u0065\u0072\u006c\u0069\u006ek"}:_abddcd .Hyperlink =NewCT_Hyperlink ();if _dadabc :=d .DecodeElement (_abddcd .Hyperlink ,&_gafcce );_dadabc !=nil {return _dadabc ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006d\u0065n\u0074\u0020\u006f\u006e\u0020\u0045\u0047\u005f\u0050\u0043\u006fn\u0074\u0065\u006e\u0074\u0042\u0061\u0073\u0065\u0020\u0025\u0076",_gafcce .Name );if _efgdf :=d .Skip ();_efgdf !=nil {return _efgdf ;};};case _g .EndElement :break _defeaa ;case _g .CharData :};};return nil ;};func (_fbdbg ST_PageBorderOffset )String ()string {switch _fbdbg {case 0:return "";case 1:return "\u0070\u0061\u0067\u0065";case 2:return "\u0074\u0065\u0078\u0074";};return "";};type CT_TblPrEx struct{
+func (_caacc *EG_ContentCellContent )Validate ()error {return _caacc .ValidateWithPath ("E\u0047\u005f\u0043\u006fnt\u0065n\u0074\u0043\u0065\u006c\u006cC\u006f\u006e\u0074\u0065\u006e\u0074");};func (_gdbe *CT_DecimalNumberOrPrecent )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for _ ,_fbcf :=range start .Attr {if _fbcf .Name .Local =="\u0076\u0061\u006c"{_ffade ,_fafcbf :=ParseUnionST_DecimalNumberOrPercent (_fbcf .Value );if _fafcbf !=nil {return _fafcbf ;};_gdbe .ValAttr =_ffade ;continue ;};};for {_gbae ,_aebc :=d .Token ();if _aebc !=nil {return _gd .Errorf ("\u0070\u0061r\u0073\u0069\u006e\u0067 \u0043\u0054_\u0044\u0065\u0063\u0069\u006d\u0061\u006c\u004eu\u006d\u0062\u0065\u0072\u004f\u0072\u0050\u0072\u0065\u0063\u0065\u006et\u003a\u0020\u0025\u0073",_aebc );};if _gaadc ,_cefg :=_gbae .(_g .EndElement );_cefg &&_gaadc .Name ==start .Name {break ;};};return nil ;};const (ST_PTabAlignmentUnset ST_PTabAlignment =0;ST_PTabAlignmentLeft ST_PTabAlignment =1;ST_PTabAlignmentCenter ST_PTabAlignment =2;ST_PTabAlignmentRight ST_PTabAlignment =3;);func (_gbffb *CT_TcPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gbffb .AuthorAttr )});if _gbffb .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gbffb .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gbffb .IdAttr )});e .EncodeToken (start );_dbcba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0063\u0050\u0072"}};e .EncodeElement (_gbffb .TcPr ,_dbcba );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_Frame ()*CT_Frame {_geebac :=&CT_Frame {};return _geebac };func (_afegdg *CT_TblPrEx )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _afegdg .TblW !=nil {_gfgga :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0057"}};e .EncodeElement (_afegdg .TblW ,_gfgga );};if _afegdg .Jc !=nil {_gceba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006a\u0063"}};e .EncodeElement (_afegdg .Jc ,_gceba );};if _afegdg .TblCellSpacing !=nil {_fegacc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003at\u0062\u006c\u0043e\u006c\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067"}};e .EncodeElement (_afegdg .TblCellSpacing ,_fegacc );};if _afegdg .TblInd !=nil {_acgfec :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0049\u006e\u0064"}};e .EncodeElement (_afegdg .TblInd ,_acgfec );};if _afegdg .TblBorders !=nil {_aaecd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0042\u006f\u0072\u0064\u0065\u0072\u0073"}};e .EncodeElement (_afegdg .TblBorders ,_aaecd );};if _afegdg .Shd !=nil {_bbcga :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_afegdg .Shd ,_bbcga );};if _afegdg .TblLayout !=nil {_ddabfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006c\u004c\u0061\u0079\u006f\u0075\u0074"}};e .EncodeElement (_afegdg .TblLayout ,_ddabfb );};if _afegdg .TblCellMar !=nil {_cbagee :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0043\u0065\u006c\u006c\u004d\u0061\u0072"}};e .EncodeElement (_afegdg .TblCellMar ,_cbagee );};if _afegdg .TblLook !=nil {_cbgfge :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u004c\u006f\u006fk"}};e .EncodeElement (_afegdg .TblLook ,_cbgfge );};if _afegdg .TblPrExChange !=nil {_gafaea :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074b\u006c\u0050\u0072\u0045\u0078\u0043\u0068\u0061\u006e\u0067\u0065"}};e .EncodeElement (_afegdg .TblPrExChange ,_gafaea );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_abddcd *EG_PContentBase )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_defeaa :for {_edbeb ,_fgddf :=d .Token ();if _fgddf !=nil {return _fgddf ;};switch _gafcce :=_edbeb .(type ){case _g .StartElement :switch _gafcce .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_abddcd .CustomXml =NewCT_CustomXmlRun ();if _eeaggd :=d .DecodeElement (_abddcd .CustomXml ,&_gafcce );_eeaggd !=nil {return _eeaggd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066l\u0064\u0053\u0069\u006d\u0070\u006ce"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066l\u0064\u0053\u0069\u006d\u0070\u006ce"}:_afeeg :=NewCT_SimpleField ();if _ffcbec :=d .DecodeElement (_afeeg ,&_gafcce );_ffcbec !=nil {return _ffcbec ;};_abddcd .FldSimple =append (_abddcd .FldSimple ,_afeeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068y\u0070\u0065\u0072\u006c\u0069\u006ek"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068y\u0070\u0065\u0072\u006c\u0069\u006ek"}:_abddcd .Hyperlink =NewCT_Hyperlink ();if _dadabc :=d .DecodeElement (_abddcd .Hyperlink ,&_gafcce );_dadabc !=nil {return _dadabc ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006d\u0065n\u0074\u0020\u006f\u006e\u0020\u0045\u0047\u005f\u0050\u0043\u006fn\u0074\u0065\u006e\u0074\u0042\u0061\u0073\u0065\u0020\u0025\u0076",_gafcce .Name );if _efgdf :=d .Skip ();_efgdf !=nil {return _efgdf ;};};case _g .EndElement :break _defeaa ;case _g .CharData :};};return nil ;};func (_fbdbg ST_PageBorderOffset )String ()string {switch _fbdbg {case 0:return "";case 1:return "\u0070\u0061\u0067\u0065";case 2:return "\u0074\u0065\u0078\u0074";};return "";};type CT_TblPrEx struct{
 
 // Preferred Table Width Exception
 TblW *CT_TblWidth ;
@@ -3894,7 +3904,7 @@ func (_ebddf *WdCT_TextboxInfo )ValidateWithPath (path string )error {if _fbgeee
 ValAttr uint64 ;};func (_acdga ST_AnnotationVMerge )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_aedfc :=_g .Attr {};_aedfc .Name =name ;switch _acdga {case ST_AnnotationVMergeUnset :_aedfc .Value ="";case ST_AnnotationVMergeCont :_aedfc .Value ="\u0063\u006f\u006e\u0074";case ST_AnnotationVMergeRest :_aedfc .Value ="\u0072\u0065\u0073\u0074";};return _aedfc ,nil ;};
 
 // ValidateWithPath validates the WdEG_WrapType and its children, prefixing error messages with path
-func (_cfcbff *WdEG_WrapType )ValidateWithPath (path string )error {if _cfcbff .Choice !=nil {if _ggbdaf :=_cfcbff .Choice .ValidateWithPath (path +"\u002fC\u0068\u006f\u0069\u0063\u0065");_ggbdaf !=nil {return _ggbdaf ;};};return nil ;};func (_gaddbc *CT_TrackChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gaddbc .AuthorAttr )});if _gaddbc .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_gaddbc .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gaddbc .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_adbad *CT_Ruby )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_adbad .RubyPr =NewCT_RubyPr ();_adbad .Rt =NewCT_RubyContent ();_adbad .RubyBase =NewCT_RubyContent ();_cbdab :for {_gaedg ,_eaage :=d .Token ();if _eaage !=nil {return _eaage ;};switch _ccaea :=_gaedg .(type ){case _g .StartElement :switch _ccaea .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0075\u0062\u0079\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0075\u0062\u0079\u0050\u0072"}:if _bdbcg :=d .DecodeElement (_adbad .RubyPr ,&_ccaea );_bdbcg !=nil {return _bdbcg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0074"}:if _egacb :=d .DecodeElement (_adbad .Rt ,&_ccaea );_egacb !=nil {return _egacb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0075\u0062\u0079\u0042\u0061\u0073\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0075\u0062\u0079\u0042\u0061\u0073\u0065"}:if _bfedb :=d .DecodeElement (_adbad .RubyBase ,&_ccaea );_bfedb !=nil {return _bfedb ;};default:_ga .Log ("\u0073\u006b\u0069p\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043T\u005f\u0052\u0075\u0062\u0079\u0020\u0025\u0076",_ccaea .Name );if _bcafd :=d .Skip ();_bcafd !=nil {return _bcafd ;};};case _g .EndElement :break _cbdab ;case _g .CharData :};};return nil ;};
+func (_cfcbff *WdEG_WrapType )ValidateWithPath (path string )error {if _cfcbff .Choice !=nil {if _ggbdaf :=_cfcbff .Choice .ValidateWithPath (path +"\u002fC\u0068\u006f\u0069\u0063\u0065");_ggbdaf !=nil {return _ggbdaf ;};};return nil ;};func (_gaddbc *CT_TrackChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_gaddbc .AuthorAttr )});if _gaddbc .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gaddbc .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_gaddbc .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_adbad *CT_Ruby )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_adbad .RubyPr =NewCT_RubyPr ();_adbad .Rt =NewCT_RubyContent ();_adbad .RubyBase =NewCT_RubyContent ();_cbdab :for {_gaedg ,_eaage :=d .Token ();if _eaage !=nil {return _eaage ;};switch _ccaea :=_gaedg .(type ){case _g .StartElement :switch _ccaea .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0075\u0062\u0079\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0075\u0062\u0079\u0050\u0072"}:if _bdbcg :=d .DecodeElement (_adbad .RubyPr ,&_ccaea );_bdbcg !=nil {return _bdbcg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0074"}:if _egacb :=d .DecodeElement (_adbad .Rt ,&_ccaea );_egacb !=nil {return _egacb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0075\u0062\u0079\u0042\u0061\u0073\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0075\u0062\u0079\u0042\u0061\u0073\u0065"}:if _bfedb :=d .DecodeElement (_adbad .RubyBase ,&_ccaea );_bfedb !=nil {return _bfedb ;};default:_ga .Log ("\u0073\u006b\u0069p\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043T\u005f\u0052\u0075\u0062\u0079\u0020\u0025\u0076",_ccaea .Name );if _bcafd :=d .Skip ();_bcafd !=nil {return _bcafd ;};};case _g .EndElement :break _cbdab ;case _g .CharData :};};return nil ;};
 
 // Validate validates the CT_MailMerge and its children
 func (_gdaeea *CT_MailMerge )Validate ()error {return _gdaeea .ValidateWithPath ("\u0043\u0054\u005fM\u0061\u0069\u006c\u004d\u0065\u0072\u0067\u0065");};
@@ -4059,7 +4069,7 @@ TypeAttr ST_TblLayoutType ;};
 func (_ggafe *CT_FldChar )Validate ()error {return _ggafe .ValidateWithPath ("\u0043\u0054\u005f\u0046\u006c\u0064\u0043\u0068\u0061\u0072");};func (_dfbecg ST_Theme )ValidateWithPath (path string )error {switch _dfbecg {case 0,1,2,3,4,5,6,7,8:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_dfbecg ));};return nil ;};func (_cfgbfc ST_VAnchor )ValidateWithPath (path string )error {switch _cfgbfc {case 0,1,2,3:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_cfgbfc ));};return nil ;};func (_ddfce *ST_TblOverlap )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_efcda ,_aafafd :=d .Token ();if _aafafd !=nil {return _aafafd ;};if _fbafaf ,_gfefee :=_efcda .(_g .EndElement );_gfefee &&_fbafaf .Name ==start .Name {*_ddfce =1;return nil ;};if _efgdga ,_acgaa :=_efcda .(_g .CharData );!_acgaa {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_efcda );}else {switch string (_efgdga ){case "":*_ddfce =0;case "\u006e\u0065\u0076e\u0072":*_ddfce =1;case "\u006fv\u0065\u0072\u006c\u0061\u0070":*_ddfce =2;};};_efcda ,_aafafd =d .Token ();if _aafafd !=nil {return _aafafd ;};if _adfgc ,_ggdbba :=_efcda .(_g .EndElement );_ggdbba &&_adfgc .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_efcda );};func NewCT_Shd ()*CT_Shd {_gbagg :=&CT_Shd {};_gbagg .ValAttr =ST_Shd (1);return _gbagg };
 
 // Validate validates the CT_PageSz and its children
-func (_dbffac *CT_PageSz )Validate ()error {return _dbffac .ValidateWithPath ("\u0043T\u005f\u0050\u0061\u0067\u0065\u0053z");};func NewCT_EastAsianLayout ()*CT_EastAsianLayout {_acef :=&CT_EastAsianLayout {};return _acef };func (_afccfb *CT_SdtDate )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _afccfb .FullDateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0066\u0075\u006c\u006c\u0044\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_afccfb .FullDateAttr )});};e .EncodeToken (start );if _afccfb .DateFormat !=nil {_fbfggf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0064a\u0074\u0065\u0046\u006f\u0072\u006d\u0061\u0074"}};e .EncodeElement (_afccfb .DateFormat ,_fbfggf );};if _afccfb .Lid !=nil {_geeeg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006ci\u0064"}};e .EncodeElement (_afccfb .Lid ,_geeeg );};if _afccfb .StoreMappedDataAs !=nil {_feabdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0074or\u0065\u004d\u0061\u0070\u0070\u0065\u0064\u0044\u0061\u0074\u0061\u0041\u0073"}};e .EncodeElement (_afccfb .StoreMappedDataAs ,_feabdg );};if _afccfb .Calendar !=nil {_aafge :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u0061\u006c\u0065\u006e\u0064\u0061\u0072"}};e .EncodeElement (_afccfb .Calendar ,_aafge );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_abcbde *WdCT_PosVChoice )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_aeege :for {_ddceg ,_fdcdbce :=d .Token ();if _fdcdbce !=nil {return _fdcdbce ;};switch _ceceae :=_ddceg .(type ){case _g .StartElement :switch _ceceae .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"}:_abcbde .Align =WdST_AlignVUnset ;if _ecgee :=d .DecodeElement (&_abcbde .Align ,&_ceceae );_ecgee !=nil {return _ecgee ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"}:_abcbde .PosOffset =new (int32 );if _gebcb :=d .DecodeElement (_abcbde .PosOffset ,&_ceceae );_gebcb !=nil {return _gebcb ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006d\u0065n\u0074\u0020\u006f\u006e\u0020\u0057\u0064\u0043\u0054\u005f\u0050o\u0073\u0056\u0043\u0068\u006f\u0069\u0063\u0065\u0020\u0025\u0076",_ceceae .Name );if _feecg :=d .Skip ();_feecg !=nil {return _feecg ;};};case _g .EndElement :break _aeege ;case _g .CharData :};};return nil ;};
+func (_dbffac *CT_PageSz )Validate ()error {return _dbffac .ValidateWithPath ("\u0043T\u005f\u0050\u0061\u0067\u0065\u0053z");};func NewCT_EastAsianLayout ()*CT_EastAsianLayout {_acef :=&CT_EastAsianLayout {};return _acef };func (_afccfb *CT_SdtDate )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _afccfb .FullDateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0066\u0075\u006c\u006c\u0044\u0061\u0074\u0065"},Value :_afccfb .FullDateAttr .Format (_f .RFC3339 )});};e .EncodeToken (start );if _afccfb .DateFormat !=nil {_fbfggf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0064a\u0074\u0065\u0046\u006f\u0072\u006d\u0061\u0074"}};e .EncodeElement (_afccfb .DateFormat ,_fbfggf );};if _afccfb .Lid !=nil {_geeeg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006ci\u0064"}};e .EncodeElement (_afccfb .Lid ,_geeeg );};if _afccfb .StoreMappedDataAs !=nil {_feabdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0074or\u0065\u004d\u0061\u0070\u0070\u0065\u0064\u0044\u0061\u0074\u0061\u0041\u0073"}};e .EncodeElement (_afccfb .StoreMappedDataAs ,_feabdg );};if _afccfb .Calendar !=nil {_aafge :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u0061\u006c\u0065\u006e\u0064\u0061\u0072"}};e .EncodeElement (_afccfb .Calendar ,_aafge );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_abcbde *WdCT_PosVChoice )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_aeege :for {_ddceg ,_fdcdbce :=d .Token ();if _fdcdbce !=nil {return _fdcdbce ;};switch _ceceae :=_ddceg .(type ){case _g .StartElement :switch _ceceae .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"}:_abcbde .Align =WdST_AlignVUnset ;if _ecgee :=d .DecodeElement (&_abcbde .Align ,&_ceceae );_ecgee !=nil {return _ecgee ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"}:_abcbde .PosOffset =new (int32 );if _gebcb :=d .DecodeElement (_abcbde .PosOffset ,&_ceceae );_gebcb !=nil {return _gebcb ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006d\u0065n\u0074\u0020\u006f\u006e\u0020\u0057\u0064\u0043\u0054\u005f\u0050o\u0073\u0056\u0043\u0068\u006f\u0069\u0063\u0065\u0020\u0025\u0076",_ceceae .Name );if _feecg :=d .Skip ();_feecg !=nil {return _feecg ;};};case _g .EndElement :break _aeege ;case _g .CharData :};};return nil ;};
 
 // Validate validates the CT_HdrFtrRef and its children
 func (_cbggc *CT_HdrFtrRef )Validate ()error {return _cbggc .ValidateWithPath ("\u0043\u0054\u005fH\u0064\u0072\u0046\u0074\u0072\u0052\u0065\u0066");};func (_aeecec *CT_PPr )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _aeecec .PStyle !=nil {_ecba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_aeecec .PStyle ,_ecba );};if _aeecec .KeepNext !=nil {_bfeae :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006b\u0065\u0065\u0070\u004e\u0065\u0078\u0074"}};e .EncodeElement (_aeecec .KeepNext ,_bfeae );};if _aeecec .KeepLines !=nil {_dbacb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u006b\u0065\u0065\u0070\u004c\u0069\u006e\u0065\u0073"}};e .EncodeElement (_aeecec .KeepLines ,_dbacb );};if _aeecec .PageBreakBefore !=nil {_bfffc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0061\u0067\u0065\u0042\u0072\u0065\u0061\u006b\u0042e\u0066\u006f\u0072\u0065"}};e .EncodeElement (_aeecec .PageBreakBefore ,_bfffc );};if _aeecec .FramePr !=nil {_faecfe :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0066\u0072\u0061\u006d\u0065\u0050r"}};e .EncodeElement (_aeecec .FramePr ,_faecfe );};if _aeecec .WidowControl !=nil {_bgfgc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0077\u0069\u0064\u006f\u0077\u0043\u006fn\u0074\u0072\u006f\u006c"}};e .EncodeElement (_aeecec .WidowControl ,_bgfgc );};if _aeecec .NumPr !=nil {_afead :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006e\u0075\u006d\u0050\u0072"}};e .EncodeElement (_aeecec .NumPr ,_afead );};if _aeecec .SuppressLineNumbers !=nil {_cagd :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0073\u0075\u0070pr\u0065s\u0073\u004c\u0069\u006e\u0065N\u0075\u006d\u0062\u0065\u0072\u0073"}};e .EncodeElement (_aeecec .SuppressLineNumbers ,_cagd );};if _aeecec .PBdr !=nil {_gbfgd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0042\u0064\u0072"}};e .EncodeElement (_aeecec .PBdr ,_gbfgd );};if _aeecec .Shd !=nil {_gcgea :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_aeecec .Shd ,_gcgea );};if _aeecec .Tabs !=nil {_dcbff :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0061\u0062\u0073"}};e .EncodeElement (_aeecec .Tabs ,_dcbff );};if _aeecec .SuppressAutoHyphens !=nil {_gcdfb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0073\u0075\u0070pr\u0065s\u0073\u0041\u0075\u0074\u006fH\u0079\u0070\u0068\u0065\u006e\u0073"}};e .EncodeElement (_aeecec .SuppressAutoHyphens ,_gcdfb );};if _aeecec .Kinsoku !=nil {_feeda :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006b\u0069\u006e\u0073\u006f\u006bu"}};e .EncodeElement (_aeecec .Kinsoku ,_feeda );};if _aeecec .WordWrap !=nil {_agcb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0077\u006f\u0072\u0064\u0057\u0072\u0061\u0070"}};e .EncodeElement (_aeecec .WordWrap ,_agcb );};if _aeecec .OverflowPunct !=nil {_cbgce :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006fv\u0065\u0072\u0066\u006c\u006f\u0077\u0050\u0075\u006e\u0063\u0074"}};e .EncodeElement (_aeecec .OverflowPunct ,_cbgce );};if _aeecec .TopLinePunct !=nil {_deegf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u006f\u0070\u004c\u0069\u006e\u0065P\u0075\u006e\u0063\u0074"}};e .EncodeElement (_aeecec .TopLinePunct ,_deegf );};if _aeecec .AutoSpaceDE !=nil {_gfcaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u006f\u0053\u0070\u0061\u0063\u0065\u0044\u0045"}};e .EncodeElement (_aeecec .AutoSpaceDE ,_gfcaa );};if _aeecec .AutoSpaceDN !=nil {_cdagf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u006f\u0053\u0070\u0061\u0063\u0065\u0044\u004e"}};e .EncodeElement (_aeecec .AutoSpaceDN ,_cdagf );};if _aeecec .Bidi !=nil {_aceea :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062\u0069\u0064\u0069"}};e .EncodeElement (_aeecec .Bidi ,_aceea );};if _aeecec .AdjustRightInd !=nil {_ddgag :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003aa\u0064\u006a\u0075s\u0074\u0052\u0069\u0067\u0068\u0074\u0049\u006e\u0064"}};e .EncodeElement (_aeecec .AdjustRightInd ,_ddgag );};if _aeecec .SnapToGrid !=nil {_dfgcfc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073n\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"}};e .EncodeElement (_aeecec .SnapToGrid ,_dfgcfc );};if _aeecec .Spacing !=nil {_efdgc :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0070\u0061\u0063\u0069\u006eg"}};e .EncodeElement (_aeecec .Spacing ,_efdgc );};if _aeecec .Ind !=nil {_bfcdca :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0069n\u0064"}};e .EncodeElement (_aeecec .Ind ,_bfcdca );};if _aeecec .ContextualSpacing !=nil {_ddaaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u006fnt\u0065\u0078\u0074\u0075\u0061\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067"}};e .EncodeElement (_aeecec .ContextualSpacing ,_ddaaa );};if _aeecec .MirrorIndents !=nil {_fgedd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006di\u0072\u0072\u006f\u0072\u0049\u006e\u0064\u0065\u006e\u0074\u0073"}};e .EncodeElement (_aeecec .MirrorIndents ,_fgedd );};if _aeecec .SuppressOverlap !=nil {_cafgf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0075\u0070\u0070\u0072\u0065\u0073\u0073\u004f\u0076e\u0072\u006c\u0061\u0070"}};e .EncodeElement (_aeecec .SuppressOverlap ,_cafgf );};if _aeecec .Jc !=nil {_gfdgd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006a\u0063"}};e .EncodeElement (_aeecec .Jc ,_gfdgd );};if _aeecec .TextDirection !=nil {_dbfdc :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0044\u0069\u0072\u0065\u0063\u0074\u0069\u006f\u006e"}};e .EncodeElement (_aeecec .TextDirection ,_dbfdc );};if _aeecec .TextAlignment !=nil {_bbdcg :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0041\u006c\u0069\u0067\u006e\u006d\u0065\u006e\u0074"}};e .EncodeElement (_aeecec .TextAlignment ,_bbdcg );};if _aeecec .TextboxTightWrap !=nil {_deafe :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0062\u006f\u0078T\u0069\u0067h\u0074\u0057\u0072\u0061\u0070"}};e .EncodeElement (_aeecec .TextboxTightWrap ,_deafe );};if _aeecec .OutlineLvl !=nil {_dfcge :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006fu\u0074\u006c\u0069\u006e\u0065\u004c\u0076\u006c"}};e .EncodeElement (_aeecec .OutlineLvl ,_dfcge );};if _aeecec .DivId !=nil {_adbdb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0064\u0069\u0076\u0049\u0064"}};e .EncodeElement (_aeecec .DivId ,_adbdb );};if _aeecec .CnfStyle !=nil {_ebcea :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u006e\u0066\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_aeecec .CnfStyle ,_ebcea );};if _aeecec .RPr !=nil {_bagdb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_aeecec .RPr ,_bagdb );};if _aeecec .SectPr !=nil {_dcfdc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0065\u0063\u0074\u0050\u0072"}};e .EncodeElement (_aeecec .SectPr ,_dcfdc );};if _aeecec .PPrChange !=nil {_cbegg :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0070\u0050\u0072\u0043\u0068\u0061\u006e\u0067\u0065"}};e .EncodeElement (_aeecec .PPrChange ,_cbegg );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};const (ST_LineSpacingRuleUnset ST_LineSpacingRule =0;ST_LineSpacingRuleAuto ST_LineSpacingRule =1;ST_LineSpacingRuleExact ST_LineSpacingRule =2;ST_LineSpacingRuleAtLeast ST_LineSpacingRule =3;);type CT_FtnEdnSepRef struct{
@@ -4137,7 +4147,7 @@ FlatBorders *CT_OnOff ;};func (_ddbfg ST_InfoTextType )ValidateWithPath (path st
 func (_cgdab *CT_TcBorders )Validate ()error {return _cgdab .ValidateWithPath ("\u0043\u0054\u005fT\u0063\u0042\u006f\u0072\u0064\u0065\u0072\u0073");};
 
 // ValidateWithPath validates the CT_SmartTagType and its children, prefixing error messages with path
-func (_aafacd *CT_SmartTagType )ValidateWithPath (path string )error {return nil };func (_cfccag *WdCT_PosV )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_bbebfb ,_gagabb :=_cfccag .RelativeFromAttr .MarshalXMLAttr (_g .Name {Local :"\u0072\u0065\u006ca\u0074\u0069\u0076\u0065\u0046\u0072\u006f\u006d"});if _gagabb !=nil {return _gagabb ;};start .Attr =append (start .Attr ,_bbebfb );e .EncodeToken (start );_cfccag .Choice .MarshalXML (e ,_g .StartElement {});e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_geggf *ST_DocPartType )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_geggf =0;case "\u006e\u006f\u006e\u0065":*_geggf =1;case "\u006e\u006f\u0072\u006d\u0061\u006c":*_geggf =2;case "\u0061u\u0074\u006f\u0045\u0078\u0070":*_geggf =3;case "\u0074o\u006f\u006c\u0062\u0061\u0072":*_geggf =4;case "\u0073p\u0065\u006c\u006c\u0065\u0072":*_geggf =5;case "\u0066o\u0072\u006d\u0046\u006c\u0064":*_geggf =6;case "\u0062\u0062\u0050\u006c\u0063\u0048\u0064\u0072":*_geggf =7;};return nil ;};func (_febcc *CT_ParaRPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_febcc .AuthorAttr )});if _febcc .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_febcc .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_febcc .IdAttr )});e .EncodeToken (start );_dfebe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_febcc .RPr ,_dfebe );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+func (_aafacd *CT_SmartTagType )ValidateWithPath (path string )error {return nil };func (_cfccag *WdCT_PosV )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_bbebfb ,_gagabb :=_cfccag .RelativeFromAttr .MarshalXMLAttr (_g .Name {Local :"\u0072\u0065\u006ca\u0074\u0069\u0076\u0065\u0046\u0072\u006f\u006d"});if _gagabb !=nil {return _gagabb ;};start .Attr =append (start .Attr ,_bbebfb );e .EncodeToken (start );_cfccag .Choice .MarshalXML (e ,_g .StartElement {});e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_geggf *ST_DocPartType )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_geggf =0;case "\u006e\u006f\u006e\u0065":*_geggf =1;case "\u006e\u006f\u0072\u006d\u0061\u006c":*_geggf =2;case "\u0061u\u0074\u006f\u0045\u0078\u0070":*_geggf =3;case "\u0074o\u006f\u006c\u0062\u0061\u0072":*_geggf =4;case "\u0073p\u0065\u006c\u006c\u0065\u0072":*_geggf =5;case "\u0066o\u0072\u006d\u0046\u006c\u0064":*_geggf =6;case "\u0062\u0062\u0050\u006c\u0063\u0048\u0064\u0072":*_geggf =7;};return nil ;};func (_febcc *CT_ParaRPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_febcc .AuthorAttr )});if _febcc .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_febcc .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_febcc .IdAttr )});e .EncodeToken (start );_dfebe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_febcc .RPr ,_dfebe );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // Validate validates the CT_FontRel and its children
 func (_cagg *CT_FontRel )Validate ()error {return _cagg .ValidateWithPath ("\u0043\u0054\u005f\u0046\u006f\u006e\u0074\u0052\u0065\u006c");};
@@ -4278,7 +4288,7 @@ func (_aged *CT_Drawing )ValidateWithPath (path string )error {for _agac ,_gceb
 func (_cdbgec *EG_MathContent )Validate ()error {return _cdbgec .ValidateWithPath ("\u0045\u0047\u005f\u004d\u0061\u0074\u0068\u0043\u006fn\u0074\u0065\u006e\u0074");};func (_edddd ST_FrameScrollbar )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_edddd .String (),start );};func NewFonts ()*Fonts {_ebcdeg :=&Fonts {};_ebcdeg .CT_FontsList =*NewCT_FontsList ();return _ebcdeg ;};
 
 // Validate validates the CT_Document and its children
-func (_gfedg *CT_Document )Validate ()error {return _gfedg .ValidateWithPath ("C\u0054\u005f\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074");};func (_dccc *CT_SdtContentRow )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cbcca :for {_fdgcf ,_gdbgg :=d .Token ();if _gdbgg !=nil {return _gdbgg ;};switch _gbdec :=_fdgcf .(type ){case _g .StartElement :switch _gbdec .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0072"}:_acag :=NewCT_Row ();if _ffeaa :=d .DecodeElement (_acag ,&_gbdec );_ffeaa !=nil {return _ffeaa ;};_dccc .Tr =append (_dccc .Tr ,_acag );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_dccc .CustomXml =NewCT_CustomXmlRow ();if _ecggg :=d .DecodeElement (_dccc .CustomXml ,&_gbdec );_ecggg !=nil {return _ecggg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_dccc .Sdt =NewCT_SdtRow ();if _eebaf :=d .DecodeElement (_dccc .Sdt ,&_gbdec );_eebaf !=nil {return _eebaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_aaagf :=NewEG_RunLevelElts ();_aaagf .ProofErr =NewCT_ProofErr ();if _aabda :=d .DecodeElement (_aaagf .ProofErr ,&_gbdec );_aabda !=nil {return _aabda ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_aaagf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_fcaec :=NewEG_RunLevelElts ();_fcaec .PermStart =NewCT_PermStart ();if _daafad :=d .DecodeElement (_fcaec .PermStart ,&_gbdec );_daafad !=nil {return _daafad ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fcaec );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_gegabdd :=NewEG_RunLevelElts ();_gegabdd .PermEnd =NewCT_Perm ();if _cbdge :=d .DecodeElement (_gegabdd .PermEnd ,&_gbdec );_cbdge !=nil {return _cbdge ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gegabdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_fcabf :=NewEG_RunLevelElts ();_fcabf .Ins =NewCT_RunTrackChange ();if _fgbbc :=d .DecodeElement (_fcabf .Ins ,&_gbdec );_fgbbc !=nil {return _fgbbc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fcabf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_feccc :=NewEG_RunLevelElts ();_feccc .Del =NewCT_RunTrackChange ();if _eedbf :=d .DecodeElement (_feccc .Del ,&_gbdec );_eedbf !=nil {return _eedbf ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_feccc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_afbgdd :=NewEG_RunLevelElts ();_afbgdd .MoveFrom =NewCT_RunTrackChange ();if _egdbd :=d .DecodeElement (_afbgdd .MoveFrom ,&_gbdec );_egdbd !=nil {return _egdbd ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_afbgdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_aaabd :=NewEG_RunLevelElts ();_aaabd .MoveTo =NewCT_RunTrackChange ();if _cafcb :=d .DecodeElement (_aaabd .MoveTo ,&_gbdec );_cafcb !=nil {return _cafcb ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_aaabd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_dffag :=NewEG_RunLevelElts ();_dcbfg :=NewEG_RangeMarkupElements ();_dcbfg .BookmarkStart =NewCT_Bookmark ();if _dedbg :=d .DecodeElement (_dcbfg .BookmarkStart ,&_gbdec );_dedbg !=nil {return _dedbg ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_dffag );_dffag .EG_RangeMarkupElements =append (_dffag .EG_RangeMarkupElements ,_dcbfg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_aeebc :=NewEG_RunLevelElts ();_geddg :=NewEG_RangeMarkupElements ();_geddg .BookmarkEnd =NewCT_MarkupRange ();if _badgc :=d .DecodeElement (_geddg .BookmarkEnd ,&_gbdec );_badgc !=nil {return _badgc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_aeebc );_aeebc .EG_RangeMarkupElements =append (_aeebc .EG_RangeMarkupElements ,_geddg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_cdceb :=NewEG_RunLevelElts ();_gdgac :=NewEG_RangeMarkupElements ();_gdgac .MoveFromRangeStart =NewCT_MoveBookmark ();if _ffdcg :=d .DecodeElement (_gdgac .MoveFromRangeStart ,&_gbdec );_ffdcg !=nil {return _ffdcg ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_cdceb );_cdceb .EG_RangeMarkupElements =append (_cdceb .EG_RangeMarkupElements ,_gdgac );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_gdaag :=NewEG_RunLevelElts ();_aefbbg :=NewEG_RangeMarkupElements ();_aefbbg .MoveFromRangeEnd =NewCT_MarkupRange ();if _gadca :=d .DecodeElement (_aefbbg .MoveFromRangeEnd ,&_gbdec );_gadca !=nil {return _gadca ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gdaag );_gdaag .EG_RangeMarkupElements =append (_gdaag .EG_RangeMarkupElements ,_aefbbg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_faaag :=NewEG_RunLevelElts ();_aeebgd :=NewEG_RangeMarkupElements ();_aeebgd .MoveToRangeStart =NewCT_MoveBookmark ();if _abfba :=d .DecodeElement (_aeebgd .MoveToRangeStart ,&_gbdec );_abfba !=nil {return _abfba ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_faaag );_faaag .EG_RangeMarkupElements =append (_faaag .EG_RangeMarkupElements ,_aeebgd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_cdbdfg :=NewEG_RunLevelElts ();_addfe :=NewEG_RangeMarkupElements ();_addfe .MoveToRangeEnd =NewCT_MarkupRange ();if _aafac :=d .DecodeElement (_addfe .MoveToRangeEnd ,&_gbdec );_aafac !=nil {return _aafac ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_cdbdfg );_cdbdfg .EG_RangeMarkupElements =append (_cdbdfg .EG_RangeMarkupElements ,_addfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_gdaca :=NewEG_RunLevelElts ();_cgfe :=NewEG_RangeMarkupElements ();_cgfe .CommentRangeStart =NewCT_MarkupRange ();if _befaa :=d .DecodeElement (_cgfe .CommentRangeStart ,&_gbdec );_befaa !=nil {return _befaa ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gdaca );_gdaca .EG_RangeMarkupElements =append (_gdaca .EG_RangeMarkupElements ,_cgfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_feefa :=NewEG_RunLevelElts ();_agffg :=NewEG_RangeMarkupElements ();_agffg .CommentRangeEnd =NewCT_MarkupRange ();if _ggefd :=d .DecodeElement (_agffg .CommentRangeEnd ,&_gbdec );_ggefd !=nil {return _ggefd ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_feefa );_feefa .EG_RangeMarkupElements =append (_feefa .EG_RangeMarkupElements ,_agffg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_ccfefa :=NewEG_RunLevelElts ();_cgfced :=NewEG_RangeMarkupElements ();_cgfced .CustomXmlInsRangeStart =NewCT_TrackChange ();if _fbcgc :=d .DecodeElement (_cgfced .CustomXmlInsRangeStart ,&_gbdec );_fbcgc !=nil {return _fbcgc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_ccfefa );_ccfefa .EG_RangeMarkupElements =append (_ccfefa .EG_RangeMarkupElements ,_cgfced );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_fegbe :=NewEG_RunLevelElts ();_agbafb :=NewEG_RangeMarkupElements ();_agbafb .CustomXmlInsRangeEnd =NewCT_Markup ();if _dfdbb :=d .DecodeElement (_agbafb .CustomXmlInsRangeEnd ,&_gbdec );_dfdbb !=nil {return _dfdbb ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fegbe );_fegbe .EG_RangeMarkupElements =append (_fegbe .EG_RangeMarkupElements ,_agbafb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_ffada :=NewEG_RunLevelElts ();_gdeaeb :=NewEG_RangeMarkupElements ();_gdeaeb .CustomXmlDelRangeStart =NewCT_TrackChange ();if _bccfa :=d .DecodeElement (_gdeaeb .CustomXmlDelRangeStart ,&_gbdec );_bccfa !=nil {return _bccfa ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_ffada );_ffada .EG_RangeMarkupElements =append (_ffada .EG_RangeMarkupElements ,_gdeaeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_fcfcd :=NewEG_RunLevelElts ();_dcbfd :=NewEG_RangeMarkupElements ();_dcbfd .CustomXmlDelRangeEnd =NewCT_Markup ();if _bebca :=d .DecodeElement (_dcbfd .CustomXmlDelRangeEnd ,&_gbdec );_bebca !=nil {return _bebca ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fcfcd );_fcfcd .EG_RangeMarkupElements =append (_fcfcd .EG_RangeMarkupElements ,_dcbfd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_eabbc :=NewEG_RunLevelElts ();_ceadf :=NewEG_RangeMarkupElements ();_ceadf .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _ccgccc :=d .DecodeElement (_ceadf .CustomXmlMoveFromRangeStart ,&_gbdec );_ccgccc !=nil {return _ccgccc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_eabbc );_eabbc .EG_RangeMarkupElements =append (_eabbc .EG_RangeMarkupElements ,_ceadf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_caeee :=NewEG_RunLevelElts ();_aadea :=NewEG_RangeMarkupElements ();_aadea .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _baefe :=d .DecodeElement (_aadea .CustomXmlMoveFromRangeEnd ,&_gbdec );_baefe !=nil {return _baefe ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_caeee );_caeee .EG_RangeMarkupElements =append (_caeee .EG_RangeMarkupElements ,_aadea );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_cedcbad :=NewEG_RunLevelElts ();_bbecf :=NewEG_RangeMarkupElements ();_bbecf .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _gafcg :=d .DecodeElement (_bbecf .CustomXmlMoveToRangeStart ,&_gbdec );_gafcg !=nil {return _gafcg ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_cedcbad );_cedcbad .EG_RangeMarkupElements =append (_cedcbad .EG_RangeMarkupElements ,_bbecf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_abbca :=NewEG_RunLevelElts ();_dgede :=NewEG_RangeMarkupElements ();_dgede .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _eaafc :=d .DecodeElement (_dgede .CustomXmlMoveToRangeEnd ,&_gbdec );_eaafc !=nil {return _eaafc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_abbca );_abbca .EG_RangeMarkupElements =append (_abbca .EG_RangeMarkupElements ,_dgede );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_acbfeg :=NewEG_RunLevelElts ();_bbfdg :=NewEG_MathContent ();_bbfdg .OMathPara =_ec .NewOMathPara ();if _bfeee :=d .DecodeElement (_bbfdg .OMathPara ,&_gbdec );_bfeee !=nil {return _bfeee ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_acbfeg );_acbfeg .EG_MathContent =append (_acbfeg .EG_MathContent ,_bbfdg );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_gaded :=NewEG_RunLevelElts ();_baga :=NewEG_MathContent ();_baga .OMath =_ec .NewOMath ();if _ebfad :=d .DecodeElement (_baga .OMath ,&_gbdec );_ebfad !=nil {return _ebfad ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gaded );_gaded .EG_MathContent =append (_gaded .EG_MathContent ,_baga );default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0053\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0052\u006f\u0077\u0020\u0025v",_gbdec .Name );if _decab :=d .Skip ();_decab !=nil {return _decab ;};};case _g .EndElement :break _cbcca ;case _g .CharData :};};return nil ;};func NewCT_SdtDate ()*CT_SdtDate {_accabc :=&CT_SdtDate {};return _accabc };func (_bcbfag ST_RubyAlign )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_bcbfag .String (),start );};func (_adecc *CT_TblBorders )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _adecc .Top !=nil {_fabac :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074o\u0070"}};e .EncodeElement (_adecc .Top ,_fabac );};if _adecc .Start !=nil {_fgagb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0074\u0061\u0072\u0074"}};e .EncodeElement (_adecc .Start ,_fgagb );};if _adecc .Left !=nil {_gcdcc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006c\u0065\u0066\u0074"}};e .EncodeElement (_adecc .Left ,_gcdcc );};if _adecc .Bottom !=nil {_aaeba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062\u006f\u0074\u0074\u006f\u006d"}};e .EncodeElement (_adecc .Bottom ,_aaeba );};if _adecc .End !=nil {_dbcdbe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065n\u0064"}};e .EncodeElement (_adecc .End ,_dbcdbe );};if _adecc .Right !=nil {_gbcffg :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0072\u0069\u0067\u0068\u0074"}};e .EncodeElement (_adecc .Right ,_gbcffg );};if _adecc .InsideH !=nil {_cfca :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u006e\u0073\u0069\u0064\u0065H"}};e .EncodeElement (_adecc .InsideH ,_cfca );};if _adecc .InsideV !=nil {_gbff :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u006e\u0073\u0069\u0064\u0065V"}};e .EncodeElement (_adecc .InsideV ,_gbff );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_abfag *WdCT_TextboxInfo )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_abfag .TxbxContent =NewWdCT_TxbxContent ();for _ ,_bdfeaf :=range start .Attr {if _bdfeaf .Name .Local =="\u0069\u0064"{_aedge ,_dbbfca :=_b .ParseUint (_bdfeaf .Value ,10,16);if _dbbfca !=nil {return _dbbfca ;};_dfcdcb :=uint16 (_aedge );_abfag .IdAttr =&_dfcdcb ;continue ;};};_bdaccb :for {_edaba ,_aebda :=d .Token ();if _aebda !=nil {return _aebda ;};switch _afefg :=_edaba .(type ){case _g .StartElement :switch _afefg .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"t\u0078\u0062\u0078\u0043\u006f\u006e\u0074\u0065\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"t\u0078\u0062\u0078\u0043\u006f\u006e\u0074\u0065\u006e\u0074"}:if _cgaba :=d .DecodeElement (_abfag .TxbxContent ,&_afefg );_cgaba !=nil {return _cgaba ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"}:_abfag .ExtLst =_e .NewCT_OfficeArtExtensionList ();if _dafdgb :=d .DecodeElement (_abfag .ExtLst ,&_afefg );_dafdgb !=nil {return _dafdgb ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0057\u0064\u0043\u0054\u005f\u0054\u0065\u0078\u0074\u0062\u006f\u0078\u0049\u006e\u0066\u006f\u0020\u0025v",_afefg .Name );if _eeffd :=d .Skip ();_eeffd !=nil {return _eeffd ;};};case _g .EndElement :break _bdaccb ;case _g .CharData :};};return nil ;};type ST_Theme byte ;func (_gaeabe *ST_InfoTextType )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_gaeabe =0;case "\u0074\u0065\u0078\u0074":*_gaeabe =1;case "\u0061\u0075\u0074\u006f\u0054\u0065\u0078\u0074":*_gaeabe =2;};return nil ;};func (_ffa *CT_AltChunkPr )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_adb :for {_baf ,_beef :=d .Token ();if _beef !=nil {return _beef ;};switch _cg :=_baf .(type ){case _g .StartElement :switch _cg .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u0061\u0074\u0063\u0068\u0053\u0072\u0063"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u0061\u0074\u0063\u0068\u0053\u0072\u0063"}:_ffa .MatchSrc =NewCT_OnOff ();if _aggd :=d .DecodeElement (_ffa .MatchSrc ,&_cg );_aggd !=nil {return _aggd ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067 \u0075\u006e\u0073up\u0070\u006f\u0072\u0074\u0065\u0064 \u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0041l\u0074\u0043\u0068\u0075\u006e\u006b\u0050\u0072 \u0025\u0076",_cg .Name );if _deg :=d .Skip ();_deg !=nil {return _deg ;};};case _g .EndElement :break _adb ;case _g .CharData :};};return nil ;};func (_gcbfed ST_TabJc )ValidateWithPath (path string )error {switch _gcbfed {case 0,1,2,3,4,5,6,7,8,9:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_gcbfed ));};return nil ;};func ParseStdlibTime (s string )(_f .Time ,error ){return _f .Time {},nil };func (_gbg *CT_AutoCaptions )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ebf :for {_eafa ,_edd :=d .Token ();if _edd !=nil {return _edd ;};switch _abg :=_eafa .(type ){case _g .StartElement :switch _abg .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"a\u0075\u0074\u006f\u0043\u0061\u0070\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"a\u0075\u0074\u006f\u0043\u0061\u0070\u0074\u0069\u006f\u006e"}:_ecb :=NewCT_AutoCaption ();if _dbd :=d .DecodeElement (_ecb ,&_abg );_dbd !=nil {return _dbd ;};_gbg .AutoCaption =append (_gbg .AutoCaption ,_ecb );default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006d\u0065n\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0041\u0075\u0074o\u0043\u0061\u0070\u0074\u0069\u006f\u006e\u0073\u0020\u0025\u0076",_abg .Name );if _ggf :=d .Skip ();_ggf !=nil {return _ggf ;};};case _g .EndElement :break _ebf ;case _g .CharData :};};return nil ;};type CT_FFData struct{
+func (_gfedg *CT_Document )Validate ()error {return _gfedg .ValidateWithPath ("C\u0054\u005f\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074");};func (_dccc *CT_SdtContentRow )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cbcca :for {_fdgcf ,_gdbgg :=d .Token ();if _gdbgg !=nil {return _gdbgg ;};switch _gbdec :=_fdgcf .(type ){case _g .StartElement :switch _gbdec .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0072"}:_acag :=NewCT_Row ();if _ffeaa :=d .DecodeElement (_acag ,&_gbdec );_ffeaa !=nil {return _ffeaa ;};_dccc .Tr =append (_dccc .Tr ,_acag );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_dccc .CustomXml =NewCT_CustomXmlRow ();if _ecggg :=d .DecodeElement (_dccc .CustomXml ,&_gbdec );_ecggg !=nil {return _ecggg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_dccc .Sdt =NewCT_SdtRow ();if _eebaf :=d .DecodeElement (_dccc .Sdt ,&_gbdec );_eebaf !=nil {return _eebaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_aaagf :=NewEG_RunLevelElts ();_aaagf .ProofErr =NewCT_ProofErr ();if _aabda :=d .DecodeElement (_aaagf .ProofErr ,&_gbdec );_aabda !=nil {return _aabda ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_aaagf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_fcaec :=NewEG_RunLevelElts ();_fcaec .PermStart =NewCT_PermStart ();if _daafad :=d .DecodeElement (_fcaec .PermStart ,&_gbdec );_daafad !=nil {return _daafad ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fcaec );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_gegabdd :=NewEG_RunLevelElts ();_gegabdd .PermEnd =NewCT_Perm ();if _cbdge :=d .DecodeElement (_gegabdd .PermEnd ,&_gbdec );_cbdge !=nil {return _cbdge ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gegabdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_fcabf :=NewEG_RunLevelElts ();_fcabf .Ins =NewCT_RunTrackChange ();if _fgbbc :=d .DecodeElement (_fcabf .Ins ,&_gbdec );_fgbbc !=nil {return _fgbbc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fcabf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_feccc :=NewEG_RunLevelElts ();_feccc .Del =NewCT_RunTrackChange ();if _eedbf :=d .DecodeElement (_feccc .Del ,&_gbdec );_eedbf !=nil {return _eedbf ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_feccc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_afbgdd :=NewEG_RunLevelElts ();_afbgdd .MoveFrom =NewCT_RunTrackChange ();if _egdbd :=d .DecodeElement (_afbgdd .MoveFrom ,&_gbdec );_egdbd !=nil {return _egdbd ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_afbgdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_aaabd :=NewEG_RunLevelElts ();_aaabd .MoveTo =NewCT_RunTrackChange ();if _cafcb :=d .DecodeElement (_aaabd .MoveTo ,&_gbdec );_cafcb !=nil {return _cafcb ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_aaabd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_dffag :=NewEG_RunLevelElts ();_dcbfg :=NewEG_RangeMarkupElements ();_dcbfg .BookmarkStart =NewCT_Bookmark ();if _dedbg :=d .DecodeElement (_dcbfg .BookmarkStart ,&_gbdec );_dedbg !=nil {return _dedbg ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_dffag );_dffag .EG_RangeMarkupElements =append (_dffag .EG_RangeMarkupElements ,_dcbfg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_aeebc :=NewEG_RunLevelElts ();_geddg :=NewEG_RangeMarkupElements ();_geddg .BookmarkEnd =NewCT_MarkupRange ();if _badgc :=d .DecodeElement (_geddg .BookmarkEnd ,&_gbdec );_badgc !=nil {return _badgc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_aeebc );_aeebc .EG_RangeMarkupElements =append (_aeebc .EG_RangeMarkupElements ,_geddg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_cdceb :=NewEG_RunLevelElts ();_gdgac :=NewEG_RangeMarkupElements ();_gdgac .MoveFromRangeStart =NewCT_MoveBookmark ();if _ffdcg :=d .DecodeElement (_gdgac .MoveFromRangeStart ,&_gbdec );_ffdcg !=nil {return _ffdcg ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_cdceb );_cdceb .EG_RangeMarkupElements =append (_cdceb .EG_RangeMarkupElements ,_gdgac );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_gdaag :=NewEG_RunLevelElts ();_aefbbg :=NewEG_RangeMarkupElements ();_aefbbg .MoveFromRangeEnd =NewCT_MarkupRange ();if _gadca :=d .DecodeElement (_aefbbg .MoveFromRangeEnd ,&_gbdec );_gadca !=nil {return _gadca ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gdaag );_gdaag .EG_RangeMarkupElements =append (_gdaag .EG_RangeMarkupElements ,_aefbbg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_faaag :=NewEG_RunLevelElts ();_aeebgd :=NewEG_RangeMarkupElements ();_aeebgd .MoveToRangeStart =NewCT_MoveBookmark ();if _abfba :=d .DecodeElement (_aeebgd .MoveToRangeStart ,&_gbdec );_abfba !=nil {return _abfba ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_faaag );_faaag .EG_RangeMarkupElements =append (_faaag .EG_RangeMarkupElements ,_aeebgd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_cdbdfg :=NewEG_RunLevelElts ();_addfe :=NewEG_RangeMarkupElements ();_addfe .MoveToRangeEnd =NewCT_MarkupRange ();if _aafac :=d .DecodeElement (_addfe .MoveToRangeEnd ,&_gbdec );_aafac !=nil {return _aafac ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_cdbdfg );_cdbdfg .EG_RangeMarkupElements =append (_cdbdfg .EG_RangeMarkupElements ,_addfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_gdaca :=NewEG_RunLevelElts ();_cgfe :=NewEG_RangeMarkupElements ();_cgfe .CommentRangeStart =NewCT_MarkupRange ();if _befaa :=d .DecodeElement (_cgfe .CommentRangeStart ,&_gbdec );_befaa !=nil {return _befaa ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gdaca );_gdaca .EG_RangeMarkupElements =append (_gdaca .EG_RangeMarkupElements ,_cgfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_feefa :=NewEG_RunLevelElts ();_agffg :=NewEG_RangeMarkupElements ();_agffg .CommentRangeEnd =NewCT_MarkupRange ();if _ggefd :=d .DecodeElement (_agffg .CommentRangeEnd ,&_gbdec );_ggefd !=nil {return _ggefd ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_feefa );_feefa .EG_RangeMarkupElements =append (_feefa .EG_RangeMarkupElements ,_agffg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_ccfefa :=NewEG_RunLevelElts ();_cgfced :=NewEG_RangeMarkupElements ();_cgfced .CustomXmlInsRangeStart =NewCT_TrackChange ();if _fbcgc :=d .DecodeElement (_cgfced .CustomXmlInsRangeStart ,&_gbdec );_fbcgc !=nil {return _fbcgc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_ccfefa );_ccfefa .EG_RangeMarkupElements =append (_ccfefa .EG_RangeMarkupElements ,_cgfced );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_fegbe :=NewEG_RunLevelElts ();_agbafb :=NewEG_RangeMarkupElements ();_agbafb .CustomXmlInsRangeEnd =NewCT_Markup ();if _dfdbb :=d .DecodeElement (_agbafb .CustomXmlInsRangeEnd ,&_gbdec );_dfdbb !=nil {return _dfdbb ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fegbe );_fegbe .EG_RangeMarkupElements =append (_fegbe .EG_RangeMarkupElements ,_agbafb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_ffada :=NewEG_RunLevelElts ();_gdeaeb :=NewEG_RangeMarkupElements ();_gdeaeb .CustomXmlDelRangeStart =NewCT_TrackChange ();if _bccfa :=d .DecodeElement (_gdeaeb .CustomXmlDelRangeStart ,&_gbdec );_bccfa !=nil {return _bccfa ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_ffada );_ffada .EG_RangeMarkupElements =append (_ffada .EG_RangeMarkupElements ,_gdeaeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_fcfcd :=NewEG_RunLevelElts ();_dcbfd :=NewEG_RangeMarkupElements ();_dcbfd .CustomXmlDelRangeEnd =NewCT_Markup ();if _bebca :=d .DecodeElement (_dcbfd .CustomXmlDelRangeEnd ,&_gbdec );_bebca !=nil {return _bebca ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_fcfcd );_fcfcd .EG_RangeMarkupElements =append (_fcfcd .EG_RangeMarkupElements ,_dcbfd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_eabbc :=NewEG_RunLevelElts ();_ceadf :=NewEG_RangeMarkupElements ();_ceadf .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _ccgccc :=d .DecodeElement (_ceadf .CustomXmlMoveFromRangeStart ,&_gbdec );_ccgccc !=nil {return _ccgccc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_eabbc );_eabbc .EG_RangeMarkupElements =append (_eabbc .EG_RangeMarkupElements ,_ceadf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_caeee :=NewEG_RunLevelElts ();_aadea :=NewEG_RangeMarkupElements ();_aadea .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _baefe :=d .DecodeElement (_aadea .CustomXmlMoveFromRangeEnd ,&_gbdec );_baefe !=nil {return _baefe ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_caeee );_caeee .EG_RangeMarkupElements =append (_caeee .EG_RangeMarkupElements ,_aadea );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_cedcbad :=NewEG_RunLevelElts ();_bbecf :=NewEG_RangeMarkupElements ();_bbecf .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _gafcg :=d .DecodeElement (_bbecf .CustomXmlMoveToRangeStart ,&_gbdec );_gafcg !=nil {return _gafcg ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_cedcbad );_cedcbad .EG_RangeMarkupElements =append (_cedcbad .EG_RangeMarkupElements ,_bbecf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_abbca :=NewEG_RunLevelElts ();_dgede :=NewEG_RangeMarkupElements ();_dgede .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _eaafc :=d .DecodeElement (_dgede .CustomXmlMoveToRangeEnd ,&_gbdec );_eaafc !=nil {return _eaafc ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_abbca );_abbca .EG_RangeMarkupElements =append (_abbca .EG_RangeMarkupElements ,_dgede );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_acbfeg :=NewEG_RunLevelElts ();_bbfdg :=NewEG_MathContent ();_bbfdg .OMathPara =_ec .NewOMathPara ();if _bfeee :=d .DecodeElement (_bbfdg .OMathPara ,&_gbdec );_bfeee !=nil {return _bfeee ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_acbfeg );_acbfeg .EG_MathContent =append (_acbfeg .EG_MathContent ,_bbfdg );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_gaded :=NewEG_RunLevelElts ();_baga :=NewEG_MathContent ();_baga .OMath =_ec .NewOMath ();if _ebfad :=d .DecodeElement (_baga .OMath ,&_gbdec );_ebfad !=nil {return _ebfad ;};_dccc .EG_RunLevelElts =append (_dccc .EG_RunLevelElts ,_gaded );_gaded .EG_MathContent =append (_gaded .EG_MathContent ,_baga );default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0053\u0064\u0074\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0052\u006f\u0077\u0020\u0025v",_gbdec .Name );if _decab :=d .Skip ();_decab !=nil {return _decab ;};};case _g .EndElement :break _cbcca ;case _g .CharData :};};return nil ;};func NewCT_SdtDate ()*CT_SdtDate {_accabc :=&CT_SdtDate {};return _accabc };func (_bcbfag ST_RubyAlign )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_bcbfag .String (),start );};func (_adecc *CT_TblBorders )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _adecc .Top !=nil {_fabac :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074o\u0070"}};e .EncodeElement (_adecc .Top ,_fabac );};if _adecc .Start !=nil {_fgagb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0074\u0061\u0072\u0074"}};e .EncodeElement (_adecc .Start ,_fgagb );};if _adecc .Left !=nil {_gcdcc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006c\u0065\u0066\u0074"}};e .EncodeElement (_adecc .Left ,_gcdcc );};if _adecc .Bottom !=nil {_aaeba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062\u006f\u0074\u0074\u006f\u006d"}};e .EncodeElement (_adecc .Bottom ,_aaeba );};if _adecc .End !=nil {_dbcdbe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0065n\u0064"}};e .EncodeElement (_adecc .End ,_dbcdbe );};if _adecc .Right !=nil {_gbcffg :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0072\u0069\u0067\u0068\u0074"}};e .EncodeElement (_adecc .Right ,_gbcffg );};if _adecc .InsideH !=nil {_cfca :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u006e\u0073\u0069\u0064\u0065H"}};e .EncodeElement (_adecc .InsideH ,_cfca );};if _adecc .InsideV !=nil {_gbff :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0069\u006e\u0073\u0069\u0064\u0065V"}};e .EncodeElement (_adecc .InsideV ,_gbff );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_abfag *WdCT_TextboxInfo )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_abfag .TxbxContent =NewWdCT_TxbxContent ();for _ ,_bdfeaf :=range start .Attr {if _bdfeaf .Name .Local =="\u0069\u0064"{_aedge ,_dbbfca :=_b .ParseUint (_bdfeaf .Value ,10,16);if _dbbfca !=nil {return _dbbfca ;};_dfcdcb :=uint16 (_aedge );_abfag .IdAttr =&_dfcdcb ;continue ;};};_bdaccb :for {_edaba ,_aebda :=d .Token ();if _aebda !=nil {return _aebda ;};switch _afefg :=_edaba .(type ){case _g .StartElement :switch _afefg .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"t\u0078\u0062\u0078\u0043\u006f\u006e\u0074\u0065\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"t\u0078\u0062\u0078\u0043\u006f\u006e\u0074\u0065\u006e\u0074"}:if _cgaba :=d .DecodeElement (_abfag .TxbxContent ,&_afefg );_cgaba !=nil {return _cgaba ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0065\u0078\u0074\u004c\u0073\u0074"}:_abfag .ExtLst =_e .NewCT_OfficeArtExtensionList ();if _dafdgb :=d .DecodeElement (_abfag .ExtLst ,&_afefg );_dafdgb !=nil {return _dafdgb ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0057\u0064\u0043\u0054\u005f\u0054\u0065\u0078\u0074\u0062\u006f\u0078\u0049\u006e\u0066\u006f\u0020\u0025v",_afefg .Name );if _eeffd :=d .Skip ();_eeffd !=nil {return _eeffd ;};};case _g .EndElement :break _bdaccb ;case _g .CharData :};};return nil ;};type ST_Theme byte ;func (_gaeabe *ST_InfoTextType )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_gaeabe =0;case "\u0074\u0065\u0078\u0074":*_gaeabe =1;case "\u0061\u0075\u0074\u006f\u0054\u0065\u0078\u0074":*_gaeabe =2;};return nil ;};func (_ffa *CT_AltChunkPr )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_adb :for {_baf ,_beef :=d .Token ();if _beef !=nil {return _beef ;};switch _cg :=_baf .(type ){case _g .StartElement :switch _cg .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u0061\u0074\u0063\u0068\u0053\u0072\u0063"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u0061\u0074\u0063\u0068\u0053\u0072\u0063"}:_ffa .MatchSrc =NewCT_OnOff ();if _aggd :=d .DecodeElement (_ffa .MatchSrc ,&_cg );_aggd !=nil {return _aggd ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067 \u0075\u006e\u0073up\u0070\u006f\u0072\u0074\u0065\u0064 \u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0041l\u0074\u0043\u0068\u0075\u006e\u006b\u0050\u0072 \u0025\u0076",_cg .Name );if _deg :=d .Skip ();_deg !=nil {return _deg ;};};case _g .EndElement :break _adb ;case _g .CharData :};};return nil ;};func (_gcbfed ST_TabJc )ValidateWithPath (path string )error {switch _gcbfed {case 0,1,2,3,4,5,6,7,8,9:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_gcbfed ));};return nil ;};func ParseStdlibTime (s string )(_f .Time ,error ){return _e .ParseStdlibTime (s )};func (_gbg *CT_AutoCaptions )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ebf :for {_eafa ,_edd :=d .Token ();if _edd !=nil {return _edd ;};switch _abg :=_eafa .(type ){case _g .StartElement :switch _abg .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"a\u0075\u0074\u006f\u0043\u0061\u0070\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"a\u0075\u0074\u006f\u0043\u0061\u0070\u0074\u0069\u006f\u006e"}:_ecb :=NewCT_AutoCaption ();if _dbd :=d .DecodeElement (_ecb ,&_abg );_dbd !=nil {return _dbd ;};_gbg .AutoCaption =append (_gbg .AutoCaption ,_ecb );default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006d\u0065n\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0041\u0075\u0074o\u0043\u0061\u0070\u0074\u0069\u006f\u006e\u0073\u0020\u0025\u0076",_abg .Name );if _ggf :=d .Skip ();_ggf !=nil {return _ggf ;};};case _g .EndElement :break _ebf ;case _g .CharData :};};return nil ;};type CT_FFData struct{
 
 // Form Field Name
 Name []*CT_FFName ;
@@ -4673,6 +4683,10 @@ func (_gdeeg *CT_Color )ValidateWithPath (path string )error {if _aagg :=_gdeeg
 // Validate validates the CT_TblGridCol and its children
 func (_ecbbeb *CT_TblGridCol )Validate ()error {return _ecbbeb .ValidateWithPath ("\u0043\u0054\u005f\u0054\u0062\u006c\u0047\u0072\u0069\u0064\u0043\u006f\u006c");};
 
+// ParseUnderline parses an underline style name as used in documents (e.g.
+// "single" or "double").  An empty string returns ST_UnderlineUnset.
+func ParseUnderline (s string )(ST_Underline ,error ){var _dacbg ST_Underline ;if _cgabc :=_dacbg .UnmarshalXMLAttr (_g .Attr {Value :s });_cgabc !=nil {return ST_UnderlineUnset ,_cgabc ;};if _dacbg ==ST_UnderlineUnset &&s !=""{return ST_UnderlineUnset ,_gd .Errorf ("\u0075\u006e\u006b\u006e\u006f\u0077\u006e\u0020\u0075\u006e\u0064\u0065\u0072\u006c\u0069\u006e\u0065\u0020\u0073\u0074\u0079l\u0065\u0020\u0025\u0071",s );};return _dacbg ,nil ;};
+
 // ValidateWithPath validates the EG_RPrMath and its children, prefixing error messages with path
 func (_dbeeb *EG_RPrMath )ValidateWithPath (path string )error {if _dbeeb .Ins !=nil {if _aedaca :=_dbeeb .Ins .ValidateWithPath (path +"\u002f\u0049\u006e\u0073");_aedaca !=nil {return _aedaca ;};};if _dbeeb .Del !=nil {if _dbedd :=_dbeeb .Del .ValidateWithPath (path +"\u002f\u0044\u0065\u006c");_dbedd !=nil {return _dbedd ;};};if _dbeeb .RPr !=nil {if _fadccc :=_dbeeb .RPr .ValidateWithPath (path +"\u002f\u0052\u0050\u0072");_fadccc !=nil {return _fadccc ;};};return nil ;};type CT_PageBorders struct{
 
@@ -5175,7 +5189,7 @@ SpecVanish *CT_OnOff ;
 OMath *CT_OnOff ;
 
 // Revision Information for Run Properties
-RPrChange *CT_RPrChange ;};func (_efagf ST_LevelSuffix )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_befad :=_g .Attr {};_befad .Name =name ;switch _efagf {case ST_LevelSuffixUnset :_befad .Value ="";case ST_LevelSuffixTab :_befad .Value ="\u0074\u0061\u0062";case ST_LevelSuffixSpace :_befad .Value ="\u0073\u0070\u0061c\u0065";case ST_LevelSuffixNothing :_befad .Value ="\u006eo\u0074\u0068\u0069\u006e\u0067";};return _befad ,nil ;};func (_beedcf *WdCT_PosH )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_beedcf .RelativeFromAttr =WdST_RelFromH (1);_beedcf .Choice =NewWdCT_PosHChoice ();for _ ,_bdfea :=range start .Attr {if _bdfea .Name .Local =="\u0072\u0065\u006ca\u0074\u0069\u0076\u0065\u0046\u0072\u006f\u006d"{_beedcf .RelativeFromAttr .UnmarshalXMLAttr (_bdfea );continue ;};};_feabeg :for {_bddff ,_fdgdg :=d .Token ();if _fdgdg !=nil {return _fdgdg ;};switch _gabcfc :=_bddff .(type ){case _g .StartElement :switch _gabcfc .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"}:_beedcf .Choice =NewWdCT_PosHChoice ();if _gdgaba :=d .DecodeElement (&_beedcf .Choice .Align ,&_gabcfc );_gdgaba !=nil {return _gdgaba ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"}:_beedcf .Choice =NewWdCT_PosHChoice ();if _acecc :=d .DecodeElement (&_beedcf .Choice .PosOffset ,&_gabcfc );_acecc !=nil {return _acecc ;};default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067\u0020u\u006e\u0073\u0075p\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006cem\u0065\u006e\u0074 \u006f\u006e \u0057\u0064\u0043\u0054\u005f\u0050o\u0073\u0048 \u0025\u0076",_gabcfc .Name );if _gddege :=d .Skip ();_gddege !=nil {return _gddege ;};};case _g .EndElement :break _feabeg ;case _g .CharData :};};return nil ;};func (_ddeeca *ST_DocPartGallery )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_ddeeca =0;case "p\u006c\u0061\u0063\u0065\u0068\u006f\u006c\u0064\u0065\u0072":*_ddeeca =1;case "\u0061\u006e\u0079":*_ddeeca =2;case "\u0064e\u0066\u0061\u0075\u006c\u0074":*_ddeeca =3;case "\u0064\u006f\u0063\u0050\u0061\u0072\u0074\u0073":*_ddeeca =4;case "\u0063o\u0076\u0065\u0072\u0050\u0067":*_ddeeca =5;case "\u0065\u0071":*_ddeeca =6;case "\u0066\u0074\u0072\u0073":*_ddeeca =7;case "\u0068\u0064\u0072\u0073":*_ddeeca =8;case "\u0070\u0067\u004eu\u006d":*_ddeeca =9;case "\u0074\u0062\u006c\u0073":*_ddeeca =10;case "\u0077\u0061\u0074\u0065\u0072\u006d\u0061\u0072\u006b\u0073":*_ddeeca =11;case "\u0061u\u0074\u006f\u0054\u0078\u0074":*_ddeeca =12;case "\u0074\u0078\u0074\u0042\u006f\u0078":*_ddeeca =13;case "\u0070\u0067\u004e\u0075\u006d\u0054":*_ddeeca =14;case "\u0070\u0067\u004e\u0075\u006d\u0042":*_ddeeca =15;case "\u0070\u0067\u004eu\u006d\u004d\u0061\u0072\u0067\u0069\u006e\u0073":*_ddeeca =16;case "\u0074\u0062\u006c\u004f\u0066\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0073":*_ddeeca =17;case "\u0062\u0069\u0062":*_ddeeca =18;case "\u0063\u0075\u0073\u0074\u0051\u0075\u0069\u0063\u006bP\u0061\u0072\u0074\u0073":*_ddeeca =19;case "c\u0075\u0073\u0074\u0043\u006f\u0076\u0065\u0072\u0050\u0067":*_ddeeca =20;case "\u0063\u0075\u0073\u0074\u0045\u0071":*_ddeeca =21;case "\u0063\u0075\u0073\u0074\u0046\u0074\u0072\u0073":*_ddeeca =22;case "\u0063\u0075\u0073\u0074\u0048\u0064\u0072\u0073":*_ddeeca =23;case "\u0063u\u0073\u0074\u0050\u0067\u004e\u0075m":*_ddeeca =24;case "\u0063\u0075\u0073\u0074\u0054\u0062\u006c\u0073":*_ddeeca =25;case "\u0063\u0075\u0073\u0074\u0057\u0061\u0074\u0065\u0072m\u0061\u0072\u006b\u0073":*_ddeeca =26;case "c\u0075\u0073\u0074\u0041\u0075\u0074\u006f\u0054\u0078\u0074":*_ddeeca =27;case "\u0063\u0075\u0073\u0074\u0054\u0078\u0074\u0042\u006f\u0078":*_ddeeca =28;case "\u0063\u0075\u0073\u0074\u0050\u0067\u004e\u0075\u006d\u0054":*_ddeeca =29;case "\u0063\u0075\u0073\u0074\u0050\u0067\u004e\u0075\u006d\u0042":*_ddeeca =30;case "\u0063\u0075s\u0074\u0050\u0067N\u0075\u006d\u004d\u0061\u0072\u0067\u0069\u006e\u0073":*_ddeeca =31;case "\u0063\u0075\u0073\u0074\u0054\u0062\u006c\u004f\u0066\u0043\u006f\u006et\u0065\u006e\u0074\u0073":*_ddeeca =32;case "\u0063u\u0073\u0074\u0042\u0069\u0062":*_ddeeca =33;case "\u0063u\u0073\u0074\u006f\u006d\u0031":*_ddeeca =34;case "\u0063u\u0073\u0074\u006f\u006d\u0032":*_ddeeca =35;case "\u0063u\u0073\u0074\u006f\u006d\u0033":*_ddeeca =36;case "\u0063u\u0073\u0074\u006f\u006d\u0034":*_ddeeca =37;case "\u0063u\u0073\u0074\u006f\u006d\u0035":*_ddeeca =38;};return nil ;};type WdCT_WrapTopBottom struct{DistTAttr *uint32 ;DistBAttr *uint32 ;EffectExtent *WdCT_EffectExtent ;};type ST_TabJc byte ;func (_cbedca ST_Pitch )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_cbedca .String (),start );};func (_ceaca *CT_SignedHpsMeasure )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076a\u006c"},Value :_gd .Sprintf ("\u0025\u0076",_ceaca .ValAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+RPrChange *CT_RPrChange ;Extra []_ga .Any ;};func (_efagf ST_LevelSuffix )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_befad :=_g .Attr {};_befad .Name =name ;switch _efagf {case ST_LevelSuffixUnset :_befad .Value ="";case ST_LevelSuffixTab :_befad .Value ="\u0074\u0061\u0062";case ST_LevelSuffixSpace :_befad .Value ="\u0073\u0070\u0061c\u0065";case ST_LevelSuffixNothing :_befad .Value ="\u006eo\u0074\u0068\u0069\u006e\u0067";};return _befad ,nil ;};func (_beedcf *WdCT_PosH )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_beedcf .RelativeFromAttr =WdST_RelFromH (1);_beedcf .Choice =NewWdCT_PosHChoice ();for _ ,_bdfea :=range start .Attr {if _bdfea .Name .Local =="\u0072\u0065\u006ca\u0074\u0069\u0076\u0065\u0046\u0072\u006f\u006d"{_beedcf .RelativeFromAttr .UnmarshalXMLAttr (_bdfea );continue ;};};_feabeg :for {_bddff ,_fdgdg :=d .Token ();if _fdgdg !=nil {return _fdgdg ;};switch _gabcfc :=_bddff .(type ){case _g .StartElement :switch _gabcfc .Name {case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0061\u006c\u0069g\u006e"}:_beedcf .Choice =NewWdCT_PosHChoice ();if _gdgaba :=d .DecodeElement (&_beedcf .Choice .Align ,&_gabcfc );_gdgaba !=nil {return _gdgaba ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0064\u0072\u0061w\u0069\u006e\u0067\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006fc\u0065\u0073\u0073\u0069\u006e\u0067D\u0072\u0061\u0077i\u006e\u0067",Local :"\u0070o\u0073\u004f\u0066\u0066\u0073\u0065t"}:_beedcf .Choice =NewWdCT_PosHChoice ();if _acecc :=d .DecodeElement (&_beedcf .Choice .PosOffset ,&_gabcfc );_acecc !=nil {return _acecc ;};default:_ga .Log ("\u0073k\u0069\u0070p\u0069\u006e\u0067\u0020u\u006e\u0073\u0075p\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006cem\u0065\u006e\u0074 \u006f\u006e \u0057\u0064\u0043\u0054\u005f\u0050o\u0073\u0048 \u0025\u0076",_gabcfc .Name );if _gddege :=d .Skip ();_gddege !=nil {return _gddege ;};};case _g .EndElement :break _feabeg ;case _g .CharData :};};return nil ;};func (_ddeeca *ST_DocPartGallery )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_ddeeca =0;case "p\u006c\u0061\u0063\u0065\u0068\u006f\u006c\u0064\u0065\u0072":*_ddeeca =1;case "\u0061\u006e\u0079":*_ddeeca =2;case "\u0064e\u0066\u0061\u0075\u006c\u0074":*_ddeeca =3;case "\u0064\u006f\u0063\u0050\u0061\u0072\u0074\u0073":*_ddeeca =4;case "\u0063o\u0076\u0065\u0072\u0050\u0067":*_ddeeca =5;case "\u0065\u0071":*_ddeeca =6;case "\u0066\u0074\u0072\u0073":*_ddeeca =7;case "\u0068\u0064\u0072\u0073":*_ddeeca =8;case "\u0070\u0067\u004eu\u006d":*_ddeeca =9;case "\u0074\u0062\u006c\u0073":*_ddeeca =10;case "\u0077\u0061\u0074\u0065\u0072\u006d\u0061\u0072\u006b\u0073":*_ddeeca =11;case "\u0061u\u0074\u006f\u0054\u0078\u0074":*_ddeeca =12;case "\u0074\u0078\u0074\u0042\u006f\u0078":*_ddeeca =13;case "\u0070\u0067\u004e\u0075\u006d\u0054":*_ddeeca =14;case "\u0070\u0067\u004e\u0075\u006d\u0042":*_ddeeca =15;case "\u0070\u0067\u004eu\u006d\u004d\u0061\u0072\u0067\u0069\u006e\u0073":*_ddeeca =16;case "\u0074\u0062\u006c\u004f\u0066\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0073":*_ddeeca =17;case "\u0062\u0069\u0062":*_ddeeca =18;case "\u0063\u0075\u0073\u0074\u0051\u0075\u0069\u0063\u006bP\u0061\u0072\u0074\u0073":*_ddeeca =19;case "c\u0075\u0073\u0074\u0043\u006f\u0076\u0065\u0072\u0050\u0067":*_ddeeca =20;case "\u0063\u0075\u0073\u0074\u0045\u0071":*_ddeeca =21;case "\u0063\u0075\u0073\u0074\u0046\u0074\u0072\u0073":*_ddeeca =22;case "\u0063\u0075\u0073\u0074\u0048\u0064\u0072\u0073":*_ddeeca =23;case "\u0063u\u0073\u0074\u0050\u0067\u004e\u0075m":*_ddeeca =24;case "\u0063\u0075\u0073\u0074\u0054\u0062\u006c\u0073":*_ddeeca =25;case "\u0063\u0075\u0073\u0074\u0057\u0061\u0074\u0065\u0072m\u0061\u0072\u006b\u0073":*_ddeeca =26;case "c\u0075\u0073\u0074\u0041\u0075\u0074\u006f\u0054\u0078\u0074":*_ddeeca =27;case "\u0063\u0075\u0073\u0074\u0054\u0078\u0074\u0042\u006f\u0078":*_ddeeca =28;case "\u0063\u0075\u0073\u0074\u0050\u0067\u004e\u0075\u006d\u0054":*_ddeeca =29;case "\u0063\u0075\u0073\u0074\u0050\u0067\u004e\u0075\u006d\u0042":*_ddeeca =30;case "\u0063\u0075s\u0074\u0050\u0067N\u0075\u006d\u004d\u0061\u0072\u0067\u0069\u006e\u0073":*_ddeeca =31;case "\u0063\u0075\u0073\u0074\u0054\u0062\u006c\u004f\u0066\u0043\u006f\u006et\u0065\u006e\u0074\u0073":*_ddeeca =32;case "\u0063u\u0073\u0074\u0042\u0069\u0062":*_ddeeca =33;case "\u0063u\u0073\u0074\u006f\u006d\u0031":*_ddeeca =34;case "\u0063u\u0073\u0074\u006f\u006d\u0032":*_ddeeca =35;case "\u0063u\u0073\u0074\u006f\u006d\u0033":*_ddeeca =36;case "\u0063u\u0073\u0074\u006f\u006d\u0034":*_ddeeca =37;case "\u0063u\u0073\u0074\u006f\u006d\u0035":*_ddeeca =38;};return nil ;};type WdCT_WrapTopBottom struct{DistTAttr *uint32 ;DistBAttr *uint32 ;EffectExtent *WdCT_EffectExtent ;};type ST_TabJc byte ;func (_cbedca ST_Pitch )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_cbedca .String (),start );};func (_ceaca *CT_SignedHpsMeasure )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076a\u006c"},Value :_gd .Sprintf ("\u0025\u0076",_ceaca .ValAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // ValidateWithPath validates the CT_TblLook and its children, prefixing error messages with path
 func (_fcedb *CT_TblLook )ValidateWithPath (path string )error {if _fcedb .FirstRowAttr !=nil {if _gcffb :=_fcedb .FirstRowAttr .ValidateWithPath (path +"\u002f\u0046\u0069\u0072\u0073\u0074\u0052\u006f\u0077\u0041\u0074\u0074\u0072");_gcffb !=nil {return _gcffb ;};};if _fcedb .LastRowAttr !=nil {if _fdecgc :=_fcedb .LastRowAttr .ValidateWithPath (path +"\u002f\u004c\u0061s\u0074\u0052\u006f\u0077\u0041\u0074\u0074\u0072");_fdecgc !=nil {return _fdecgc ;};};if _fcedb .FirstColumnAttr !=nil {if _egbdc :=_fcedb .FirstColumnAttr .ValidateWithPath (path +"\u002f\u0046i\u0072\u0073\u0074C\u006f\u006c\u0075\u006d\u006e\u0041\u0074\u0074\u0072");_egbdc !=nil {return _egbdc ;};};if _fcedb .LastColumnAttr !=nil {if _dagad :=_fcedb .LastColumnAttr .ValidateWithPath (path +"\u002fL\u0061s\u0074\u0043\u006f\u006c\u0075\u006d\u006e\u0041\u0074\u0074\u0072");_dagad !=nil {return _dagad ;};};if _fcedb .NoHBandAttr !=nil {if _debcf :=_fcedb .NoHBandAttr .ValidateWithPath (path +"\u002f\u004e\u006fH\u0042\u0061\u006e\u0064\u0041\u0074\u0074\u0072");_debcf !=nil {return _debcf ;};};if _fcedb .NoVBandAttr !=nil {if _ebcdf :=_fcedb .NoVBandAttr .ValidateWithPath (path +"\u002f\u004e\u006fV\u0042\u0061\u006e\u0064\u0041\u0074\u0074\u0072");_ebcdf !=nil {return _ebcdf ;};};return nil ;};type CT_Guid struct{
@@ -5250,7 +5264,7 @@ SdtPr *CT_SdtPr ;
 SdtEndPr *CT_SdtEndPr ;
 
 // Cell-Level Structured Document Tag Content
-SdtContent *CT_SdtContentCell ;};type ST_View byte ;func (_cacab ST_TabJc )String ()string {switch _cacab {case 0:return "";case 1:return "\u0063\u006c\u0065a\u0072";case 2:return "\u0073\u0074\u0061r\u0074";case 3:return "\u0063\u0065\u006e\u0074\u0065\u0072";case 4:return "\u0065\u006e\u0064";case 5:return "\u0064e\u0063\u0069\u006d\u0061\u006c";case 6:return "\u0062\u0061\u0072";case 7:return "\u006e\u0075\u006d";case 8:return "\u006c\u0065\u0066\u0074";case 9:return "\u0072\u0069\u0067h\u0074";};return "";};func (_ggacac *CT_TblPrBase )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _ggacac .TblStyle !=nil {_bfcea :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_ggacac .TblStyle ,_bfcea );};if _ggacac .TblpPr !=nil {_cbedc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0070\u0050\u0072"}};e .EncodeElement (_ggacac .TblpPr ,_cbedc );};if _ggacac .TblOverlap !=nil {_dacded :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u004f\u0076\u0065\u0072\u006c\u0061\u0070"}};e .EncodeElement (_ggacac .TblOverlap ,_dacded );};if _ggacac .BidiVisual !=nil {_cbcede :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062i\u0064\u0069\u0056\u0069\u0073\u0075\u0061\u006c"}};e .EncodeElement (_ggacac .BidiVisual ,_cbcede );};if _ggacac .TblStyleRowBandSize !=nil {_gebgd :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006cSt\u0079l\u0065\u0052\u006f\u0077\u0042a\u006e\u0064\u0053\u0069\u007a\u0065"}};e .EncodeElement (_ggacac .TblStyleRowBandSize ,_gebgd );};if _ggacac .TblStyleColBandSize !=nil {_fadffg :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006cSt\u0079l\u0065\u0043\u006f\u006c\u0042a\u006e\u0064\u0053\u0069\u007a\u0065"}};e .EncodeElement (_ggacac .TblStyleColBandSize ,_fadffg );};if _ggacac .TblW !=nil {_bbbgg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0057"}};e .EncodeElement (_ggacac .TblW ,_bbbgg );};if _ggacac .Jc !=nil {_aggbe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006a\u0063"}};e .EncodeElement (_ggacac .Jc ,_aggbe );};if _ggacac .TblCellSpacing !=nil {_facdf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003at\u0062\u006c\u0043e\u006c\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067"}};e .EncodeElement (_ggacac .TblCellSpacing ,_facdf );};if _ggacac .TblInd !=nil {_dbecf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0049\u006e\u0064"}};e .EncodeElement (_ggacac .TblInd ,_dbecf );};if _ggacac .TblBorders !=nil {_bcfgcg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0042\u006f\u0072\u0064\u0065\u0072\u0073"}};e .EncodeElement (_ggacac .TblBorders ,_bcfgcg );};if _ggacac .Shd !=nil {_gefbc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_ggacac .Shd ,_gefbc );};if _ggacac .TblLayout !=nil {_bfbgb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006c\u004c\u0061\u0079\u006f\u0075\u0074"}};e .EncodeElement (_ggacac .TblLayout ,_bfbgb );};if _ggacac .TblCellMar !=nil {_gcgdf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0043\u0065\u006c\u006c\u004d\u0061\u0072"}};e .EncodeElement (_ggacac .TblCellMar ,_gcgdf );};if _ggacac .TblLook !=nil {_eggeec :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u004c\u006f\u006fk"}};e .EncodeElement (_ggacac .TblLook ,_eggeec );};if _ggacac .TblCaption !=nil {_cefeg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0043\u0061\u0070\u0074\u0069\u006f\u006e"}};e .EncodeElement (_ggacac .TblCaption ,_cefeg );};if _ggacac .TblDescription !=nil {_ggggb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003at\u0062\u006c\u0044e\u0073\u0063\u0072\u0069\u0070\u0074\u0069\u006f\u006e"}};e .EncodeElement (_ggacac .TblDescription ,_ggggb );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_ebdgce ST_SectionMark )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_ebdgce .String (),start );};func (_gaffc *CT_R )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gaffc .RsidRPrAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0072\u0073\u0069\u0064\u0052\u0050r"},Value :_gd .Sprintf ("\u0025\u0076",*_gaffc .RsidRPrAttr )});};if _gaffc .RsidDelAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0072\u0073\u0069\u0064\u0044\u0065l"},Value :_gd .Sprintf ("\u0025\u0076",*_gaffc .RsidDelAttr )});};if _gaffc .RsidRAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0072\u0073\u0069\u0064\u0052"},Value :_gd .Sprintf ("\u0025\u0076",*_gaffc .RsidRAttr )});};e .EncodeToken (start );if _gaffc .RPr !=nil {_ggeagd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_gaffc .RPr ,_ggeagd );};if _gaffc .EG_RunInnerContent !=nil {for _ ,_baafde :=range _gaffc .EG_RunInnerContent {_baafde .MarshalXML (e ,_g .StartElement {});};};for _ ,_bafee :=range _gaffc .Extra {if _eaccb :=_bafee .MarshalXML (e ,_g .StartElement {});_eaccb !=nil {return _eaccb ;};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+SdtContent *CT_SdtContentCell ;};type ST_View byte ;func (_cacab ST_TabJc )String ()string {switch _cacab {case 0:return "";case 1:return "\u0063\u006c\u0065a\u0072";case 2:return "\u0073\u0074\u0061r\u0074";case 3:return "\u0063\u0065\u006e\u0074\u0065\u0072";case 4:return "\u0065\u006e\u0064";case 5:return "\u0064e\u0063\u0069\u006d\u0061\u006c";case 6:return "\u0062\u0061\u0072";case 7:return "\u006e\u0075\u006d";case 8:return "\u006c\u0065\u0066\u0074";case 9:return "\u0072\u0069\u0067h\u0074";};return "";};func (_ggacac *CT_TblPrBase )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _ggacac .TblStyle !=nil {_bfcea :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_ggacac .TblStyle ,_bfcea );};if _ggacac .TblpPr !=nil {_cbedc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0070\u0050\u0072"}};e .EncodeElement (_ggacac .TblpPr ,_cbedc );};if _ggacac .TblOverlap !=nil {_dacded :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u004f\u0076\u0065\u0072\u006c\u0061\u0070"}};e .EncodeElement (_ggacac .TblOverlap ,_dacded );};if _ggacac .BidiVisual !=nil {_cbcede :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062i\u0064\u0069\u0056\u0069\u0073\u0075\u0061\u006c"}};e .EncodeElement (_ggacac .BidiVisual ,_cbcede );};if _ggacac .TblStyleRowBandSize !=nil {_gebgd :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006cSt\u0079l\u0065\u0052\u006f\u0077\u0042a\u006e\u0064\u0053\u0069\u007a\u0065"}};e .EncodeElement (_ggacac .TblStyleRowBandSize ,_gebgd );};if _ggacac .TblStyleColBandSize !=nil {_fadffg :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006cSt\u0079l\u0065\u0043\u006f\u006c\u0042a\u006e\u0064\u0053\u0069\u007a\u0065"}};e .EncodeElement (_ggacac .TblStyleColBandSize ,_fadffg );};if _ggacac .TblW !=nil {_bbbgg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0057"}};e .EncodeElement (_ggacac .TblW ,_bbbgg );};if _ggacac .Jc !=nil {_aggbe :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006a\u0063"}};e .EncodeElement (_ggacac .Jc ,_aggbe );};if _ggacac .TblCellSpacing !=nil {_facdf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003at\u0062\u006c\u0043e\u006c\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067"}};e .EncodeElement (_ggacac .TblCellSpacing ,_facdf );};if _ggacac .TblInd !=nil {_dbecf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0062\u006c\u0049\u006e\u0064"}};e .EncodeElement (_ggacac .TblInd ,_dbecf );};if _ggacac .TblBorders !=nil {_bcfgcg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0042\u006f\u0072\u0064\u0065\u0072\u0073"}};e .EncodeElement (_ggacac .TblBorders ,_bcfgcg );};if _ggacac .Shd !=nil {_gefbc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_ggacac .Shd ,_gefbc );};if _ggacac .TblLayout !=nil {_bfbgb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0062\u006c\u004c\u0061\u0079\u006f\u0075\u0074"}};e .EncodeElement (_ggacac .TblLayout ,_bfbgb );};if _ggacac .TblCellMar !=nil {_gcgdf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0043\u0065\u006c\u006c\u004d\u0061\u0072"}};e .EncodeElement (_ggacac .TblCellMar ,_gcgdf );};if _ggacac .TblLook !=nil {_eggeec :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u004c\u006f\u006fk"}};e .EncodeElement (_ggacac .TblLook ,_eggeec );};if _ggacac .TblCaption !=nil {_cefeg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074b\u006c\u0043\u0061\u0070\u0074\u0069\u006f\u006e"}};e .EncodeElement (_ggacac .TblCaption ,_cefeg );};if _ggacac .TblDescription !=nil {_ggggb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003at\u0062\u006c\u0044e\u0073\u0063\u0072\u0069\u0070\u0074\u0069\u006f\u006e"}};e .EncodeElement (_ggacac .TblDescription ,_ggggb );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_ebdgce ST_SectionMark )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_ebdgce .String (),start );};func (_gaffc *CT_R )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gaffc .RsidRPrAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0072\u0073\u0069\u0064\u0052\u0050r"},Value :_gd .Sprintf ("\u0025\u0076",*_gaffc .RsidRPrAttr )});};if _gaffc .RsidDelAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0072\u0073\u0069\u0064\u0044\u0065l"},Value :_gd .Sprintf ("\u0025\u0076",*_gaffc .RsidDelAttr )});};if _gaffc .RsidRAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0072\u0073\u0069\u0064\u0052"},Value :_gd .Sprintf ("\u0025\u0076",*_gaffc .RsidRAttr )});};e .EncodeToken (start );if _gaffc .RPr !=nil &&!_gaffc .RPr .isEmpty (){_ggeagd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0072P\u0072"}};e .EncodeElement (_gaffc .RPr ,_ggeagd );};_aefdbc :=map[*EG_RunInnerContent ][]_ga .Any {};_fgadeb :=_gaffc .Extra ;if len (_gaffc ._cfdaeb )> 0{_bcfeda :=map[*EG_RunInnerContent ]bool {nil :true };for _ ,_gaebcd :=range _gaffc .EG_RunInnerContent {_bcfeda [_gaebcd ]=true ;};_fgadeb =nil ;for _ ,_fdcaeb :=range _gaffc .Extra {if _ecabdf ,_bedcfa :=_gaffc ._cfdaeb [_fdcaeb ];_bedcfa &&_bcfeda [_ecabdf ]{_aefdbc [_ecabdf ]=append (_aefdbc [_ecabdf ],_fdcaeb );}else {_fgadeb =append (_fgadeb ,_fdcaeb );};};};for _ ,_bafee :=range _aefdbc [nil ]{if _eaccb :=_bafee .MarshalXML (e ,_g .StartElement {});_eaccb !=nil {return _eaccb ;};};for _ ,_baafde :=range _gaffc .EG_RunInnerContent {_baafde .MarshalXML (e ,_g .StartElement {});for _ ,_bafee :=range _aefdbc [_baafde ]{if _eaccb :=_bafee .MarshalXML (e ,_g .StartElement {});_eaccb !=nil {return _eaccb ;};};};for _ ,_bafee :=range _fgadeb {if _eaccb :=_bafee .MarshalXML (e ,_g .StartElement {});_eaccb !=nil {return _eaccb ;};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // Validate validates the CT_Divs and its children
 func (_ccab *CT_Divs )Validate ()error {return _ccab .ValidateWithPath ("\u0043T\u005f\u0044\u0069\u0076\u0073");};func (_dgggf *CT_Settings )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_beddd :for {_gbfbgc ,_facbf :=d .Token ();if _facbf !=nil {return _facbf ;};switch _eegac :=_gbfbgc .(type ){case _g .StartElement :switch _eegac .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0077r\u0069t\u0065\u0050\u0072\u006f\u0074\u0065\u0063\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0077r\u0069t\u0065\u0050\u0072\u006f\u0074\u0065\u0063\u0074\u0069\u006f\u006e"}:_dgggf .WriteProtection =NewCT_WriteProtection ();if _febfdf :=d .DecodeElement (_dgggf .WriteProtection ,&_eegac );_febfdf !=nil {return _febfdf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0076\u0069\u0065\u0077"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0076\u0069\u0065\u0077"}:_dgggf .View =NewCT_View ();if _fecebd :=d .DecodeElement (_dgggf .View ,&_eegac );_fecebd !=nil {return _fecebd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u007a\u006f\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u007a\u006f\u006f\u006d"}:_dgggf .Zoom =NewCT_Zoom ();if _fdafg :=d .DecodeElement (_dgggf .Zoom ,&_eegac );_fdafg !=nil {return _fdafg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072e\u006d\u006f\u0076\u0065P\u0065\u0072\u0073\u006f\u006ea\u006cI\u006ef\u006f\u0072\u006d\u0061\u0074\u0069\u006fn"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072e\u006d\u006f\u0076\u0065P\u0065\u0072\u0073\u006f\u006ea\u006cI\u006ef\u006f\u0072\u006d\u0061\u0074\u0069\u006fn"}:_dgggf .RemovePersonalInformation =NewCT_OnOff ();if _dafff :=d .DecodeElement (_dgggf .RemovePersonalInformation ,&_eegac );_dafff !=nil {return _dafff ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0065\u006d\u006f\u0076\u0065\u0044\u0061\u0074\u0065\u0041\u006ed\u0054\u0069\u006d\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0065\u006d\u006f\u0076\u0065\u0044\u0061\u0074\u0065\u0041\u006ed\u0054\u0069\u006d\u0065"}:_dgggf .RemoveDateAndTime =NewCT_OnOff ();if _efdcag :=d .DecodeElement (_dgggf .RemoveDateAndTime ,&_eegac );_efdcag !=nil {return _efdcag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u006f\u004e\u006f\u0074\u0044\u0069\u0073\u0070\u006c\u0061y\u0050\u0061\u0067\u0065\u0042\u006f\u0075\u006e\u0064\u0061r\u0069\u0065\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u006f\u004e\u006f\u0074\u0044\u0069\u0073\u0070\u006c\u0061y\u0050\u0061\u0067\u0065\u0042\u006f\u0075\u006e\u0064\u0061r\u0069\u0065\u0073"}:_dgggf .DoNotDisplayPageBoundaries =NewCT_OnOff ();if _dbcac :=d .DecodeElement (_dgggf .DoNotDisplayPageBoundaries ,&_eegac );_dbcac !=nil {return _dbcac ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0069\u0073\u0070la\u0079\u0042\u0061\u0063\u006b\u0067\u0072\u006f\u0075\u006e\u0064\u0053\u0068\u0061p\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0069\u0073\u0070la\u0079\u0042\u0061\u0063\u006b\u0067\u0072\u006f\u0075\u006e\u0064\u0053\u0068\u0061p\u0065"}:_dgggf .DisplayBackgroundShape =NewCT_OnOff ();if _bbde :=d .DecodeElement (_dgggf .DisplayBackgroundShape ,&_eegac );_bbde !=nil {return _bbde ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u0069nt\u0050\u006f\u0073\u0074\u0053\u0063\u0072\u0069\u0070\u0074\u004f\u0076\u0065\u0072\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u0069nt\u0050\u006f\u0073\u0074\u0053\u0063\u0072\u0069\u0070\u0074\u004f\u0076\u0065\u0072\u0054\u0065\u0078\u0074"}:_dgggf .PrintPostScriptOverText =NewCT_OnOff ();if _gcegf :=d .DecodeElement (_dgggf .PrintPostScriptOverText ,&_eegac );_gcegf !=nil {return _gcegf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072i\u006e\u0074\u0046\u0072\u0061\u0063\u0074\u0069\u006f\u006e\u0061\u006c\u0043\u0068\u0061\u0072\u0061\u0063\u0074\u0065\u0072\u0057id\u0074\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072i\u006e\u0074\u0046\u0072\u0061\u0063\u0074\u0069\u006f\u006e\u0061\u006c\u0043\u0068\u0061\u0072\u0061\u0063\u0074\u0065\u0072\u0057id\u0074\u0068"}:_dgggf .PrintFractionalCharacterWidth =NewCT_OnOff ();if _edacaf :=d .DecodeElement (_dgggf .PrintFractionalCharacterWidth ,&_eegac );_edacaf !=nil {return _edacaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u0069\u006e\u0074\u0046\u006f\u0072\u006ds\u0044\u0061\u0074\u0061"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u0069\u006e\u0074\u0046\u006f\u0072\u006ds\u0044\u0061\u0074\u0061"}:_dgggf .PrintFormsData =NewCT_OnOff ();if _gcfgce :=d .DecodeElement (_dgggf .PrintFormsData ,&_eegac );_gcfgce !=nil {return _gcfgce ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065m\u0062e\u0064\u0054\u0072\u0075\u0065T\u0079\u0070e\u0046\u006f\u006e\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065m\u0062e\u0064\u0054\u0072\u0075\u0065T\u0079\u0070e\u0046\u006f\u006e\u0074\u0073"}:_dgggf .EmbedTrueTypeFonts =NewCT_OnOff ();if _bbccf :=d .DecodeElement (_dgggf .EmbedTrueTypeFonts ,&_eegac );_bbccf !=nil {return _bbccf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006db\u0065\u0064\u0053y\u0073\u0074\u0065\u006d\u0046\u006f\u006e\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006db\u0065\u0064\u0053y\u0073\u0074\u0065\u006d\u0046\u006f\u006e\u0074\u0073"}:_dgggf .EmbedSystemFonts =NewCT_OnOff ();if _debec :=d .DecodeElement (_dgggf .EmbedSystemFonts ,&_eegac );_debec !=nil {return _debec ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073a\u0076e\u0053\u0075\u0062\u0073\u0065\u0074\u0046\u006f\u006e\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073a\u0076e\u0053\u0075\u0062\u0073\u0065\u0074\u0046\u006f\u006e\u0074\u0073"}:_dgggf .SaveSubsetFonts =NewCT_OnOff ();if _gceac :=d .DecodeElement (_dgggf .SaveSubsetFonts ,&_eegac );_gceac !=nil {return _gceac ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0061\u0076\u0065\u0046\u006f\u0072\u006d\u0073\u0044\u0061\u0074\u0061"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0061\u0076\u0065\u0046\u006f\u0072\u006d\u0073\u0044\u0061\u0074\u0061"}:_dgggf .SaveFormsData =NewCT_OnOff ();if _edcge :=d .DecodeElement (_dgggf .SaveFormsData ,&_eegac );_edcge !=nil {return _edcge ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u0069\u0072\u0072\u006f\u0072\u004d\u0061\u0072\u0067\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u0069\u0072\u0072\u006f\u0072\u004d\u0061\u0072\u0067\u0069\u006e\u0073"}:_dgggf .MirrorMargins =NewCT_OnOff ();if _eefgf :=d .DecodeElement (_dgggf .MirrorMargins ,&_eegac );_eefgf !=nil {return _eefgf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"a\u006ci\u0067\u006e\u0042\u006f\u0072\u0064\u0065\u0072s\u0041\u006e\u0064\u0045dg\u0065\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"a\u006ci\u0067\u006e\u0042\u006f\u0072\u0064\u0065\u0072s\u0041\u006e\u0064\u0045dg\u0065\u0073"}:_dgggf .AlignBordersAndEdges =NewCT_OnOff ();if _eacfa :=d .DecodeElement (_dgggf .AlignBordersAndEdges ,&_eegac );_eacfa !=nil {return _eacfa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u0072\u0064\u0065\u0072\u0073\u0044\u006f\u004e\u006ft\u0053\u0075\u0072\u0072\u006f\u0075\u006e\u0064\u0048\u0065a\u0064\u0065\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u0072\u0064\u0065\u0072\u0073\u0044\u006f\u004e\u006ft\u0053\u0075\u0072\u0072\u006f\u0075\u006e\u0064\u0048\u0065a\u0064\u0065\u0072"}:_dgggf .BordersDoNotSurroundHeader =NewCT_OnOff ();if _cedbbd :=d .DecodeElement (_dgggf .BordersDoNotSurroundHeader ,&_eegac );_cedbbd !=nil {return _cedbbd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u0072\u0064\u0065\u0072\u0073\u0044\u006f\u004e\u006ft\u0053\u0075\u0072\u0072\u006f\u0075\u006e\u0064\u0046\u006fo\u0074\u0065\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u0072\u0064\u0065\u0072\u0073\u0044\u006f\u004e\u006ft\u0053\u0075\u0072\u0072\u006f\u0075\u006e\u0064\u0046\u006fo\u0074\u0065\u0072"}:_dgggf .BordersDoNotSurroundFooter =NewCT_OnOff ();if _egedb :=d .DecodeElement (_dgggf .BordersDoNotSurroundFooter ,&_eegac );_egedb !=nil {return _egedb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"g\u0075\u0074\u0074\u0065\u0072\u0041\u0074\u0054\u006f\u0070"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"g\u0075\u0074\u0074\u0065\u0072\u0041\u0074\u0054\u006f\u0070"}:_dgggf .GutterAtTop =NewCT_OnOff ();if _aceag :=d .DecodeElement (_dgggf .GutterAtTop ,&_eegac );_aceag !=nil {return _aceag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068i\u0064e\u0053\u0070\u0065\u006c\u006ci\u006e\u0067E\u0072\u0072\u006f\u0072\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068i\u0064e\u0053\u0070\u0065\u006c\u006ci\u006e\u0067E\u0072\u0072\u006f\u0072\u0073"}:_dgggf .HideSpellingErrors =NewCT_OnOff ();if _efeded :=d .DecodeElement (_dgggf .HideSpellingErrors ,&_eegac );_efeded !=nil {return _efeded ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"h\u0069\u0064\u0065\u0047ra\u006dm\u0061\u0074\u0069\u0063\u0061l\u0045\u0072\u0072\u006f\u0072\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"h\u0069\u0064\u0065\u0047ra\u006dm\u0061\u0074\u0069\u0063\u0061l\u0045\u0072\u0072\u006f\u0072\u0073"}:_dgggf .HideGrammaticalErrors =NewCT_OnOff ();if _cbaddd :=d .DecodeElement (_dgggf .HideGrammaticalErrors ,&_eegac );_cbaddd !=nil {return _cbaddd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061c\u0074i\u0076\u0065\u0057\u0072\u0069t\u0069\u006eg\u0053\u0074\u0079\u006c\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061c\u0074i\u0076\u0065\u0057\u0072\u0069t\u0069\u006eg\u0053\u0074\u0079\u006c\u0065"}:_eedaa :=NewCT_WritingStyle ();if _feacgd :=d .DecodeElement (_eedaa ,&_eegac );_feacgd !=nil {return _feacgd ;};_dgggf .ActiveWritingStyle =append (_dgggf .ActiveWritingStyle ,_eedaa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0053\u0074\u0061\u0074\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0053\u0074\u0061\u0074\u0065"}:_dgggf .ProofState =NewCT_Proof ();if _ccgdff :=d .DecodeElement (_dgggf .ProofState ,&_eegac );_ccgdff !=nil {return _ccgdff ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"f\u006f\u0072\u006d\u0073\u0044\u0065\u0073\u0069\u0067\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"f\u006f\u0072\u006d\u0073\u0044\u0065\u0073\u0069\u0067\u006e"}:_dgggf .FormsDesign =NewCT_OnOff ();if _cfcfe :=d .DecodeElement (_dgggf .FormsDesign ,&_eegac );_cfcfe !=nil {return _cfcfe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u0074t\u0061\u0063\u0068e\u0064\u0054\u0065\u006d\u0070\u006c\u0061\u0074\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u0074t\u0061\u0063\u0068e\u0064\u0054\u0065\u006d\u0070\u006c\u0061\u0074\u0065"}:_dgggf .AttachedTemplate =NewCT_Rel ();if _cceedf :=d .DecodeElement (_dgggf .AttachedTemplate ,&_eegac );_cceedf !=nil {return _cceedf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006c\u0069\u006e\u006b\u0053\u0074\u0079\u006c\u0065\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006c\u0069\u006e\u006b\u0053\u0074\u0079\u006c\u0065\u0073"}:_dgggf .LinkStyles =NewCT_OnOff ();if _bfgfg :=d .DecodeElement (_dgggf .LinkStyles ,&_eegac );_bfgfg !=nil {return _bfgfg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"s\u0074\u0079\u006c\u0065Pa\u006ee\u0046\u006f\u0072\u006d\u0061t\u0046\u0069\u006c\u0074\u0065\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"s\u0074\u0079\u006c\u0065Pa\u006ee\u0046\u006f\u0072\u006d\u0061t\u0046\u0069\u006c\u0074\u0065\u0072"}:_dgggf .StylePaneFormatFilter =NewCT_StylePaneFilter ();if _ddbef :=d .DecodeElement (_dgggf .StylePaneFormatFilter ,&_eegac );_ddbef !=nil {return _ddbef ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0074\u0079\u006ceP\u0061\u006e\u0065\u0053\u006f\u0072\u0074\u004d\u0065\u0074\u0068\u006f\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0074\u0079\u006ceP\u0061\u006e\u0065\u0053\u006f\u0072\u0074\u004d\u0065\u0074\u0068\u006f\u0064"}:_dgggf .StylePaneSortMethod =NewCT_StyleSort ();if _bcbeg :=d .DecodeElement (_dgggf .StylePaneSortMethod ,&_eegac );_bcbeg !=nil {return _bcbeg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u006f\u0063u\u006d\u0065\u006e\u0074\u0054\u0079\u0070\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u006f\u0063u\u006d\u0065\u006e\u0074\u0054\u0079\u0070\u0065"}:_dgggf .DocumentType =NewCT_DocType ();if _bfgfa :=d .DecodeElement (_dgggf .DocumentType ,&_eegac );_bfgfa !=nil {return _bfgfa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006da\u0069\u006c\u004d\u0065\u0072\u0067e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006da\u0069\u006c\u004d\u0065\u0072\u0067e"}:_dgggf .MailMerge =NewCT_MailMerge ();if _gbcecd :=d .DecodeElement (_dgggf .MailMerge ,&_eegac );_gbcecd !=nil {return _gbcecd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0065\u0076i\u0073\u0069\u006f\u006e\u0056\u0069\u0065\u0077"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0065\u0076i\u0073\u0069\u006f\u006e\u0056\u0069\u0065\u0077"}:_dgggf .RevisionView =NewCT_TrackChangesView ();if _ddcaf :=d .DecodeElement (_dgggf .RevisionView ,&_eegac );_ddcaf !=nil {return _ddcaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0072\u0061\u0063\u006b\u0052\u0065\u0076\u0069s\u0069\u006f\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0072\u0061\u0063\u006b\u0052\u0065\u0076\u0069s\u0069\u006f\u006e\u0073"}:_dgggf .TrackRevisions =NewCT_OnOff ();if _dadag :=d .DecodeElement (_dgggf .TrackRevisions ,&_eegac );_dadag !=nil {return _dadag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u004eo\u0074\u0054\u0072\u0061\u0063\u006b\u004d\u006f\u0076\u0065\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u004eo\u0074\u0054\u0072\u0061\u0063\u006b\u004d\u006f\u0076\u0065\u0073"}:_dgggf .DoNotTrackMoves =NewCT_OnOff ();if _bedae :=d .DecodeElement (_dgggf .DoNotTrackMoves ,&_eegac );_bedae !=nil {return _bedae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"d\u006fN\u006f\u0074\u0054\u0072\u0061\u0063\u006b\u0046o\u0072\u006d\u0061\u0074ti\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"d\u006fN\u006f\u0074\u0054\u0072\u0061\u0063\u006b\u0046o\u0072\u006d\u0061\u0074ti\u006e\u0067"}:_dgggf .DoNotTrackFormatting =NewCT_OnOff ();if _eeefd :=d .DecodeElement (_dgggf .DoNotTrackFormatting ,&_eegac );_eeefd !=nil {return _eeefd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u0063u\u006d\u0065\u006e\u0074\u0050r\u006f\u0074e\u0063\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u0063u\u006d\u0065\u006e\u0074\u0050r\u006f\u0074e\u0063\u0074\u0069\u006f\u006e"}:_dgggf .DocumentProtection =NewCT_DocProtect ();if _fbefc :=d .DecodeElement (_dgggf .DocumentProtection ,&_eegac );_fbefc !=nil {return _fbefc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061u\u0074o\u0046\u006f\u0072\u006d\u0061t\u004f\u0076e\u0072\u0072\u0069\u0064\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061u\u0074o\u0046\u006f\u0072\u006d\u0061t\u004f\u0076e\u0072\u0072\u0069\u0064\u0065"}:_dgggf .AutoFormatOverride =NewCT_OnOff ();if _eadeb :=d .DecodeElement (_dgggf .AutoFormatOverride ,&_eegac );_eadeb !=nil {return _eadeb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0074\u0079\u006c\u0065\u004c\u006f\u0063\u006bT\u0068\u0065\u006d\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0074\u0079\u006c\u0065\u004c\u006f\u0063\u006bT\u0068\u0065\u006d\u0065"}:_dgggf .StyleLockTheme =NewCT_OnOff ();if _ddbaa :=d .DecodeElement (_dgggf .StyleLockTheme ,&_eegac );_ddbaa !=nil {return _ddbaa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0074\u0079\u006c\u0065\u004c\u006f\u0063\u006bQ\u0046\u0053\u0065\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0074\u0079\u006c\u0065\u004c\u006f\u0063\u006bQ\u0046\u0053\u0065\u0074"}:_dgggf .StyleLockQFSet =NewCT_OnOff ();if _abaeg :=d .DecodeElement (_dgggf .StyleLockQFSet ,&_eegac );_abaeg !=nil {return _abaeg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u0066\u0061\u0075\u006c\u0074\u0054\u0061b\u0053\u0074\u006f\u0070"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u0066\u0061\u0075\u006c\u0074\u0054\u0061b\u0053\u0074\u006f\u0070"}:_dgggf .DefaultTabStop =NewCT_TwipsMeasure ();if _afcaa :=d .DecodeElement (_dgggf .DefaultTabStop ,&_eegac );_afcaa !=nil {return _afcaa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061u\u0074o\u0048\u0079\u0070\u0068\u0065\u006e\u0061\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061u\u0074o\u0048\u0079\u0070\u0068\u0065\u006e\u0061\u0074\u0069\u006f\u006e"}:_dgggf .AutoHyphenation =NewCT_OnOff ();if _caaab :=d .DecodeElement (_dgggf .AutoHyphenation ,&_eegac );_caaab !=nil {return _caaab ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006e\u0073ec\u0075\u0074\u0069\u0076\u0065\u0048\u0079\u0070\u0068\u0065\u006e\u004c\u0069\u006di\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006e\u0073ec\u0075\u0074\u0069\u0076\u0065\u0048\u0079\u0070\u0068\u0065\u006e\u004c\u0069\u006di\u0074"}:_dgggf .ConsecutiveHyphenLimit =NewCT_DecimalNumber ();if _dcgagb :=d .DecodeElement (_dgggf .ConsecutiveHyphenLimit ,&_eegac );_dcgagb !=nil {return _dcgagb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068y\u0070h\u0065\u006e\u0061\u0074\u0069\u006f\u006e\u005a\u006f\u006e\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068y\u0070h\u0065\u006e\u0061\u0074\u0069\u006f\u006e\u005a\u006f\u006e\u0065"}:_dgggf .HyphenationZone =NewCT_TwipsMeasure ();if _bfbcgb :=d .DecodeElement (_dgggf .HyphenationZone ,&_eegac );_bfbcgb !=nil {return _bfbcgb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u004eo\u0074\u0048\u0079\u0070\u0068e\u006e\u0061t\u0065\u0043\u0061\u0070\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u004eo\u0074\u0048\u0079\u0070\u0068e\u006e\u0061t\u0065\u0043\u0061\u0070\u0073"}:_dgggf .DoNotHyphenateCaps =NewCT_OnOff ();if _eeegf :=d .DecodeElement (_dgggf .DoNotHyphenateCaps ,&_eegac );_eeegf !=nil {return _eeegf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0068\u006fw\u0045\u006e\u0076\u0065\u006c\u006f\u0070\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0068\u006fw\u0045\u006e\u0076\u0065\u006c\u006f\u0070\u0065"}:_dgggf .ShowEnvelope =NewCT_OnOff ();if _eaaeb :=d .DecodeElement (_dgggf .ShowEnvelope ,&_eegac );_eaaeb !=nil {return _eaaeb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0075\u006d\u006d\u0061\u0072\u0079\u004c\u0065\u006e\u0067\u0074\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0075\u006d\u006d\u0061\u0072\u0079\u004c\u0065\u006e\u0067\u0074\u0068"}:_dgggf .SummaryLength =NewCT_DecimalNumberOrPrecent ();if _ddcdg :=d .DecodeElement (_dgggf .SummaryLength ,&_eegac );_ddcdg !=nil {return _ddcdg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006c\u0069\u0063\u006b\u0041\u006e\u0064\u0054\u0079\u0070\u0065S\u0074\u0079\u006c\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006c\u0069\u0063\u006b\u0041\u006e\u0064\u0054\u0079\u0070\u0065S\u0074\u0079\u006c\u0065"}:_dgggf .ClickAndTypeStyle =NewCT_String ();if _efggd :=d .DecodeElement (_dgggf .ClickAndTypeStyle ,&_eegac );_efggd !=nil {return _efggd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u0066\u0061\u0075\u006c\u0074\u0054\u0061\u0062\u006c\u0065S\u0074\u0079\u006c\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u0066\u0061\u0075\u006c\u0074\u0054\u0061\u0062\u006c\u0065S\u0074\u0079\u006c\u0065"}:_dgggf .DefaultTableStyle =NewCT_String ();if _cebac :=d .DecodeElement (_dgggf .DefaultTableStyle ,&_eegac );_cebac !=nil {return _cebac ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u0076\u0065\u006e\u0041\u006e\u0064\u004f\u0064\u0064\u0048\u0065a\u0064\u0065\u0072\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u0076\u0065\u006e\u0041\u006e\u0064\u004f\u0064\u0064\u0048\u0065a\u0064\u0065\u0072\u0073"}:_dgggf .EvenAndOddHeaders =NewCT_OnOff ();if _gbegd :=d .DecodeElement (_dgggf .EvenAndOddHeaders ,&_eegac );_gbegd !=nil {return _gbegd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006bFo\u006c\u0064\u0052\u0065\u0076\u0050\u0072\u0069\u006e\u0074\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006bFo\u006c\u0064\u0052\u0065\u0076\u0050\u0072\u0069\u006e\u0074\u0069\u006e\u0067"}:_dgggf .BookFoldRevPrinting =NewCT_OnOff ();if _fcdeb :=d .DecodeElement (_dgggf .BookFoldRevPrinting ,&_eegac );_fcdeb !=nil {return _fcdeb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006fo\u006b\u0046\u006fl\u0064\u0050\u0072\u0069\u006e\u0074\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006fo\u006b\u0046\u006fl\u0064\u0050\u0072\u0069\u006e\u0074\u0069\u006e\u0067"}:_dgggf .BookFoldPrinting =NewCT_OnOff ();if _fgfgg :=d .DecodeElement (_dgggf .BookFoldPrinting ,&_eegac );_fgfgg !=nil {return _fgfgg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006bFo\u006c\u0064\u0050\u0072\u0069\u006e\u0074\u0069\u006e\u0067\u0053\u0068\u0065\u0065t\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006bFo\u006c\u0064\u0050\u0072\u0069\u006e\u0074\u0069\u006e\u0067\u0053\u0068\u0065\u0065t\u0073"}:_dgggf .BookFoldPrintingSheets =NewCT_DecimalNumber ();if _befcge :=d .DecodeElement (_dgggf .BookFoldPrintingSheets ,&_eegac );_befcge !=nil {return _befcge ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0072\u0061\u0077i\u006e\u0067\u0047\u0072\u0069\u0064\u0048\u006f\u0072\u0069z\u006fn\u0074\u0061\u006c\u0053\u0070\u0061\u0063i\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0072\u0061\u0077i\u006e\u0067\u0047\u0072\u0069\u0064\u0048\u006f\u0072\u0069z\u006fn\u0074\u0061\u006c\u0053\u0070\u0061\u0063i\u006e\u0067"}:_dgggf .DrawingGridHorizontalSpacing =NewCT_TwipsMeasure ();if _caeccc :=d .DecodeElement (_dgggf .DrawingGridHorizontalSpacing ,&_eegac );_caeccc !=nil {return _caeccc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u0047\u0072\u0069\u0064V\u0065\u0072\u0074\u0069\u0063\u0061\u006c\u0053\u0070\u0061c\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u0047\u0072\u0069\u0064V\u0065\u0072\u0074\u0069\u0063\u0061\u006c\u0053\u0070\u0061c\u0069\u006e\u0067"}:_dgggf .DrawingGridVerticalSpacing =NewCT_TwipsMeasure ();if _eecde :=d .DecodeElement (_dgggf .DrawingGridVerticalSpacing ,&_eegac );_eecde !=nil {return _eecde ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0069\u0073\u0070\u006c\u0061y\u0048\u006f\u0072\u0069\u007a\u006f\u006e\u0074\u0061\u006c\u0044\u0072\u0061w\u0069\u006e\u0067\u0047\u0072\u0069\u0064E\u0076\u0065\u0072\u0079"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0069\u0073\u0070\u006c\u0061y\u0048\u006f\u0072\u0069\u007a\u006f\u006e\u0074\u0061\u006c\u0044\u0072\u0061w\u0069\u006e\u0067\u0047\u0072\u0069\u0064E\u0076\u0065\u0072\u0079"}:_dgggf .DisplayHorizontalDrawingGridEvery =NewCT_DecimalNumber ();if _bfegb :=d .DecodeElement (_dgggf .DisplayHorizontalDrawingGridEvery ,&_eegac );_bfegb !=nil {return _bfegb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0069\u0073p\u006c\u0061\u0079\u0056e\u0072\u0074\u0069\u0063\u0061\u006c\u0044r\u0061\u0077\u0069\u006e\u0067\u0047\u0072\u0069\u0064\u0045\u0076\u0065\u0072\u0079"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0069\u0073p\u006c\u0061\u0079\u0056e\u0072\u0074\u0069\u0063\u0061\u006c\u0044r\u0061\u0077\u0069\u006e\u0067\u0047\u0072\u0069\u0064\u0045\u0076\u0065\u0072\u0079"}:_dgggf .DisplayVerticalDrawingGridEvery =NewCT_DecimalNumber ();if _adffce :=d .DecodeElement (_dgggf .DisplayVerticalDrawingGridEvery ,&_eegac );_adffce !=nil {return _adffce ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"d\u006f\u004e\u006f\u0074\u0055\u0073e\u004d\u0061\u0072\u0067\u0069\u006es\u0046\u006f\u0072\u0044\u0072\u0061\u0077i\u006e\u0067\u0047\u0072\u0069\u0064\u004f\u0072\u0069\u0067i\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"d\u006f\u004e\u006f\u0074\u0055\u0073e\u004d\u0061\u0072\u0067\u0069\u006es\u0046\u006f\u0072\u0044\u0072\u0061\u0077i\u006e\u0067\u0047\u0072\u0069\u0064\u004f\u0072\u0069\u0067i\u006e"}:_dgggf .DoNotUseMarginsForDrawingGridOrigin =NewCT_OnOff ();if _bdcafd :=d .DecodeElement (_dgggf .DoNotUseMarginsForDrawingGridOrigin ,&_eegac );_bdcafd !=nil {return _bdcafd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"d\u0072\u0061\u0077\u0069\u006e\u0067G\u0072\u0069\u0064\u0048\u006f\u0072\u0069\u007a\u006fn\u0074\u0061\u006cO\u0072i\u0067\u0069\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"d\u0072\u0061\u0077\u0069\u006e\u0067G\u0072\u0069\u0064\u0048\u006f\u0072\u0069\u007a\u006fn\u0074\u0061\u006cO\u0072i\u0067\u0069\u006e"}:_dgggf .DrawingGridHorizontalOrigin =NewCT_TwipsMeasure ();if _gecbd :=d .DecodeElement (_dgggf .DrawingGridHorizontalOrigin ,&_eegac );_gecbd !=nil {return _gecbd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064r\u0061\u0077\u0069\u006eg\u0047\u0072\u0069\u0064\u0056e\u0072t\u0069c\u0061\u006c\u004f\u0072\u0069\u0067\u0069n"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064r\u0061\u0077\u0069\u006eg\u0047\u0072\u0069\u0064\u0056e\u0072t\u0069c\u0061\u006c\u004f\u0072\u0069\u0067\u0069n"}:_dgggf .DrawingGridVerticalOrigin =NewCT_TwipsMeasure ();if _cdagfd :=d .DecodeElement (_dgggf .DrawingGridVerticalOrigin ,&_eegac );_cdagfd !=nil {return _cdagfd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u004eo\u0074\u0053\u0068\u0061\u0064e\u0046\u006fr\u006d\u0044\u0061\u0074\u0061"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u004eo\u0074\u0053\u0068\u0061\u0064e\u0046\u006fr\u006d\u0044\u0061\u0074\u0061"}:_dgggf .DoNotShadeFormData =NewCT_OnOff ();if _daeba :=d .DecodeElement (_dgggf .DoNotShadeFormData ,&_eegac );_daeba !=nil {return _daeba ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"n\u006fP\u0075\u006e\u0063\u0074\u0075\u0061\u0074\u0069o\u006e\u004b\u0065\u0072ni\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"n\u006fP\u0075\u006e\u0063\u0074\u0075\u0061\u0074\u0069o\u006e\u004b\u0065\u0072ni\u006e\u0067"}:_dgggf .NoPunctuationKerning =NewCT_OnOff ();if _bbffc :=d .DecodeElement (_dgggf .NoPunctuationKerning ,&_eegac );_bbffc !=nil {return _bbffc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0068\u0061ra\u0063\u0074\u0065\u0072\u0053\u0070\u0061\u0063\u0069\u006e\u0067\u0043\u006f\u006e\u0074\u0072\u006f\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0068\u0061ra\u0063\u0074\u0065\u0072\u0053\u0070\u0061\u0063\u0069\u006e\u0067\u0043\u006f\u006e\u0074\u0072\u006f\u006c"}:_dgggf .CharacterSpacingControl =NewCT_CharacterSpacing ();if _geefag :=d .DecodeElement (_dgggf .CharacterSpacingControl ,&_eegac );_geefag !=nil {return _geefag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u0069\u006e\u0074\u0054\u0077\u006f\u004f\u006e\u004f\u006e\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u0069\u006e\u0074\u0054\u0077\u006f\u004f\u006e\u004f\u006e\u0065"}:_dgggf .PrintTwoOnOne =NewCT_OnOff ();if _ggbec :=d .DecodeElement (_dgggf .PrintTwoOnOne ,&_eegac );_ggbec !=nil {return _ggbec ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0074\u0072ic\u0074\u0046\u0069\u0072\u0073\u0074\u0041\u006e\u0064\u004c\u0061\u0073\u0074\u0043\u0068\u0061\u0072\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0074\u0072ic\u0074\u0046\u0069\u0072\u0073\u0074\u0041\u006e\u0064\u004c\u0061\u0073\u0074\u0043\u0068\u0061\u0072\u0073"}:_dgggf .StrictFirstAndLastChars =NewCT_OnOff ();if _gfdge :=d .DecodeElement (_dgggf .StrictFirstAndLastChars ,&_eegac );_gfdge !=nil {return _gfdge ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006e\u006f\u004c\u0069\u006e\u0065\u0042\u0072\u0065\u0061\u006b\u0073A\u0066\u0074\u0065\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006e\u006f\u004c\u0069\u006e\u0065\u0042\u0072\u0065\u0061\u006b\u0073A\u0066\u0074\u0065\u0072"}:_dgggf .NoLineBreaksAfter =NewCT_Kinsoku ();if _adaag :=d .DecodeElement (_dgggf .NoLineBreaksAfter ,&_eegac );_adaag !=nil {return _adaag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006eo\u004ci\u006e\u0065\u0042\u0072\u0065a\u006b\u0073B\u0065\u0066\u006f\u0072\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006eo\u004ci\u006e\u0065\u0042\u0072\u0065a\u006b\u0073B\u0065\u0066\u006f\u0072\u0065"}:_dgggf .NoLineBreaksBefore =NewCT_Kinsoku ();if _febdd :=d .DecodeElement (_dgggf .NoLineBreaksBefore ,&_eegac );_febdd !=nil {return _febdd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073a\u0076e\u0050\u0072\u0065\u0076\u0069e\u0077\u0050i\u0063\u0074\u0075\u0072\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073a\u0076e\u0050\u0072\u0065\u0076\u0069e\u0077\u0050i\u0063\u0074\u0075\u0072\u0065"}:_dgggf .SavePreviewPicture =NewCT_OnOff ();if _efacd :=d .DecodeElement (_dgggf .SavePreviewPicture ,&_eegac );_efacd !=nil {return _efacd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u006f\u004e\u006f\u0074\u0056\u0061\u006c\u0069\u0064\u0061t\u0065\u0041\u0067\u0061\u0069\u006e\u0073\u0074\u0053\u0063h\u0065\u006d\u0061"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u006f\u004e\u006f\u0074\u0056\u0061\u006c\u0069\u0064\u0061t\u0065\u0041\u0067\u0061\u0069\u006e\u0073\u0074\u0053\u0063h\u0065\u006d\u0061"}:_dgggf .DoNotValidateAgainstSchema =NewCT_OnOff ();if _eaaede :=d .DecodeElement (_dgggf .DoNotValidateAgainstSchema ,&_eegac );_eaaede !=nil {return _eaaede ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0061\u0076\u0065\u0049\u006e\u0076\u0061\u006ci\u0064\u0058\u006d\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0061\u0076\u0065\u0049\u006e\u0076\u0061\u006ci\u0064\u0058\u006d\u006c"}:_dgggf .SaveInvalidXml =NewCT_OnOff ();if _gfbgae :=d .DecodeElement (_dgggf .SaveInvalidXml ,&_eegac );_gfbgae !=nil {return _gfbgae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069g\u006eo\u0072\u0065\u004d\u0069\u0078e\u0064\u0043o\u006e\u0074\u0065\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069g\u006eo\u0072\u0065\u004d\u0069\u0078e\u0064\u0043o\u006e\u0074\u0065\u006e\u0074"}:_dgggf .IgnoreMixedContent =NewCT_OnOff ();if _eagdaef :=d .DecodeElement (_dgggf .IgnoreMixedContent ,&_eegac );_eagdaef !=nil {return _eagdaef ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061l\u0077\u0061\u0079\u0073S\u0068\u006f\u0077\u0050\u006ca\u0063e\u0068o\u006c\u0064\u0065\u0072\u0054\u0065\u0078t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061l\u0077\u0061\u0079\u0073S\u0068\u006f\u0077\u0050\u006ca\u0063e\u0068o\u006c\u0064\u0065\u0072\u0054\u0065\u0078t"}:_dgggf .AlwaysShowPlaceholderText =NewCT_OnOff ();if _gfgage :=d .DecodeElement (_dgggf .AlwaysShowPlaceholderText ,&_eegac );_gfgage !=nil {return _gfgage ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u004e\u006f\u0074\u0044\u0065\u006d\u0061\u0072\u0063\u0061\u0074e\u0049\u006e\u0076\u0061\u006c\u0069\u0064\u0058\u006d\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u004e\u006f\u0074\u0044\u0065\u006d\u0061\u0072\u0063\u0061\u0074e\u0049\u006e\u0076\u0061\u006c\u0069\u0064\u0058\u006d\u006c"}:_dgggf .DoNotDemarcateInvalidXml =NewCT_OnOff ();if _fbcedc :=d .DecodeElement (_dgggf .DoNotDemarcateInvalidXml ,&_eegac );_fbcedc !=nil {return _fbcedc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073a\u0076e\u0058\u006d\u006c\u0044\u0061\u0074\u0061\u004f\u006e\u006c\u0079"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073a\u0076e\u0058\u006d\u006c\u0044\u0061\u0074\u0061\u004f\u006e\u006c\u0079"}:_dgggf .SaveXmlDataOnly =NewCT_OnOff ();if _acdfe :=d .DecodeElement (_dgggf .SaveXmlDataOnly ,&_eegac );_acdfe !=nil {return _acdfe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0075\u0073\u0065\u0058\u0053\u004c\u0054\u0057\u0068\u0065\u006e\u0053a\u0076\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0075\u0073\u0065\u0058\u0053\u004c\u0054\u0057\u0068\u0065\u006e\u0053a\u0076\u0069\u006e\u0067"}:_dgggf .UseXSLTWhenSaving =NewCT_OnOff ();if _cgdae :=d .DecodeElement (_dgggf .UseXSLTWhenSaving ,&_eegac );_cgdae !=nil {return _cgdae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073a\u0076e\u0054\u0068\u0072\u006f\u0075\u0067\u0068\u0058\u0073\u006c\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073a\u0076e\u0054\u0068\u0072\u006f\u0075\u0067\u0068\u0058\u0073\u006c\u0074"}:_dgggf .SaveThroughXslt =NewCT_SaveThroughXslt ();if _dgdaa :=d .DecodeElement (_dgggf .SaveThroughXslt ,&_eegac );_dgdaa !=nil {return _dgdaa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"s\u0068\u006f\u0077\u0058\u004d\u004c\u0054\u0061\u0067\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"s\u0068\u006f\u0077\u0058\u004d\u004c\u0054\u0061\u0067\u0073"}:_dgggf .ShowXMLTags =NewCT_OnOff ();if _cbgbf :=d .DecodeElement (_dgggf .ShowXMLTags ,&_eegac );_cbgbf !=nil {return _cbgbf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061l\u0077\u0061\u0079\u0073M\u0065\u0072\u0067\u0065\u0045m\u0070t\u0079N\u0061\u006d\u0065\u0073\u0070\u0061\u0063e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061l\u0077\u0061\u0079\u0073M\u0065\u0072\u0067\u0065\u0045m\u0070t\u0079N\u0061\u006d\u0065\u0073\u0070\u0061\u0063e"}:_dgggf .AlwaysMergeEmptyNamespace =NewCT_OnOff ();if _aaedd :=d .DecodeElement (_dgggf .AlwaysMergeEmptyNamespace ,&_eegac );_aaedd !=nil {return _aaedd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0075\u0070\u0064a\u0074\u0065\u0046\u0069\u0065\u006c\u0064\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0075\u0070\u0064a\u0074\u0065\u0046\u0069\u0065\u006c\u0064\u0073"}:_dgggf .UpdateFields =NewCT_OnOff ();if _dbcad :=d .DecodeElement (_dgggf .UpdateFields ,&_eegac );_dbcad !=nil {return _dbcad ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068\u0064r\u0053\u0068\u0061p\u0065\u0044\u0065\u0066\u0061\u0075\u006c\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068\u0064r\u0053\u0068\u0061p\u0065\u0044\u0065\u0066\u0061\u0075\u006c\u0074\u0073"}:_dgggf .HdrShapeDefaults =NewCT_ShapeDefaults ();if _dgaec :=d .DecodeElement (_dgggf .HdrShapeDefaults ,&_eegac );_dgaec !=nil {return _dgaec ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0050\u0072"}:_dgggf .FootnotePr =NewCT_FtnDocProps ();if _bcbef :=d .DecodeElement (_dgggf .FootnotePr ,&_eegac );_bcbef !=nil {return _bcbef ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065n\u0064\u006e\u006f\u0074\u0065\u0050r"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065n\u0064\u006e\u006f\u0074\u0065\u0050r"}:_dgggf .EndnotePr =NewCT_EdnDocProps ();if _gfgea :=d .DecodeElement (_dgggf .EndnotePr ,&_eegac );_gfgea !=nil {return _gfgea ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u0070\u0061\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u0070\u0061\u0074"}:_dgggf .Compat =NewCT_Compat ();if _dcgbd :=d .DecodeElement (_dgggf .Compat ,&_eegac );_dcgbd !=nil {return _dcgbd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u0063\u0056\u0061\u0072\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u0063\u0056\u0061\u0072\u0073"}:_dgggf .DocVars =NewCT_DocVars ();if _bedce :=d .DecodeElement (_dgggf .DocVars ,&_eegac );_bedce !=nil {return _bedce ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0073\u0069d\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0073\u0069d\u0073"}:_dgggf .Rsids =NewCT_DocRsids ();if _bcagec :=d .DecodeElement (_dgggf .Rsids ,&_eegac );_bcagec !=nil {return _bcagec ;};case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006d\u0061\u0074\u0068\u0050\u0072"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006d\u0061\u0074\u0068\u0050\u0072"}:_dgggf .MathPr =_ec .NewMathPr ();if _bdbbg :=d .DecodeElement (_dgggf .MathPr ,&_eegac );_bdbbg !=nil {return _bdbbg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u0074\u0074\u0061\u0063\u0068\u0065\u0064\u0053c\u0068\u0065\u006d\u0061"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u0074\u0074\u0061\u0063\u0068\u0065\u0064\u0053c\u0068\u0065\u006d\u0061"}:_daccg :=NewCT_String ();if _gddcf :=d .DecodeElement (_daccg ,&_eegac );_gddcf !=nil {return _gddcf ;};_dgggf .AttachedSchema =append (_dgggf .AttachedSchema ,_daccg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0068\u0065\u006d\u0065\u0046\u006f\u006e\u0074\u004c\u0061\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0068\u0065\u006d\u0065\u0046\u006f\u006e\u0074\u004c\u0061\u006e\u0067"}:_dgggf .ThemeFontLang =NewCT_Language ();if _eggedc :=d .DecodeElement (_dgggf .ThemeFontLang ,&_eegac );_eggedc !=nil {return _eggedc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006cr\u0053\u0063\u0068e\u006d\u0065\u004d\u0061\u0070\u0070\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006cr\u0053\u0063\u0068e\u006d\u0065\u004d\u0061\u0070\u0070\u0069\u006e\u0067"}:_dgggf .ClrSchemeMapping =NewCT_ColorSchemeMapping ();if _cbffgb :=d .DecodeElement (_dgggf .ClrSchemeMapping ,&_eegac );_cbffgb !=nil {return _cbffgb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u006f\u004e\u006f\u0074\u0049\u006e\u0063\u006c\u0075\u0064e\u0053\u0075\u0062\u0064\u006f\u0063\u0073\u0049\u006e\u0053t\u0061\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u006f\u004e\u006f\u0074\u0049\u006e\u0063\u006c\u0075\u0064e\u0053\u0075\u0062\u0064\u006f\u0063\u0073\u0049\u006e\u0053t\u0061\u0074\u0073"}:_dgggf .DoNotIncludeSubdocsInStats =NewCT_OnOff ();if _dfegec :=d .DecodeElement (_dgggf .DoNotIncludeSubdocsInStats ,&_eegac );_dfegec !=nil {return _dfegec ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064o\u004e\u006f\u0074\u0041u\u0074\u006f\u0043\u006f\u006dp\u0072e\u0073s\u0050\u0069\u0063\u0074\u0075\u0072\u0065s"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064o\u004e\u006f\u0074\u0041u\u0074\u006f\u0043\u006f\u006dp\u0072e\u0073s\u0050\u0069\u0063\u0074\u0075\u0072\u0065s"}:_dgggf .DoNotAutoCompressPictures =NewCT_OnOff ();if _bedbe :=d .DecodeElement (_dgggf .DoNotAutoCompressPictures ,&_eegac );_bedbe !=nil {return _bedbe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066\u006f\u0072c\u0065\u0055\u0070\u0067\u0072\u0061\u0064\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066\u006f\u0072c\u0065\u0055\u0070\u0067\u0072\u0061\u0064\u0065"}:_dgggf .ForceUpgrade =NewCT_Empty ();if _gffce :=d .DecodeElement (_dgggf .ForceUpgrade ,&_eegac );_gffce !=nil {return _gffce ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0061\u0070\u0074\u0069\u006f\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0061\u0070\u0074\u0069\u006f\u006e\u0073"}:_dgggf .Captions =NewCT_Captions ();if _eefef :=d .DecodeElement (_dgggf .Captions ,&_eegac );_eefef !=nil {return _eefef ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0065\u0061\u0064Mo\u0064\u0065\u0049\u006e\u006b\u004c\u006f\u0063\u006b\u0044\u006f\u0077\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0065\u0061\u0064Mo\u0064\u0065\u0049\u006e\u006b\u004c\u006f\u0063\u006b\u0044\u006f\u0077\u006e"}:_dgggf .ReadModeInkLockDown =NewCT_ReadingModeInkLockDown ();if _affag :=d .DecodeElement (_dgggf .ReadModeInkLockDown ,&_eegac );_affag !=nil {return _affag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006d\u0061r\u0074\u0054\u0061\u0067\u0054\u0079\u0070\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006d\u0061r\u0074\u0054\u0061\u0067\u0054\u0079\u0070\u0065"}:_bdfged :=NewCT_SmartTagType ();if _fcdfa :=d .DecodeElement (_bdfged ,&_eegac );_fcdfa !=nil {return _fcdfa ;};_dgggf .SmartTagType =append (_dgggf .SmartTagType ,_bdfged );case _g .Name {Space :"\u0068\u0074\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067/\u0073\u0063\u0068\u0065\u006da\u004c\u0069\u0062\u0072\u0061\u0072\u0079\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e",Local :"\u0073\u0063\u0068\u0065\u006d\u0061\u004c\u0069\u0062\u0072\u0061\u0072\u0079"}:_dgggf .SchemaLibrary =_da .NewSchemaLibrary ();if _efeeb :=d .DecodeElement (_dgggf .SchemaLibrary ,&_eegac );_efeeb !=nil {return _efeeb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0068\u0061\u0070\u0065\u0044\u0065\u0066\u0061\u0075\u006c\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0068\u0061\u0070\u0065\u0044\u0065\u0066\u0061\u0075\u006c\u0074\u0073"}:_dgggf .ShapeDefaults =NewCT_ShapeDefaults ();if _debdad :=d .DecodeElement (_dgggf .ShapeDefaults ,&_eegac );_debdad !=nil {return _debdad ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u006f\u004e\u006ftE\u006d\u0062\u0065\u0064\u0053\u006d\u0061\u0072\u0074\u0054\u0061\u0067\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u006f\u004e\u006ftE\u006d\u0062\u0065\u0064\u0053\u006d\u0061\u0072\u0074\u0054\u0061\u0067\u0073"}:_dgggf .DoNotEmbedSmartTags =NewCT_OnOff ();if _ddedb :=d .DecodeElement (_dgggf .DoNotEmbedSmartTags ,&_eegac );_ddedb !=nil {return _ddedb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u0063\u0069\u006d\u0061\u006c\u0053\u0079\u006d\u0062\u006f\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u0063\u0069\u006d\u0061\u006c\u0053\u0079\u006d\u0062\u006f\u006c"}:_dgggf .DecimalSymbol =NewCT_String ();if _egbga :=d .DecodeElement (_dgggf .DecimalSymbol ,&_eegac );_egbga !=nil {return _egbga ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006c\u0069\u0073\u0074\u0053\u0065\u0070\u0061\u0072\u0061\u0074\u006f\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006c\u0069\u0073\u0074\u0053\u0065\u0070\u0061\u0072\u0061\u0074\u006f\u0072"}:_dgggf .ListSeparator =NewCT_String ();if _ebebg :=d .DecodeElement (_dgggf .ListSeparator ,&_eegac );_ebebg !=nil {return _ebebg ;};default:_fefgde :=&_ga .XSDAny {};if _gcgba :=d .DecodeElement (_fefgde ,&_eegac );_gcgba !=nil {return _gcgba ;};_dgggf .Extra =append (_dgggf .Extra ,_fefgde );};case _g .EndElement :break _beddd ;case _g .CharData :};};return nil ;};func (_ccfbd *CT_FFHelpText )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ccfbd .TypeAttr !=ST_InfoTextTypeUnset {_egcdc ,_fecda :=_ccfbd .TypeAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0074\u0079\u0070\u0065"});if _fecda !=nil {return _fecda ;};start .Attr =append (start .Attr ,_egcdc );};if _ccfbd .ValAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076a\u006c"},Value :_gd .Sprintf ("\u0025\u0076",*_ccfbd .ValAttr )});};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_dfaf *CT_FtnPos )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {_adfdd ,_adebe :=_dfaf .ValAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0076a\u006c"});if _adebe !=nil {return _adebe ;};start .Attr =append (start .Attr ,_adfdd );e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_cfgcdb ST_MailMergeSourceType )String ()string {switch _cfgcdb {case 0:return "";case 1:return "\u0064\u0061\u0074\u0061\u0062\u0061\u0073\u0065";case 2:return "a\u0064\u0064\u0072\u0065\u0073\u0073\u0042\u006f\u006f\u006b";case 3:return "\u0064o\u0063\u0075\u006d\u0065\u006e\u00741";case 4:return "\u0064o\u0063\u0075\u006d\u0065\u006e\u00742";case 5:return "\u0074\u0065\u0078\u0074";case 6:return "\u0065\u006d\u0061i\u006c";case 7:return "\u006e\u0061\u0074\u0069\u0076\u0065";case 8:return "\u006c\u0065\u0067\u0061\u0063\u0079";case 9:return "\u006d\u0061\u0073\u0074\u0065\u0072";};return "";};func (_bgggg ST_Lock )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_bgggg .String (),start );};func (_eecbc *ST_PTabAlignment )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bacgee ,_cbccea :=d .Token ();if _cbccea !=nil {return _cbccea ;};if _eecab ,_dbbga :=_bacgee .(_g .EndElement );_dbbga &&_eecab .Name ==start .Name {*_eecbc =1;return nil ;};if _aebfeb ,_geeag :=_bacgee .(_g .CharData );!_geeag {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_bacgee );}else {switch string (_aebfeb ){case "":*_eecbc =0;case "\u006c\u0065\u0066\u0074":*_eecbc =1;case "\u0063\u0065\u006e\u0074\u0065\u0072":*_eecbc =2;case "\u0072\u0069\u0067h\u0074":*_eecbc =3;};};_bacgee ,_cbccea =d .Token ();if _cbccea !=nil {return _cbccea ;};if _cbeac ,_aabagd :=_bacgee .(_g .EndElement );_aabagd &&_cbeac .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_bacgee );};
@@ -5328,7 +5342,7 @@ func (_bcaee *CT_ObjectEmbed )Validate ()error {return _bcaee .ValidateWithPath
 func (_cdggdgd *CT_StyleSort )Validate ()error {return _cdggdgd .ValidateWithPath ("\u0043\u0054\u005fS\u0074\u0079\u006c\u0065\u0053\u006f\u0072\u0074");};
 
 // Validate validates the EG_PContent and its children
-func (_dgdcb *EG_PContent )Validate ()error {return _dgdcb .ValidateWithPath ("E\u0047\u005f\u0050\u0043\u006f\u006e\u0074\u0065\u006e\u0074");};func (_aadgde *CT_PPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_aadgde .AuthorAttr )});if _aadgde .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_aadgde .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_aadgde .IdAttr )});e .EncodeToken (start );_ffadee :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070P\u0072"}};e .EncodeElement (_aadgde .PPr ,_ffadee );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+func (_dgdcb *EG_PContent )Validate ()error {return _dgdcb .ValidateWithPath ("E\u0047\u005f\u0050\u0043\u006f\u006e\u0074\u0065\u006e\u0074");};func (_aadgde *CT_PPrChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_aadgde .AuthorAttr )});if _aadgde .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_aadgde .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_aadgde .IdAttr )});e .EncodeToken (start );_ffadee :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070P\u0072"}};e .EncodeElement (_aadgde .PPr ,_ffadee );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // Validate validates the CT_SignedHpsMeasure and its children
 func (_geefdb *CT_SignedHpsMeasure )Validate ()error {return _geefdb .ValidateWithPath ("\u0043\u0054\u005f\u0053ig\u006e\u0065\u0064\u0048\u0070\u0073\u004d\u0065\u0061\u0073\u0075\u0072\u0065");};
@@ -5373,7 +5387,7 @@ func (_agbfa *CT_FtnEdn )ValidateWithPath (path string )error {if _beefd :=_agbf
 AllAttr *_gc .ST_OnOff ;
 
 // Entry Type
-Type []*CT_DocPartType ;};const (ST_TextEffectUnset ST_TextEffect =0;ST_TextEffectBlinkBackground ST_TextEffect =1;ST_TextEffectLights ST_TextEffect =2;ST_TextEffectAntsBlack ST_TextEffect =3;ST_TextEffectAntsRed ST_TextEffect =4;ST_TextEffectShimmer ST_TextEffect =5;ST_TextEffectSparkle ST_TextEffect =6;ST_TextEffectNone ST_TextEffect =7;);func (_baag *CT_RPr )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_fgbbf :for {_gaced ,_bbfac :=d .Token ();if _bbfac !=nil {return _bbfac ;};switch _dadac :=_gaced .(type ){case _g .StartElement :switch _dadac .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0053\u0074\u0079\u006c\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0053\u0074\u0079\u006c\u0065"}:_baag .RStyle =NewCT_String ();if _ecgf :=d .DecodeElement (_baag .RStyle ,&_dadac );_ecgf !=nil {return _ecgf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0046\u006f\u006e\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0046\u006f\u006e\u0074\u0073"}:_baag .RFonts =NewCT_Fonts ();if _ffae :=d .DecodeElement (_baag .RFonts ,&_dadac );_ffae !=nil {return _ffae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062"}:_baag .B =NewCT_OnOff ();if _cbdfb :=d .DecodeElement (_baag .B ,&_dadac );_cbdfb !=nil {return _cbdfb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0043\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0043\u0073"}:_baag .BCs =NewCT_OnOff ();if _dddaf :=d .DecodeElement (_baag .BCs ,&_dadac );_dddaf !=nil {return _dddaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069"}:_baag .I =NewCT_OnOff ();if _ccebb :=d .DecodeElement (_baag .I ,&_dadac );_ccebb !=nil {return _ccebb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u0043\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u0043\u0073"}:_baag .ICs =NewCT_OnOff ();if _ffdabf :=d .DecodeElement (_baag .ICs ,&_dadac );_ffdabf !=nil {return _ffdabf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0061\u0070\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0061\u0070\u0073"}:_baag .Caps =NewCT_OnOff ();if _dcadd :=d .DecodeElement (_baag .Caps ,&_dadac );_dcadd !=nil {return _dcadd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073m\u0061\u006c\u006c\u0043\u0061\u0070s"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073m\u0061\u006c\u006c\u0043\u0061\u0070s"}:_baag .SmallCaps =NewCT_OnOff ();if _deafeg :=d .DecodeElement (_baag .SmallCaps ,&_dadac );_deafeg !=nil {return _deafeg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0074\u0072\u0069\u006b\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0074\u0072\u0069\u006b\u0065"}:_baag .Strike =NewCT_OnOff ();if _degaa :=d .DecodeElement (_baag .Strike ,&_dadac );_degaa !=nil {return _degaa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064s\u0074\u0072\u0069\u006b\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064s\u0074\u0072\u0069\u006b\u0065"}:_baag .Dstrike =NewCT_OnOff ();if _eeeaf :=d .DecodeElement (_baag .Dstrike ,&_dadac );_eeeaf !=nil {return _eeeaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006fu\u0074\u006c\u0069\u006e\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006fu\u0074\u006c\u0069\u006e\u0065"}:_baag .Outline =NewCT_OnOff ();if _gbbff :=d .DecodeElement (_baag .Outline ,&_dadac );_gbbff !=nil {return _gbbff ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0068\u0061\u0064\u006f\u0077"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0068\u0061\u0064\u006f\u0077"}:_baag .Shadow =NewCT_OnOff ();if _dceb :=d .DecodeElement (_baag .Shadow ,&_dadac );_dceb !=nil {return _dceb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006d\u0062\u006f\u0073\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006d\u0062\u006f\u0073\u0073"}:_baag .Emboss =NewCT_OnOff ();if _acbfe :=d .DecodeElement (_baag .Emboss ,&_dadac );_acbfe !=nil {return _acbfe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069m\u0070\u0072\u0069\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069m\u0070\u0072\u0069\u006e\u0074"}:_baag .Imprint =NewCT_OnOff ();if _affe :=d .DecodeElement (_baag .Imprint ,&_dadac );_affe !=nil {return _affe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006eo\u0050\u0072\u006f\u006f\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006eo\u0050\u0072\u006f\u006f\u0066"}:_baag .NoProof =NewCT_OnOff ();if _aaagb :=d .DecodeElement (_baag .NoProof ,&_dadac );_aaagb !=nil {return _aaagb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006e\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006e\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"}:_baag .SnapToGrid =NewCT_OnOff ();if _facdb :=d .DecodeElement (_baag .SnapToGrid ,&_dadac );_facdb !=nil {return _facdb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0076\u0061\u006e\u0069\u0073\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0076\u0061\u006e\u0069\u0073\u0068"}:_baag .Vanish =NewCT_OnOff ();if _gagcc :=d .DecodeElement (_baag .Vanish ,&_dadac );_gagcc !=nil {return _gagcc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0077e\u0062\u0048\u0069\u0064\u0064\u0065n"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0077e\u0062\u0048\u0069\u0064\u0064\u0065n"}:_baag .WebHidden =NewCT_OnOff ();if _gegcg :=d .DecodeElement (_baag .WebHidden ,&_dadac );_gegcg !=nil {return _gegcg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006co\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006co\u0072"}:_baag .Color =NewCT_Color ();if _egcee :=d .DecodeElement (_baag .Color ,&_dadac );_egcee !=nil {return _egcee ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073p\u0061\u0063\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073p\u0061\u0063\u0069\u006e\u0067"}:_baag .Spacing =NewCT_SignedTwipsMeasure ();if _gacag :=d .DecodeElement (_baag .Spacing ,&_dadac );_gacag !=nil {return _gacag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0077"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0077"}:_baag .W =NewCT_TextScale ();if _gbdaf :=d .DecodeElement (_baag .W ,&_dadac );_gbdaf !=nil {return _gbdaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006b\u0065\u0072\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006b\u0065\u0072\u006e"}:_baag .Kern =NewCT_HpsMeasure ();if _gebbab :=d .DecodeElement (_baag .Kern ,&_dadac );_gebbab !=nil {return _gebbab ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u006f\u0073\u0069\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u006f\u0073\u0069\u0074\u0069\u006f\u006e"}:_baag .Position =NewCT_SignedHpsMeasure ();if _faacg :=d .DecodeElement (_baag .Position ,&_dadac );_faacg !=nil {return _faacg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u007a"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u007a"}:_baag .Sz =NewCT_HpsMeasure ();if _gbfe :=d .DecodeElement (_baag .Sz ,&_dadac );_gbfe !=nil {return _gbfe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u007a\u0043\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u007a\u0043\u0073"}:_baag .SzCs =NewCT_HpsMeasure ();if _dgbdc :=d .DecodeElement (_baag .SzCs ,&_dadac );_dgbdc !=nil {return _dgbdc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068i\u0067\u0068\u006c\u0069\u0067\u0068t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068i\u0067\u0068\u006c\u0069\u0067\u0068t"}:_baag .Highlight =NewCT_Highlight ();if _gddae :=d .DecodeElement (_baag .Highlight ,&_dadac );_gddae !=nil {return _gddae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0075"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0075"}:_baag .U =NewCT_Underline ();if _ccbed :=d .DecodeElement (_baag .U ,&_dadac );_ccbed !=nil {return _ccbed ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u0066\u0066\u0065\u0063\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u0066\u0066\u0065\u0063\u0074"}:_baag .Effect =NewCT_TextEffect ();if _feeb :=d .DecodeElement (_baag .Effect ,&_dadac );_feeb !=nil {return _feeb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0064\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0064\u0072"}:_baag .Bdr =NewCT_Border ();if _gedad :=d .DecodeElement (_baag .Bdr ,&_dadac );_gedad !=nil {return _gedad ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0068\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0068\u0064"}:_baag .Shd =NewCT_Shd ();if _gaaba :=d .DecodeElement (_baag .Shd ,&_dadac );_gaaba !=nil {return _gaaba ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066i\u0074\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066i\u0074\u0054\u0065\u0078\u0074"}:_baag .FitText =NewCT_FitText ();if _eccae :=d .DecodeElement (_baag .FitText ,&_dadac );_eccae !=nil {return _eccae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0076e\u0072\u0074\u0041\u006c\u0069\u0067n"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0076e\u0072\u0074\u0041\u006c\u0069\u0067n"}:_baag .VertAlign =NewCT_VerticalAlignRun ();if _ggdbe :=d .DecodeElement (_baag .VertAlign ,&_dadac );_ggdbe !=nil {return _ggdbe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0074\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0074\u006c"}:_baag .Rtl =NewCT_OnOff ();if _dfega :=d .DecodeElement (_baag .Rtl ,&_dadac );_dfega !=nil {return _dfega ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0073"}:_baag .Cs =NewCT_OnOff ();if _bgfdg :=d .DecodeElement (_baag .Cs ,&_dadac );_bgfdg !=nil {return _bgfdg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006d"}:_baag .Em =NewCT_Em ();if _afecg :=d .DecodeElement (_baag .Em ,&_dadac );_afecg !=nil {return _afecg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006c\u0061\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006c\u0061\u006e\u0067"}:_baag .Lang =NewCT_Language ();if _deecag :=d .DecodeElement (_baag .Lang ,&_dadac );_deecag !=nil {return _deecag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065a\u0073t\u0041\u0073\u0069\u0061\u006e\u004c\u0061\u0079\u006f\u0075\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065a\u0073t\u0041\u0073\u0069\u0061\u006e\u004c\u0061\u0079\u006f\u0075\u0074"}:_baag .EastAsianLayout =NewCT_EastAsianLayout ();if _decag :=d .DecodeElement (_baag .EastAsianLayout ,&_dadac );_decag !=nil {return _decag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0070\u0065\u0063\u0056\u0061\u006e\u0069\u0073\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0070\u0065\u0063\u0056\u0061\u006e\u0069\u0073\u0068"}:_baag .SpecVanish =NewCT_OnOff ();if _bcgbg :=d .DecodeElement (_baag .SpecVanish ,&_dadac );_bcgbg !=nil {return _bcgbg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006f\u004d\u0061t\u0068"}:_baag .OMath =NewCT_OnOff ();if _eaede :=d .DecodeElement (_baag .OMath ,&_dadac );_eaede !=nil {return _eaede ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072P\u0072\u0043\u0068\u0061\u006e\u0067e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072P\u0072\u0043\u0068\u0061\u006e\u0067e"}:_baag .RPrChange =NewCT_RPrChange ();if _fcefb :=d .DecodeElement (_baag .RPrChange ,&_dadac );_fcefb !=nil {return _fcefb ;};default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0052\u0050\u0072\u0020\u0025\u0076",_dadac .Name );if _bgee :=d .Skip ();_bgee !=nil {return _bgee ;};};case _g .EndElement :break _fgbbf ;case _g .CharData :};};return nil ;};func (_ddfaa *CT_ShapeDefaults )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ggdff :for {_eeabaf ,_faaddg :=d .Token ();if _faaddg !=nil {return _faaddg ;};switch _fgccff :=_eeabaf .(type ){case _g .StartElement :switch _fgccff .Name {default:if _gdacg ,_ecdbec :=_ga .CreateElement (_fgccff );_ecdbec !=nil {return _ecdbec ;}else {if _aggfc :=d .DecodeElement (_gdacg ,&_fgccff );_aggfc !=nil {return _aggfc ;};_ddfaa .Any =append (_ddfaa .Any ,_gdacg );};};case _g .EndElement :break _ggdff ;case _g .CharData :};};return nil ;};func (_bdabg *ST_HAnchor )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_bdabg =0;case "\u0074\u0065\u0078\u0074":*_bdabg =1;case "\u006d\u0061\u0072\u0067\u0069\u006e":*_bdabg =2;case "\u0070\u0061\u0067\u0065":*_bdabg =3;};return nil ;};func (_eaaacg ST_PTabLeader )ValidateWithPath (path string )error {switch _eaaacg {case 0,1,2,3,4,5:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_eaaacg ));};return nil ;};func (_gbbbe *WdAnchor )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006cn\u0073"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0061"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0070\u0069c"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0072"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068\u0065\u006da\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u006f\u0066\u0066\u0069c\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002fr\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073h\u0069\u0070\u0073"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0077"},Value :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u0077\u0070"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0078\u006dl"},Value :"\u0068\u0074tp\u003a\u002f\u002fw\u0077\u0077\u002e\u00773.o\u0072g/\u0058\u004d\u004c\u002f\u0031\u0039\u00398/\u006e\u0061\u006d\u0065\u0073\u0070\u0061c\u0065"});start .Name .Local ="\u0077p\u003a\u0061\u006e\u0063\u0068\u006fr";return _gbbbe .WdCT_Anchor .MarshalXML (e ,start );};type CT_Lang struct{
+Type []*CT_DocPartType ;};const (ST_TextEffectUnset ST_TextEffect =0;ST_TextEffectBlinkBackground ST_TextEffect =1;ST_TextEffectLights ST_TextEffect =2;ST_TextEffectAntsBlack ST_TextEffect =3;ST_TextEffectAntsRed ST_TextEffect =4;ST_TextEffectShimmer ST_TextEffect =5;ST_TextEffectSparkle ST_TextEffect =6;ST_TextEffectNone ST_TextEffect =7;);func (_baag *CT_RPr )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_fgbbf :for {_gaced ,_bbfac :=d .Token ();if _bbfac !=nil {return _bbfac ;};switch _dadac :=_gaced .(type ){case _g .StartElement :switch _dadac .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0053\u0074\u0079\u006c\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0053\u0074\u0079\u006c\u0065"}:_baag .RStyle =NewCT_String ();if _ecgf :=d .DecodeElement (_baag .RStyle ,&_dadac );_ecgf !=nil {return _ecgf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0046\u006f\u006e\u0074\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0046\u006f\u006e\u0074\u0073"}:_baag .RFonts =NewCT_Fonts ();if _ffae :=d .DecodeElement (_baag .RFonts ,&_dadac );_ffae !=nil {return _ffae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062"}:_baag .B =NewCT_OnOff ();if _cbdfb :=d .DecodeElement (_baag .B ,&_dadac );_cbdfb !=nil {return _cbdfb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0043\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0043\u0073"}:_baag .BCs =NewCT_OnOff ();if _dddaf :=d .DecodeElement (_baag .BCs ,&_dadac );_dddaf !=nil {return _dddaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069"}:_baag .I =NewCT_OnOff ();if _ccebb :=d .DecodeElement (_baag .I ,&_dadac );_ccebb !=nil {return _ccebb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u0043\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u0043\u0073"}:_baag .ICs =NewCT_OnOff ();if _ffdabf :=d .DecodeElement (_baag .ICs ,&_dadac );_ffdabf !=nil {return _ffdabf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0061\u0070\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0061\u0070\u0073"}:_baag .Caps =NewCT_OnOff ();if _dcadd :=d .DecodeElement (_baag .Caps ,&_dadac );_dcadd !=nil {return _dcadd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073m\u0061\u006c\u006c\u0043\u0061\u0070s"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073m\u0061\u006c\u006c\u0043\u0061\u0070s"}:_baag .SmallCaps =NewCT_OnOff ();if _deafeg :=d .DecodeElement (_baag .SmallCaps ,&_dadac );_deafeg !=nil {return _deafeg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0074\u0072\u0069\u006b\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0074\u0072\u0069\u006b\u0065"}:_baag .Strike =NewCT_OnOff ();if _degaa :=d .DecodeElement (_baag .Strike ,&_dadac );_degaa !=nil {return _degaa ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064s\u0074\u0072\u0069\u006b\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064s\u0074\u0072\u0069\u006b\u0065"}:_baag .Dstrike =NewCT_OnOff ();if _eeeaf :=d .DecodeElement (_baag .Dstrike ,&_dadac );_eeeaf !=nil {return _eeeaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006fu\u0074\u006c\u0069\u006e\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006fu\u0074\u006c\u0069\u006e\u0065"}:_baag .Outline =NewCT_OnOff ();if _gbbff :=d .DecodeElement (_baag .Outline ,&_dadac );_gbbff !=nil {return _gbbff ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0068\u0061\u0064\u006f\u0077"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0068\u0061\u0064\u006f\u0077"}:_baag .Shadow =NewCT_OnOff ();if _dceb :=d .DecodeElement (_baag .Shadow ,&_dadac );_dceb !=nil {return _dceb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006d\u0062\u006f\u0073\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006d\u0062\u006f\u0073\u0073"}:_baag .Emboss =NewCT_OnOff ();if _acbfe :=d .DecodeElement (_baag .Emboss ,&_dadac );_acbfe !=nil {return _acbfe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069m\u0070\u0072\u0069\u006e\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069m\u0070\u0072\u0069\u006e\u0074"}:_baag .Imprint =NewCT_OnOff ();if _affe :=d .DecodeElement (_baag .Imprint ,&_dadac );_affe !=nil {return _affe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006eo\u0050\u0072\u006f\u006f\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006eo\u0050\u0072\u006f\u006f\u0066"}:_baag .NoProof =NewCT_OnOff ();if _aaagb :=d .DecodeElement (_baag .NoProof ,&_dadac );_aaagb !=nil {return _aaagb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006e\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006e\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"}:_baag .SnapToGrid =NewCT_OnOff ();if _facdb :=d .DecodeElement (_baag .SnapToGrid ,&_dadac );_facdb !=nil {return _facdb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0076\u0061\u006e\u0069\u0073\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0076\u0061\u006e\u0069\u0073\u0068"}:_baag .Vanish =NewCT_OnOff ();if _gagcc :=d .DecodeElement (_baag .Vanish ,&_dadac );_gagcc !=nil {return _gagcc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0077e\u0062\u0048\u0069\u0064\u0064\u0065n"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0077e\u0062\u0048\u0069\u0064\u0064\u0065n"}:_baag .WebHidden =NewCT_OnOff ();if _gegcg :=d .DecodeElement (_baag .WebHidden ,&_dadac );_gegcg !=nil {return _gegcg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006co\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006co\u0072"}:_baag .Color =NewCT_Color ();if _egcee :=d .DecodeElement (_baag .Color ,&_dadac );_egcee !=nil {return _egcee ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073p\u0061\u0063\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073p\u0061\u0063\u0069\u006e\u0067"}:_baag .Spacing =NewCT_SignedTwipsMeasure ();if _gacag :=d .DecodeElement (_baag .Spacing ,&_dadac );_gacag !=nil {return _gacag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0077"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0077"}:_baag .W =NewCT_TextScale ();if _gbdaf :=d .DecodeElement (_baag .W ,&_dadac );_gbdaf !=nil {return _gbdaf ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006b\u0065\u0072\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006b\u0065\u0072\u006e"}:_baag .Kern =NewCT_HpsMeasure ();if _gebbab :=d .DecodeElement (_baag .Kern ,&_dadac );_gebbab !=nil {return _gebbab ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u006f\u0073\u0069\u0074\u0069\u006f\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u006f\u0073\u0069\u0074\u0069\u006f\u006e"}:_baag .Position =NewCT_SignedHpsMeasure ();if _faacg :=d .DecodeElement (_baag .Position ,&_dadac );_faacg !=nil {return _faacg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u007a"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u007a"}:_baag .Sz =NewCT_HpsMeasure ();if _gbfe :=d .DecodeElement (_baag .Sz ,&_dadac );_gbfe !=nil {return _gbfe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u007a\u0043\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u007a\u0043\u0073"}:_baag .SzCs =NewCT_HpsMeasure ();if _dgbdc :=d .DecodeElement (_baag .SzCs ,&_dadac );_dgbdc !=nil {return _dgbdc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0068i\u0067\u0068\u006c\u0069\u0067\u0068t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0068i\u0067\u0068\u006c\u0069\u0067\u0068t"}:_baag .Highlight =NewCT_Highlight ();if _gddae :=d .DecodeElement (_baag .Highlight ,&_dadac );_gddae !=nil {return _gddae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0075"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0075"}:_baag .U =NewCT_Underline ();if _ccbed :=d .DecodeElement (_baag .U ,&_dadac );_ccbed !=nil {return _ccbed ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u0066\u0066\u0065\u0063\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u0066\u0066\u0065\u0063\u0074"}:_baag .Effect =NewCT_TextEffect ();if _feeb :=d .DecodeElement (_baag .Effect ,&_dadac );_feeb !=nil {return _feeb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0064\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0064\u0072"}:_baag .Bdr =NewCT_Border ();if _gedad :=d .DecodeElement (_baag .Bdr ,&_dadac );_gedad !=nil {return _gedad ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0068\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0068\u0064"}:_baag .Shd =NewCT_Shd ();if _gaaba :=d .DecodeElement (_baag .Shd ,&_dadac );_gaaba !=nil {return _gaaba ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066i\u0074\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066i\u0074\u0054\u0065\u0078\u0074"}:_baag .FitText =NewCT_FitText ();if _eccae :=d .DecodeElement (_baag .FitText ,&_dadac );_eccae !=nil {return _eccae ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0076e\u0072\u0074\u0041\u006c\u0069\u0067n"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0076e\u0072\u0074\u0041\u006c\u0069\u0067n"}:_baag .VertAlign =NewCT_VerticalAlignRun ();if _ggdbe :=d .DecodeElement (_baag .VertAlign ,&_dadac );_ggdbe !=nil {return _ggdbe ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0074\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0074\u006c"}:_baag .Rtl =NewCT_OnOff ();if _dfega :=d .DecodeElement (_baag .Rtl ,&_dadac );_dfega !=nil {return _dfega ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0073"}:_baag .Cs =NewCT_OnOff ();if _bgfdg :=d .DecodeElement (_baag .Cs ,&_dadac );_bgfdg !=nil {return _bgfdg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006d"}:_baag .Em =NewCT_Em ();if _afecg :=d .DecodeElement (_baag .Em ,&_dadac );_afecg !=nil {return _afecg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006c\u0061\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006c\u0061\u006e\u0067"}:_baag .Lang =NewCT_Language ();if _deecag :=d .DecodeElement (_baag .Lang ,&_dadac );_deecag !=nil {return _deecag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065a\u0073t\u0041\u0073\u0069\u0061\u006e\u004c\u0061\u0079\u006f\u0075\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065a\u0073t\u0041\u0073\u0069\u0061\u006e\u004c\u0061\u0079\u006f\u0075\u0074"}:_baag .EastAsianLayout =NewCT_EastAsianLayout ();if _decag :=d .DecodeElement (_baag .EastAsianLayout ,&_dadac );_decag !=nil {return _decag ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0070\u0065\u0063\u0056\u0061\u006e\u0069\u0073\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0070\u0065\u0063\u0056\u0061\u006e\u0069\u0073\u0068"}:_baag .SpecVanish =NewCT_OnOff ();if _bcgbg :=d .DecodeElement (_baag .SpecVanish ,&_dadac );_bcgbg !=nil {return _bcgbg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006f\u004d\u0061t\u0068"}:_baag .OMath =NewCT_OnOff ();if _eaede :=d .DecodeElement (_baag .OMath ,&_dadac );_eaede !=nil {return _eaede ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072P\u0072\u0043\u0068\u0061\u006e\u0067e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072P\u0072\u0043\u0068\u0061\u006e\u0067e"}:_baag .RPrChange =NewCT_RPrChange ();if _fcefb :=d .DecodeElement (_baag .RPrChange ,&_dadac );_fcefb !=nil {return _fcefb ;};default:if _dadac .Name .Space =="\u0068tt\u0070\u003a/\u002f\u0073c\u0068\u0065ma\u0073\u002e\u006d\u0069\u0063\u0072o\u0073oft\u002e\u0063\u006f\u006d\u002f\u006f\u0066\u0066ic\u0065\u002f\u0077\u006f\u0072\u0064\u002f\u003201\u0030\u002f\u0077\u006f\u0072\u0064\u006d\u006c"{_ebfcad :=&_ga .XSDAny {};if _bgee :=d .DecodeElement (_ebfcad ,&_dadac );_bgee !=nil {return _bgee ;};_baag .Extra =append (_baag .Extra ,_ebfcad );}else {_ga .Log ("\u0073\u006b\u0069\u0070\u0070i\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065d\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054\u005f\u0052\u0050\u0072\u0020\u0025\u0076",_dadac .Name );if _bgee :=d .Skip ();_bgee !=nil {return _bgee ;};};};case _g .EndElement :break _fgbbf ;case _g .CharData :};};return nil ;};func (_ddfaa *CT_ShapeDefaults )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_ggdff :for {_eeabaf ,_faaddg :=d .Token ();if _faaddg !=nil {return _faaddg ;};switch _fgccff :=_eeabaf .(type ){case _g .StartElement :switch _fgccff .Name {default:if _gdacg ,_ecdbec :=_ga .CreateElement (_fgccff );_ecdbec !=nil {return _ecdbec ;}else {if _aggfc :=d .DecodeElement (_gdacg ,&_fgccff );_aggfc !=nil {return _aggfc ;};_ddfaa .Any =append (_ddfaa .Any ,_gdacg );};};case _g .EndElement :break _ggdff ;case _g .CharData :};};return nil ;};func (_bdabg *ST_HAnchor )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_bdabg =0;case "\u0074\u0065\u0078\u0074":*_bdabg =1;case "\u006d\u0061\u0072\u0067\u0069\u006e":*_bdabg =2;case "\u0070\u0061\u0067\u0065":*_bdabg =3;};return nil ;};func (_eaaacg ST_PTabLeader )ValidateWithPath (path string )error {switch _eaaacg {case 0,1,2,3,4,5:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_eaaacg ));};return nil ;};func (_gbbbe *WdAnchor )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006cn\u0073"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0061"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0070\u0069c"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0072"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068\u0065\u006da\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u006f\u0066\u0066\u0069c\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002fr\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073h\u0069\u0070\u0073"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0077"},Value :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u0077\u0070"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0078\u006dl"},Value :"\u0068\u0074tp\u003a\u002f\u002fw\u0077\u0077\u002e\u00773.o\u0072g/\u0058\u004d\u004c\u002f\u0031\u0039\u00398/\u006e\u0061\u006d\u0065\u0073\u0070\u0061c\u0065"});start .Name .Local ="\u0077p\u003a\u0061\u006e\u0063\u0068\u006fr";return _gbbbe .WdCT_Anchor .MarshalXML (e ,start );};type CT_Lang struct{
 
 // Language Code
 ValAttr string ;};func (_ecgdf ST_FFTextType )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {return e .EncodeElement (_ecgdf .String (),start );};
@@ -5874,7 +5888,7 @@ Font []*CT_Font ;};func (_dccccb ST_Shd )String ()string {switch _dccccb {case 0
 func (_gbbg *CT_MoveBookmark )Validate ()error {return _gbbg .ValidateWithPath ("\u0043T\u005fM\u006f\u0076\u0065\u0042\u006f\u006f\u006b\u006d\u0061\u0072\u006b");};func NewCT_TrPrChange ()*CT_TrPrChange {_eefcb :=&CT_TrPrChange {};_eefcb .TrPr =NewCT_TrPrBase ();return _eefcb ;};type CT_Markup struct{
 
 // Annotation Identifier
-IdAttr int64 ;};func (_acbee *EG_ContentRunContent )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bgbbd :for {_eaecbd ,_cebfeg :=d .Token ();if _cebfeg !=nil {return _cebfeg ;};switch _gfdcfd :=_eaecbd .(type ){case _g .StartElement :switch _gfdcfd .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_acbee .CustomXml =NewCT_CustomXmlRun ();if _bbgcg :=d .DecodeElement (_acbee .CustomXml ,&_gfdcfd );_bbgcg !=nil {return _bbgcg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006d\u0061\u0072\u0074\u0054\u0061\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006d\u0061\u0072\u0074\u0054\u0061\u0067"}:_acbee .SmartTag =NewCT_SmartTagRun ();if _cfecgd :=d .DecodeElement (_acbee .SmartTag ,&_gfdcfd );_cfecgd !=nil {return _cfecgd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_acbee .Sdt =NewCT_SdtRun ();if _gcafc :=d .DecodeElement (_acbee .Sdt ,&_gfdcfd );_gcafc !=nil {return _gcafc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0069\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0069\u0072"}:_acbee .Dir =NewCT_DirContentRun ();if _edggda :=d .DecodeElement (_acbee .Dir ,&_gfdcfd );_edggda !=nil {return _edggda ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0064\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0064\u006f"}:_acbee .Bdo =NewCT_BdoContentRun ();if _cfgedg :=d .DecodeElement (_acbee .Bdo ,&_gfdcfd );_cfgedg !=nil {return _cfgedg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072"}:_acbee .R =NewCT_R ();if _abbgbb :=d .DecodeElement (_acbee .R ,&_gfdcfd );_abbgbb !=nil {return _abbgbb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_dfgff :=NewEG_RunLevelElts ();_dfgff .ProofErr =NewCT_ProofErr ();if _gcacb :=d .DecodeElement (_dfgff .ProofErr ,&_gfdcfd );_gcacb !=nil {return _gcacb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_dfgff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_afebg :=NewEG_RunLevelElts ();_afebg .PermStart =NewCT_PermStart ();if _ddfedb :=d .DecodeElement (_afebg .PermStart ,&_gfdcfd );_ddfedb !=nil {return _ddfedb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_afebg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_egebbb :=NewEG_RunLevelElts ();_egebbb .PermEnd =NewCT_Perm ();if _eafdb :=d .DecodeElement (_egebbb .PermEnd ,&_gfdcfd );_eafdb !=nil {return _eafdb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_egebbb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_cdcec :=NewEG_RunLevelElts ();_cdcec .Ins =NewCT_RunTrackChange ();if _badgb :=d .DecodeElement (_cdcec .Ins ,&_gfdcfd );_badgb !=nil {return _badgb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_cdcec );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_afedeg :=NewEG_RunLevelElts ();_afedeg .Del =NewCT_RunTrackChange ();if _gbggbc :=d .DecodeElement (_afedeg .Del ,&_gfdcfd );_gbggbc !=nil {return _gbggbc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_afedeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_gafbcd :=NewEG_RunLevelElts ();_gafbcd .MoveFrom =NewCT_RunTrackChange ();if _bbagg :=d .DecodeElement (_gafbcd .MoveFrom ,&_gfdcfd );_bbagg !=nil {return _bbagg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_gafbcd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_cgbba :=NewEG_RunLevelElts ();_cgbba .MoveTo =NewCT_RunTrackChange ();if _dfbbaf :=d .DecodeElement (_cgbba .MoveTo ,&_gfdcfd );_dfbbaf !=nil {return _dfbbaf ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_cgbba );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_acgfdc :=NewEG_RunLevelElts ();_aaccgb :=NewEG_RangeMarkupElements ();_aaccgb .BookmarkStart =NewCT_Bookmark ();if _bfedgg :=d .DecodeElement (_aaccgb .BookmarkStart ,&_gfdcfd );_bfedgg !=nil {return _bfedgg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_acgfdc );_acgfdc .EG_RangeMarkupElements =append (_acgfdc .EG_RangeMarkupElements ,_aaccgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_dedfed :=NewEG_RunLevelElts ();_debaa :=NewEG_RangeMarkupElements ();_debaa .BookmarkEnd =NewCT_MarkupRange ();if _eadga :=d .DecodeElement (_debaa .BookmarkEnd ,&_gfdcfd );_eadga !=nil {return _eadga ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_dedfed );_dedfed .EG_RangeMarkupElements =append (_dedfed .EG_RangeMarkupElements ,_debaa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_aedcae :=NewEG_RunLevelElts ();_fafdde :=NewEG_RangeMarkupElements ();_fafdde .MoveFromRangeStart =NewCT_MoveBookmark ();if _gbcafe :=d .DecodeElement (_fafdde .MoveFromRangeStart ,&_gfdcfd );_gbcafe !=nil {return _gbcafe ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_aedcae );_aedcae .EG_RangeMarkupElements =append (_aedcae .EG_RangeMarkupElements ,_fafdde );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_feaeb :=NewEG_RunLevelElts ();_eeefff :=NewEG_RangeMarkupElements ();_eeefff .MoveFromRangeEnd =NewCT_MarkupRange ();if _gfefgg :=d .DecodeElement (_eeefff .MoveFromRangeEnd ,&_gfdcfd );_gfefgg !=nil {return _gfefgg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_feaeb );_feaeb .EG_RangeMarkupElements =append (_feaeb .EG_RangeMarkupElements ,_eeefff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_fdaec :=NewEG_RunLevelElts ();_dagdg :=NewEG_RangeMarkupElements ();_dagdg .MoveToRangeStart =NewCT_MoveBookmark ();if _dgedcc :=d .DecodeElement (_dagdg .MoveToRangeStart ,&_gfdcfd );_dgedcc !=nil {return _dgedcc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_fdaec );_fdaec .EG_RangeMarkupElements =append (_fdaec .EG_RangeMarkupElements ,_dagdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_ebcbcg :=NewEG_RunLevelElts ();_eegee :=NewEG_RangeMarkupElements ();_eegee .MoveToRangeEnd =NewCT_MarkupRange ();if _bfbeg :=d .DecodeElement (_eegee .MoveToRangeEnd ,&_gfdcfd );_bfbeg !=nil {return _bfbeg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_ebcbcg );_ebcbcg .EG_RangeMarkupElements =append (_ebcbcg .EG_RangeMarkupElements ,_eegee );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_bbdfdb :=NewEG_RunLevelElts ();_bbgfe :=NewEG_RangeMarkupElements ();_bbgfe .CommentRangeStart =NewCT_MarkupRange ();if _geabeg :=d .DecodeElement (_bbgfe .CommentRangeStart ,&_gfdcfd );_geabeg !=nil {return _geabeg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_bbdfdb );_bbdfdb .EG_RangeMarkupElements =append (_bbdfdb .EG_RangeMarkupElements ,_bbgfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_ccfad :=NewEG_RunLevelElts ();_daagdd :=NewEG_RangeMarkupElements ();_daagdd .CommentRangeEnd =NewCT_MarkupRange ();if _edccg :=d .DecodeElement (_daagdd .CommentRangeEnd ,&_gfdcfd );_edccg !=nil {return _edccg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_ccfad );_ccfad .EG_RangeMarkupElements =append (_ccfad .EG_RangeMarkupElements ,_daagdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_eagcd :=NewEG_RunLevelElts ();_dggcg :=NewEG_RangeMarkupElements ();_dggcg .CustomXmlInsRangeStart =NewCT_TrackChange ();if _ecfcd :=d .DecodeElement (_dggcg .CustomXmlInsRangeStart ,&_gfdcfd );_ecfcd !=nil {return _ecfcd ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_eagcd );_eagcd .EG_RangeMarkupElements =append (_eagcd .EG_RangeMarkupElements ,_dggcg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_bcfcb :=NewEG_RunLevelElts ();_cgabeb :=NewEG_RangeMarkupElements ();_cgabeb .CustomXmlInsRangeEnd =NewCT_Markup ();if _fffde :=d .DecodeElement (_cgabeb .CustomXmlInsRangeEnd ,&_gfdcfd );_fffde !=nil {return _fffde ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_bcfcb );_bcfcb .EG_RangeMarkupElements =append (_bcfcb .EG_RangeMarkupElements ,_cgabeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_cddfca :=NewEG_RunLevelElts ();_dcbfce :=NewEG_RangeMarkupElements ();_dcbfce .CustomXmlDelRangeStart =NewCT_TrackChange ();if _deage :=d .DecodeElement (_dcbfce .CustomXmlDelRangeStart ,&_gfdcfd );_deage !=nil {return _deage ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_cddfca );_cddfca .EG_RangeMarkupElements =append (_cddfca .EG_RangeMarkupElements ,_dcbfce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_ffeaad :=NewEG_RunLevelElts ();_gdgeef :=NewEG_RangeMarkupElements ();_gdgeef .CustomXmlDelRangeEnd =NewCT_Markup ();if _accfed :=d .DecodeElement (_gdgeef .CustomXmlDelRangeEnd ,&_gfdcfd );_accfed !=nil {return _accfed ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_ffeaad );_ffeaad .EG_RangeMarkupElements =append (_ffeaad .EG_RangeMarkupElements ,_gdgeef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_eddgfa :=NewEG_RunLevelElts ();_cafae :=NewEG_RangeMarkupElements ();_cafae .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _gdgggb :=d .DecodeElement (_cafae .CustomXmlMoveFromRangeStart ,&_gfdcfd );_gdgggb !=nil {return _gdgggb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_eddgfa );_eddgfa .EG_RangeMarkupElements =append (_eddgfa .EG_RangeMarkupElements ,_cafae );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_abcef :=NewEG_RunLevelElts ();_adedce :=NewEG_RangeMarkupElements ();_adedce .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _aaagba :=d .DecodeElement (_adedce .CustomXmlMoveFromRangeEnd ,&_gfdcfd );_aaagba !=nil {return _aaagba ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_abcef );_abcef .EG_RangeMarkupElements =append (_abcef .EG_RangeMarkupElements ,_adedce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_baaecf :=NewEG_RunLevelElts ();_fbfde :=NewEG_RangeMarkupElements ();_fbfde .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _caabc :=d .DecodeElement (_fbfde .CustomXmlMoveToRangeStart ,&_gfdcfd );_caabc !=nil {return _caabc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_baaecf );_baaecf .EG_RangeMarkupElements =append (_baaecf .EG_RangeMarkupElements ,_fbfde );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_abgdfc :=NewEG_RunLevelElts ();_acecgg :=NewEG_RangeMarkupElements ();_acecgg .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _gecege :=d .DecodeElement (_acecgg .CustomXmlMoveToRangeEnd ,&_gfdcfd );_gecege !=nil {return _gecege ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_abgdfc );_abgdfc .EG_RangeMarkupElements =append (_abgdfc .EG_RangeMarkupElements ,_acecgg );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_facbg :=NewEG_RunLevelElts ();_egdgf :=NewEG_MathContent ();_egdgf .OMathPara =_ec .NewOMathPara ();if _ggefb :=d .DecodeElement (_egdgf .OMathPara ,&_gfdcfd );_ggefb !=nil {return _ggefb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_facbg );_facbg .EG_MathContent =append (_facbg .EG_MathContent ,_egdgf );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_agaee :=NewEG_RunLevelElts ();_ddafgd :=NewEG_MathContent ();_ddafgd .OMath =_ec .NewOMath ();if _efafbc :=d .DecodeElement (_ddafgd .OMath ,&_gfdcfd );_efafbc !=nil {return _efafbc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_agaee );_agaee .EG_MathContent =append (_agaee .EG_MathContent ,_ddafgd );default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006eg\u0020\u0075\u006es\u0075\u0070\u0070o\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006de\u006et \u006f\u006e\u0020\u0045\u0047\u005f\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0052\u0075\u006e\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0020\u0025\u0076",_gfdcfd .Name );if _fdfdb :=d .Skip ();_fdfdb !=nil {return _fdfdb ;};};case _g .EndElement :break _bgbbd ;case _g .CharData :};};return nil ;};func (_fefbgc ST_BrType )String ()string {switch _fefbgc {case 0:return "";case 1:return "\u0070\u0061\u0067\u0065";case 2:return "\u0063\u006f\u006c\u0075\u006d\u006e";case 3:return "\u0074\u0065\u0078t\u0057\u0072\u0061\u0070\u0070\u0069\u006e\u0067";};return "";};func (_deccf ST_FrameScrollbar )String ()string {switch _deccf {case 0:return "";case 1:return "\u006f\u006e";case 2:return "\u006f\u0066\u0066";case 3:return "\u0061\u0075\u0074\u006f";};return "";};func (_afbba ST_Zoom )Validate ()error {return _afbba .ValidateWithPath ("")};func (_gaacbb ST_MailMergeSourceType )ValidateWithPath (path string )error {switch _gaacbb {case 0,1,2,3,4,5,6,7,8,9:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_gaacbb ));};return nil ;};func NewCT_ParaRPr ()*CT_ParaRPr {_cbgag :=&CT_ParaRPr {};return _cbgag };func (_ebaea *CT_TrackChangeRange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ebaea .DisplacedByCustomXmlAttr !=ST_DisplacedByCustomXmlUnset {_dbaee ,_bafgg :=_ebaea .DisplacedByCustomXmlAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0064\u0069sp\u006c\u0061\u0063\u0065\u0064\u0042\u0079\u0043\u0075\u0073\u0074\u006f\u006d\u0058m\u006c"});if _bafgg !=nil {return _bafgg ;};start .Attr =append (start .Attr ,_dbaee );};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_ebaea .AuthorAttr )});if _ebaea .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_ebaea .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_ebaea .IdAttr )});start .Name .Local ="w\u003a\u0043\u0054\u005fTr\u0061c\u006b\u0043\u0068\u0061\u006eg\u0065\u0052\u0061\u006e\u0067\u0065";e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_agbfcd WdST_RelFromH )ValidateWithPath (path string )error {switch _agbfcd {case 0,1,2,3,4,5,6,7,8:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_agbfcd ));};return nil ;};func (_ggacaa ST_TblWidth )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_bdabb :=_g .Attr {};_bdabb .Name =name ;switch _ggacaa {case ST_TblWidthUnset :_bdabb .Value ="";case ST_TblWidthNil :_bdabb .Value ="\u006e\u0069\u006c";case ST_TblWidthPct :_bdabb .Value ="\u0070\u0063\u0074";case ST_TblWidthDxa :_bdabb .Value ="\u0064\u0078\u0061";case ST_TblWidthAuto :_bdabb .Value ="\u0061\u0075\u0074\u006f";};return _bdabb ,nil ;};const (ST_VerticalJcUnset ST_VerticalJc =0;ST_VerticalJcTop ST_VerticalJc =1;ST_VerticalJcCenter ST_VerticalJc =2;ST_VerticalJcBoth ST_VerticalJc =3;ST_VerticalJcBottom ST_VerticalJc =4;);
+IdAttr int64 ;};func (_acbee *EG_ContentRunContent )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bgbbd :for {_eaecbd ,_cebfeg :=d .Token ();if _cebfeg !=nil {return _cebfeg ;};switch _gfdcfd :=_eaecbd .(type ){case _g .StartElement :switch _gfdcfd .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_acbee .CustomXml =NewCT_CustomXmlRun ();if _bbgcg :=d .DecodeElement (_acbee .CustomXml ,&_gfdcfd );_bbgcg !=nil {return _bbgcg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006d\u0061\u0072\u0074\u0054\u0061\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006d\u0061\u0072\u0074\u0054\u0061\u0067"}:_acbee .SmartTag =NewCT_SmartTagRun ();if _cfecgd :=d .DecodeElement (_acbee .SmartTag ,&_gfdcfd );_cfecgd !=nil {return _cfecgd ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_acbee .Sdt =NewCT_SdtRun ();if _gcafc :=d .DecodeElement (_acbee .Sdt ,&_gfdcfd );_gcafc !=nil {return _gcafc ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0069\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0069\u0072"}:_acbee .Dir =NewCT_DirContentRun ();if _edggda :=d .DecodeElement (_acbee .Dir ,&_gfdcfd );_edggda !=nil {return _edggda ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0064\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0064\u006f"}:_acbee .Bdo =NewCT_BdoContentRun ();if _cfgedg :=d .DecodeElement (_acbee .Bdo ,&_gfdcfd );_cfgedg !=nil {return _cfgedg ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072"}:_acbee .R =NewCT_R ();if _abbgbb :=d .DecodeElement (_acbee .R ,&_gfdcfd );_abbgbb !=nil {return _abbgbb ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_dfgff :=NewEG_RunLevelElts ();_dfgff .ProofErr =NewCT_ProofErr ();if _gcacb :=d .DecodeElement (_dfgff .ProofErr ,&_gfdcfd );_gcacb !=nil {return _gcacb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_dfgff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_afebg :=NewEG_RunLevelElts ();_afebg .PermStart =NewCT_PermStart ();if _ddfedb :=d .DecodeElement (_afebg .PermStart ,&_gfdcfd );_ddfedb !=nil {return _ddfedb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_afebg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_egebbb :=NewEG_RunLevelElts ();_egebbb .PermEnd =NewCT_Perm ();if _eafdb :=d .DecodeElement (_egebbb .PermEnd ,&_gfdcfd );_eafdb !=nil {return _eafdb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_egebbb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_cdcec :=NewEG_RunLevelElts ();_cdcec .Ins =NewCT_RunTrackChange ();if _badgb :=d .DecodeElement (_cdcec .Ins ,&_gfdcfd );_badgb !=nil {return _badgb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_cdcec );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_afedeg :=NewEG_RunLevelElts ();_afedeg .Del =NewCT_RunTrackChange ();if _gbggbc :=d .DecodeElement (_afedeg .Del ,&_gfdcfd );_gbggbc !=nil {return _gbggbc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_afedeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_gafbcd :=NewEG_RunLevelElts ();_gafbcd .MoveFrom =NewCT_RunTrackChange ();if _bbagg :=d .DecodeElement (_gafbcd .MoveFrom ,&_gfdcfd );_bbagg !=nil {return _bbagg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_gafbcd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_cgbba :=NewEG_RunLevelElts ();_cgbba .MoveTo =NewCT_RunTrackChange ();if _dfbbaf :=d .DecodeElement (_cgbba .MoveTo ,&_gfdcfd );_dfbbaf !=nil {return _dfbbaf ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_cgbba );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_acgfdc :=NewEG_RunLevelElts ();_aaccgb :=NewEG_RangeMarkupElements ();_aaccgb .BookmarkStart =NewCT_Bookmark ();if _bfedgg :=d .DecodeElement (_aaccgb .BookmarkStart ,&_gfdcfd );_bfedgg !=nil {return _bfedgg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_acgfdc );_acgfdc .EG_RangeMarkupElements =append (_acgfdc .EG_RangeMarkupElements ,_aaccgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_dedfed :=NewEG_RunLevelElts ();_debaa :=NewEG_RangeMarkupElements ();_debaa .BookmarkEnd =NewCT_MarkupRange ();if _eadga :=d .DecodeElement (_debaa .BookmarkEnd ,&_gfdcfd );_eadga !=nil {return _eadga ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_dedfed );_dedfed .EG_RangeMarkupElements =append (_dedfed .EG_RangeMarkupElements ,_debaa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_aedcae :=NewEG_RunLevelElts ();_fafdde :=NewEG_RangeMarkupElements ();_fafdde .MoveFromRangeStart =NewCT_MoveBookmark ();if _gbcafe :=d .DecodeElement (_fafdde .MoveFromRangeStart ,&_gfdcfd );_gbcafe !=nil {return _gbcafe ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_aedcae );_aedcae .EG_RangeMarkupElements =append (_aedcae .EG_RangeMarkupElements ,_fafdde );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_feaeb :=NewEG_RunLevelElts ();_eeefff :=NewEG_RangeMarkupElements ();_eeefff .MoveFromRangeEnd =NewCT_MarkupRange ();if _gfefgg :=d .DecodeElement (_eeefff .MoveFromRangeEnd ,&_gfdcfd );_gfefgg !=nil {return _gfefgg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_feaeb );_feaeb .EG_RangeMarkupElements =append (_feaeb .EG_RangeMarkupElements ,_eeefff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_fdaec :=NewEG_RunLevelElts ();_dagdg :=NewEG_RangeMarkupElements ();_dagdg .MoveToRangeStart =NewCT_MoveBookmark ();if _dgedcc :=d .DecodeElement (_dagdg .MoveToRangeStart ,&_gfdcfd );_dgedcc !=nil {return _dgedcc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_fdaec );_fdaec .EG_RangeMarkupElements =append (_fdaec .EG_RangeMarkupElements ,_dagdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_ebcbcg :=NewEG_RunLevelElts ();_eegee :=NewEG_RangeMarkupElements ();_eegee .MoveToRangeEnd =NewCT_MarkupRange ();if _bfbeg :=d .DecodeElement (_eegee .MoveToRangeEnd ,&_gfdcfd );_bfbeg !=nil {return _bfbeg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_ebcbcg );_ebcbcg .EG_RangeMarkupElements =append (_ebcbcg .EG_RangeMarkupElements ,_eegee );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_bbdfdb :=NewEG_RunLevelElts ();_bbgfe :=NewEG_RangeMarkupElements ();_bbgfe .CommentRangeStart =NewCT_MarkupRange ();if _geabeg :=d .DecodeElement (_bbgfe .CommentRangeStart ,&_gfdcfd );_geabeg !=nil {return _geabeg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_bbdfdb );_bbdfdb .EG_RangeMarkupElements =append (_bbdfdb .EG_RangeMarkupElements ,_bbgfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_ccfad :=NewEG_RunLevelElts ();_daagdd :=NewEG_RangeMarkupElements ();_daagdd .CommentRangeEnd =NewCT_MarkupRange ();if _edccg :=d .DecodeElement (_daagdd .CommentRangeEnd ,&_gfdcfd );_edccg !=nil {return _edccg ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_ccfad );_ccfad .EG_RangeMarkupElements =append (_ccfad .EG_RangeMarkupElements ,_daagdd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_eagcd :=NewEG_RunLevelElts ();_dggcg :=NewEG_RangeMarkupElements ();_dggcg .CustomXmlInsRangeStart =NewCT_TrackChange ();if _ecfcd :=d .DecodeElement (_dggcg .CustomXmlInsRangeStart ,&_gfdcfd );_ecfcd !=nil {return _ecfcd ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_eagcd );_eagcd .EG_RangeMarkupElements =append (_eagcd .EG_RangeMarkupElements ,_dggcg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_bcfcb :=NewEG_RunLevelElts ();_cgabeb :=NewEG_RangeMarkupElements ();_cgabeb .CustomXmlInsRangeEnd =NewCT_Markup ();if _fffde :=d .DecodeElement (_cgabeb .CustomXmlInsRangeEnd ,&_gfdcfd );_fffde !=nil {return _fffde ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_bcfcb );_bcfcb .EG_RangeMarkupElements =append (_bcfcb .EG_RangeMarkupElements ,_cgabeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_cddfca :=NewEG_RunLevelElts ();_dcbfce :=NewEG_RangeMarkupElements ();_dcbfce .CustomXmlDelRangeStart =NewCT_TrackChange ();if _deage :=d .DecodeElement (_dcbfce .CustomXmlDelRangeStart ,&_gfdcfd );_deage !=nil {return _deage ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_cddfca );_cddfca .EG_RangeMarkupElements =append (_cddfca .EG_RangeMarkupElements ,_dcbfce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_ffeaad :=NewEG_RunLevelElts ();_gdgeef :=NewEG_RangeMarkupElements ();_gdgeef .CustomXmlDelRangeEnd =NewCT_Markup ();if _accfed :=d .DecodeElement (_gdgeef .CustomXmlDelRangeEnd ,&_gfdcfd );_accfed !=nil {return _accfed ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_ffeaad );_ffeaad .EG_RangeMarkupElements =append (_ffeaad .EG_RangeMarkupElements ,_gdgeef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_eddgfa :=NewEG_RunLevelElts ();_cafae :=NewEG_RangeMarkupElements ();_cafae .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _gdgggb :=d .DecodeElement (_cafae .CustomXmlMoveFromRangeStart ,&_gfdcfd );_gdgggb !=nil {return _gdgggb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_eddgfa );_eddgfa .EG_RangeMarkupElements =append (_eddgfa .EG_RangeMarkupElements ,_cafae );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_abcef :=NewEG_RunLevelElts ();_adedce :=NewEG_RangeMarkupElements ();_adedce .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _aaagba :=d .DecodeElement (_adedce .CustomXmlMoveFromRangeEnd ,&_gfdcfd );_aaagba !=nil {return _aaagba ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_abcef );_abcef .EG_RangeMarkupElements =append (_abcef .EG_RangeMarkupElements ,_adedce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_baaecf :=NewEG_RunLevelElts ();_fbfde :=NewEG_RangeMarkupElements ();_fbfde .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _caabc :=d .DecodeElement (_fbfde .CustomXmlMoveToRangeStart ,&_gfdcfd );_caabc !=nil {return _caabc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_baaecf );_baaecf .EG_RangeMarkupElements =append (_baaecf .EG_RangeMarkupElements ,_fbfde );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_abgdfc :=NewEG_RunLevelElts ();_acecgg :=NewEG_RangeMarkupElements ();_acecgg .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _gecege :=d .DecodeElement (_acecgg .CustomXmlMoveToRangeEnd ,&_gfdcfd );_gecege !=nil {return _gecege ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_abgdfc );_abgdfc .EG_RangeMarkupElements =append (_abgdfc .EG_RangeMarkupElements ,_acecgg );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_facbg :=NewEG_RunLevelElts ();_egdgf :=NewEG_MathContent ();_egdgf .OMathPara =_ec .NewOMathPara ();if _ggefb :=d .DecodeElement (_egdgf .OMathPara ,&_gfdcfd );_ggefb !=nil {return _ggefb ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_facbg );_facbg .EG_MathContent =append (_facbg .EG_MathContent ,_egdgf );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_agaee :=NewEG_RunLevelElts ();_ddafgd :=NewEG_MathContent ();_ddafgd .OMath =_ec .NewOMath ();if _efafbc :=d .DecodeElement (_ddafgd .OMath ,&_gfdcfd );_efafbc !=nil {return _efafbc ;};_acbee .EG_RunLevelElts =append (_acbee .EG_RunLevelElts ,_agaee );_agaee .EG_MathContent =append (_agaee .EG_MathContent ,_ddafgd );default:_ga .Log ("\u0073\u006b\u0069\u0070\u0070\u0069\u006eg\u0020\u0075\u006es\u0075\u0070\u0070o\u0072\u0074e\u0064\u0020\u0065\u006c\u0065\u006de\u006et \u006f\u006e\u0020\u0045\u0047\u005f\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0052\u0075\u006e\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0020\u0025\u0076",_gfdcfd .Name );if _fdfdb :=d .Skip ();_fdfdb !=nil {return _fdfdb ;};};case _g .EndElement :break _bgbbd ;case _g .CharData :};};return nil ;};func (_fefbgc ST_BrType )String ()string {switch _fefbgc {case 0:return "";case 1:return "\u0070\u0061\u0067\u0065";case 2:return "\u0063\u006f\u006c\u0075\u006d\u006e";case 3:return "\u0074\u0065\u0078t\u0057\u0072\u0061\u0070\u0070\u0069\u006e\u0067";};return "";};func (_deccf ST_FrameScrollbar )String ()string {switch _deccf {case 0:return "";case 1:return "\u006f\u006e";case 2:return "\u006f\u0066\u0066";case 3:return "\u0061\u0075\u0074\u006f";};return "";};func (_afbba ST_Zoom )Validate ()error {return _afbba .ValidateWithPath ("")};func (_gaacbb ST_MailMergeSourceType )ValidateWithPath (path string )error {switch _gaacbb {case 0,1,2,3,4,5,6,7,8,9:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_gaacbb ));};return nil ;};func NewCT_ParaRPr ()*CT_ParaRPr {_cbgag :=&CT_ParaRPr {};return _cbgag };func (_ebaea *CT_TrackChangeRange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ebaea .DisplacedByCustomXmlAttr !=ST_DisplacedByCustomXmlUnset {_dbaee ,_bafgg :=_ebaea .DisplacedByCustomXmlAttr .MarshalXMLAttr (_g .Name {Local :"\u0077\u003a\u0064\u0069sp\u006c\u0061\u0063\u0065\u0064\u0042\u0079\u0043\u0075\u0073\u0074\u006f\u006d\u0058m\u006c"});if _bafgg !=nil {return _bafgg ;};start .Attr =append (start .Attr ,_dbaee );};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_ebaea .AuthorAttr )});if _ebaea .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_ebaea .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_ebaea .IdAttr )});start .Name .Local ="w\u003a\u0043\u0054\u005fTr\u0061c\u006b\u0043\u0068\u0061\u006eg\u0065\u0052\u0061\u006e\u0067\u0065";e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_agbfcd WdST_RelFromH )ValidateWithPath (path string )error {switch _agbfcd {case 0,1,2,3,4,5,6,7,8:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_agbfcd ));};return nil ;};func (_ggacaa ST_TblWidth )MarshalXMLAttr (name _g .Name )(_g .Attr ,error ){_bdabb :=_g .Attr {};_bdabb .Name =name ;switch _ggacaa {case ST_TblWidthUnset :_bdabb .Value ="";case ST_TblWidthNil :_bdabb .Value ="\u006e\u0069\u006c";case ST_TblWidthPct :_bdabb .Value ="\u0070\u0063\u0074";case ST_TblWidthDxa :_bdabb .Value ="\u0064\u0078\u0061";case ST_TblWidthAuto :_bdabb .Value ="\u0061\u0075\u0074\u006f";};return _bdabb ,nil ;};const (ST_VerticalJcUnset ST_VerticalJc =0;ST_VerticalJcTop ST_VerticalJc =1;ST_VerticalJcCenter ST_VerticalJc =2;ST_VerticalJcBoth ST_VerticalJc =3;ST_VerticalJcBottom ST_VerticalJc =4;);
 
 // ValidateWithPath validates the CT_Recipients and its children, prefixing error messages with path
 func (_bbefe *CT_Recipients )ValidateWithPath (path string )error {for _beeeg ,_agbab :=range _bbefe .RecipientData {if _egdbbe :=_agbab .ValidateWithPath (_gd .Sprintf ("%\u0073/\u0052\u0065\u0063\u0069\u0070\u0069\u0065\u006et\u0044\u0061\u0074\u0061[%\u0064\u005d",path ,_beeeg ));_egdbbe !=nil {return _egdbbe ;};};return nil ;};func (_begcf *CT_PPrBase )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _begcf .PStyle !=nil {_bebdc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_begcf .PStyle ,_bebdc );};if _begcf .KeepNext !=nil {_eeae :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006b\u0065\u0065\u0070\u004e\u0065\u0078\u0074"}};e .EncodeElement (_begcf .KeepNext ,_eeae );};if _begcf .KeepLines !=nil {_edgeb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u006b\u0065\u0065\u0070\u004c\u0069\u006e\u0065\u0073"}};e .EncodeElement (_begcf .KeepLines ,_edgeb );};if _begcf .PageBreakBefore !=nil {_cfceg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0061\u0067\u0065\u0042\u0072\u0065\u0061\u006b\u0042e\u0066\u006f\u0072\u0065"}};e .EncodeElement (_begcf .PageBreakBefore ,_cfceg );};if _begcf .FramePr !=nil {_faaff :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0066\u0072\u0061\u006d\u0065\u0050r"}};e .EncodeElement (_begcf .FramePr ,_faaff );};if _begcf .WidowControl !=nil {_gcgcf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0077\u0069\u0064\u006f\u0077\u0043\u006fn\u0074\u0072\u006f\u006c"}};e .EncodeElement (_begcf .WidowControl ,_gcgcf );};if _begcf .NumPr !=nil {_fgbd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006e\u0075\u006d\u0050\u0072"}};e .EncodeElement (_begcf .NumPr ,_fgbd );};if _begcf .SuppressLineNumbers !=nil {_geffd :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0073\u0075\u0070pr\u0065s\u0073\u004c\u0069\u006e\u0065N\u0075\u006d\u0062\u0065\u0072\u0073"}};e .EncodeElement (_begcf .SuppressLineNumbers ,_geffd );};if _begcf .PBdr !=nil {_cecbb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0070\u0042\u0064\u0072"}};e .EncodeElement (_begcf .PBdr ,_cecbb );};if _begcf .Shd !=nil {_ddfc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_begcf .Shd ,_ddfc );};if _begcf .Tabs !=nil {_bcbfc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u0061\u0062\u0073"}};e .EncodeElement (_begcf .Tabs ,_bcbfc );};if _begcf .SuppressAutoHyphens !=nil {_egeff :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0073\u0075\u0070pr\u0065s\u0073\u0041\u0075\u0074\u006fH\u0079\u0070\u0068\u0065\u006e\u0073"}};e .EncodeElement (_begcf .SuppressAutoHyphens ,_egeff );};if _begcf .Kinsoku !=nil {_dgbbb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006b\u0069\u006e\u0073\u006f\u006bu"}};e .EncodeElement (_begcf .Kinsoku ,_dgbbb );};if _begcf .WordWrap !=nil {_acac :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0077\u006f\u0072\u0064\u0057\u0072\u0061\u0070"}};e .EncodeElement (_begcf .WordWrap ,_acac );};if _begcf .OverflowPunct !=nil {_ddfaf :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006fv\u0065\u0072\u0066\u006c\u006f\u0077\u0050\u0075\u006e\u0063\u0074"}};e .EncodeElement (_begcf .OverflowPunct ,_ddfaf );};if _begcf .TopLinePunct !=nil {_ggafea :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074\u006f\u0070\u004c\u0069\u006e\u0065P\u0075\u006e\u0063\u0074"}};e .EncodeElement (_begcf .TopLinePunct ,_ggafea );};if _begcf .AutoSpaceDE !=nil {_dfgbaa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u006f\u0053\u0070\u0061\u0063\u0065\u0044\u0045"}};e .EncodeElement (_begcf .AutoSpaceDE ,_dfgbaa );};if _begcf .AutoSpaceDN !=nil {_ceffa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u006f\u0053\u0070\u0061\u0063\u0065\u0044\u004e"}};e .EncodeElement (_begcf .AutoSpaceDN ,_ceffa );};if _begcf .Bidi !=nil {_ecfeba :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0062\u0069\u0064\u0069"}};e .EncodeElement (_begcf .Bidi ,_ecfeba );};if _begcf .AdjustRightInd !=nil {_faadb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003aa\u0064\u006a\u0075s\u0074\u0052\u0069\u0067\u0068\u0074\u0049\u006e\u0064"}};e .EncodeElement (_begcf .AdjustRightInd ,_faadb );};if _begcf .SnapToGrid !=nil {_bebgc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073n\u0061\u0070\u0054\u006f\u0047\u0072\u0069\u0064"}};e .EncodeElement (_begcf .SnapToGrid ,_bebgc );};if _begcf .Spacing !=nil {_ageb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0073\u0070\u0061\u0063\u0069\u006eg"}};e .EncodeElement (_begcf .Spacing ,_ageb );};if _begcf .Ind !=nil {_affdg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0069n\u0064"}};e .EncodeElement (_begcf .Ind ,_affdg );};if _begcf .ContextualSpacing !=nil {_fegafc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u006fnt\u0065\u0078\u0074\u0075\u0061\u006c\u0053\u0070\u0061\u0063\u0069\u006e\u0067"}};e .EncodeElement (_begcf .ContextualSpacing ,_fegafc );};if _begcf .MirrorIndents !=nil {_bcecf :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u006di\u0072\u0072\u006f\u0072\u0049\u006e\u0064\u0065\u006e\u0074\u0073"}};e .EncodeElement (_begcf .MirrorIndents ,_bcecf );};if _begcf .SuppressOverlap !=nil {_ececc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073\u0075\u0070\u0070\u0072\u0065\u0073\u0073\u004f\u0076e\u0072\u006c\u0061\u0070"}};e .EncodeElement (_begcf .SuppressOverlap ,_ececc );};if _begcf .Jc !=nil {_dfdfg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006a\u0063"}};e .EncodeElement (_begcf .Jc ,_dfdfg );};if _begcf .TextDirection !=nil {_cdcfcb :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0044\u0069\u0072\u0065\u0063\u0074\u0069\u006f\u006e"}};e .EncodeElement (_begcf .TextDirection ,_cdcfcb );};if _begcf .TextAlignment !=nil {_bggaa :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0041\u006c\u0069\u0067\u006e\u006d\u0065\u006e\u0074"}};e .EncodeElement (_begcf .TextAlignment ,_bggaa );};if _begcf .TextboxTightWrap !=nil {_cdbef :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0062\u006f\u0078T\u0069\u0067h\u0074\u0057\u0072\u0061\u0070"}};e .EncodeElement (_begcf .TextboxTightWrap ,_cdbef );};if _begcf .OutlineLvl !=nil {_ffacd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006fu\u0074\u006c\u0069\u006e\u0065\u004c\u0076\u006c"}};e .EncodeElement (_begcf .OutlineLvl ,_ffacd );};if _begcf .DivId !=nil {_ffba :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0064\u0069\u0076\u0049\u0064"}};e .EncodeElement (_begcf .DivId ,_ffba );};if _begcf .CnfStyle !=nil {_dcdfa :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u006e\u0066\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_begcf .CnfStyle ,_dcdfa );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
@@ -6039,7 +6053,7 @@ func (_bddcde *CT_SdtComboBox )Validate ()error {return _bddcde .ValidateWithPat
 func (_bgfd *CT_PTab )Validate ()error {return _bgfd .ValidateWithPath ("\u0043T\u005f\u0050\u0054\u0061\u0062");};func (_dbdaea *ST_CombineBrackets )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_dbdaea =0;case "\u006e\u006f\u006e\u0065":*_dbdaea =1;case "\u0072\u006f\u0075n\u0064":*_dbdaea =2;case "\u0073\u0071\u0075\u0061\u0072\u0065":*_dbdaea =3;case "\u0061\u006e\u0067l\u0065":*_dbdaea =4;case "\u0063\u0075\u0072l\u0079":*_dbdaea =5;};return nil ;};func (_acebc *CT_Numbering )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_dcgec :for {_aeffca ,_adae :=d .Token ();if _adae !=nil {return _adae ;};switch _ccbef :=_aeffca .(type ){case _g .StartElement :switch _ccbef .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006e\u0075\u006dP\u0069\u0063\u0042\u0075\u006c\u006c\u0065\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006e\u0075\u006dP\u0069\u0063\u0042\u0075\u006c\u006c\u0065\u0074"}:_fecef :=NewCT_NumPicBullet ();if _edfbg :=d .DecodeElement (_fecef ,&_ccbef );_edfbg !=nil {return _edfbg ;};_acebc .NumPicBullet =append (_acebc .NumPicBullet ,_fecef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"a\u0062\u0073\u0074\u0072\u0061\u0063\u0074\u004e\u0075\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"a\u0062\u0073\u0074\u0072\u0061\u0063\u0074\u004e\u0075\u006d"}:_becga :=NewCT_AbstractNum ();if _ddgbff :=d .DecodeElement (_becga ,&_ccbef );_ddgbff !=nil {return _ddgbff ;};_acebc .AbstractNum =append (_acebc .AbstractNum ,_becga );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006e\u0075\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006e\u0075\u006d"}:_abgfc :=NewCT_Num ();if _abfbc :=d .DecodeElement (_abgfc ,&_ccbef );_abfbc !=nil {return _abfbc ;};_acebc .Num =append (_acebc .Num ,_abgfc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006e\u0075\u006d\u0049\u0064\u004d\u0061\u0063\u0041\u0074\u0043\u006ce\u0061\u006e\u0075\u0070"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006e\u0075\u006d\u0049\u0064\u004d\u0061\u0063\u0041\u0074\u0043\u006ce\u0061\u006e\u0075\u0070"}:_acebc .NumIdMacAtCleanup =NewCT_DecimalNumber ();if _dgggg :=d .DecodeElement (_acebc .NumIdMacAtCleanup ,&_ccbef );_dgggg !=nil {return _dgggg ;};default:_ga .Log ("s\u006b\u0069\u0070\u0070\u0069\u006e\u0067\u0020\u0075n\u0073\u0075\u0070\u0070\u006f\u0072\u0074ed\u0020\u0065\u006c\u0065m\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0043\u0054_N\u0075\u006db\u0065\u0072\u0069\u006e\u0067\u0020\u0025\u0076",_ccbef .Name );if _dfedd :=d .Skip ();_dfedd !=nil {return _dfedd ;};};case _g .EndElement :break _dcgec ;case _g .CharData :};};return nil ;};func (_gagg *CT_Height )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _gagg .ValAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076a\u006c"},Value :_gd .Sprintf ("\u0025\u0076",*_gagg .ValAttr )});};if _gagg .HRuleAttr !=ST_HeightRuleUnset {_fedbf ,_eebcda :=_gagg .HRuleAttr .MarshalXMLAttr (_g .Name {Local :"\u0077:\u0068\u0052\u0075\u006c\u0065"});if _eebcda !=nil {return _eebcda ;};start .Attr =append (start .Attr ,_fedbf );};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type WdCT_PosH struct{RelativeFromAttr WdST_RelFromH ;Choice *WdCT_PosHChoice ;};type CT_HMerge struct{
 
 // Horizontal Merge Type
-ValAttr ST_Merge ;};func (_fegcd *CT_MathCtrlDel )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fegcd .AuthorAttr )});if _fegcd .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_fegcd .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fegcd .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_bfdbfa *TxbxContent )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bfdbfa .CT_TxbxContent =*NewCT_TxbxContent ();_ddebbc :for {_ccbfb ,_cbbfg :=d .Token ();if _cbbfg !=nil {return _cbbfg ;};switch _ggcedd :=_ccbfb .(type ){case _g .StartElement :switch _ggcedd .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u006c\u0074\u0043\u0068\u0075\u006e\u006b"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u006c\u0074\u0043\u0068\u0075\u006e\u006b"}:_fbafca :=NewCT_AltChunk ();if _gfgb :=d .DecodeElement (_fbafca ,&_ggcedd );_gfgb !=nil {return _gfgb ;};_bfdbfa .AltChunk =append (_bfdbfa .AltChunk ,_fbafca );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_bdcada :=NewEG_ContentBlockContent ();_bdcada .CustomXml =NewCT_CustomXmlBlock ();if _afefc :=d .DecodeElement (_bdcada .CustomXml ,&_ggcedd );_afefc !=nil {return _afefc ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bdcada );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_fgfgb :=NewEG_ContentBlockContent ();_fgfgb .Sdt =NewCT_SdtBlock ();if _bbebg :=d .DecodeElement (_fgfgb .Sdt ,&_ggcedd );_bbebg !=nil {return _bbebg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fgfgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070"}:_ggeab :=NewEG_ContentBlockContent ();_ccegec :=NewCT_P ();if _cfead :=d .DecodeElement (_ccegec ,&_ggcedd );_cfead !=nil {return _cfead ;};_ggeab .P =append (_ggeab .P ,_ccegec );_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ggeab );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0062\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0062\u006c"}:_bfcfga :=NewEG_ContentBlockContent ();_bafde :=NewCT_Tbl ();if _bcgbga :=d .DecodeElement (_bafde ,&_ggcedd );_bcgbga !=nil {return _bcgbga ;};_bfcfga .Tbl =append (_bfcfga .Tbl ,_bafde );_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bfcfga );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_gcegcg :=NewEG_ContentBlockContent ();_bdfce :=NewEG_RunLevelElts ();_bdfce .ProofErr =NewCT_ProofErr ();if _degfa :=d .DecodeElement (_bdfce .ProofErr ,&_ggcedd );_degfa !=nil {return _degfa ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gcegcg );_gcegcg .EG_RunLevelElts =append (_gcegcg .EG_RunLevelElts ,_bdfce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_ggfbbd :=NewEG_ContentBlockContent ();_cdfgca :=NewEG_RunLevelElts ();_cdfgca .PermStart =NewCT_PermStart ();if _edebg :=d .DecodeElement (_cdfgca .PermStart ,&_ggcedd );_edebg !=nil {return _edebg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ggfbbd );_ggfbbd .EG_RunLevelElts =append (_ggfbbd .EG_RunLevelElts ,_cdfgca );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_caecccg :=NewEG_ContentBlockContent ();_dabgf :=NewEG_RunLevelElts ();_dabgf .PermEnd =NewCT_Perm ();if _abaff :=d .DecodeElement (_dabgf .PermEnd ,&_ggcedd );_abaff !=nil {return _abaff ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_caecccg );_caecccg .EG_RunLevelElts =append (_caecccg .EG_RunLevelElts ,_dabgf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_fceeg :=NewEG_ContentBlockContent ();_gggbgb :=NewEG_RunLevelElts ();_gggbgb .Ins =NewCT_RunTrackChange ();if _gdfec :=d .DecodeElement (_gggbgb .Ins ,&_ggcedd );_gdfec !=nil {return _gdfec ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fceeg );_fceeg .EG_RunLevelElts =append (_fceeg .EG_RunLevelElts ,_gggbgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_fddba :=NewEG_ContentBlockContent ();_dcbfe :=NewEG_RunLevelElts ();_dcbfe .Del =NewCT_RunTrackChange ();if _bggcec :=d .DecodeElement (_dcbfe .Del ,&_ggcedd );_bggcec !=nil {return _bggcec ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fddba );_fddba .EG_RunLevelElts =append (_fddba .EG_RunLevelElts ,_dcbfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_decca :=NewEG_ContentBlockContent ();_gedagf :=NewEG_RunLevelElts ();_gedagf .MoveFrom =NewCT_RunTrackChange ();if _decfgd :=d .DecodeElement (_gedagf .MoveFrom ,&_ggcedd );_decfgd !=nil {return _decfgd ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_decca );_decca .EG_RunLevelElts =append (_decca .EG_RunLevelElts ,_gedagf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_fdgee :=NewEG_ContentBlockContent ();_ddfffb :=NewEG_RunLevelElts ();_ddfffb .MoveTo =NewCT_RunTrackChange ();if _fagbe :=d .DecodeElement (_ddfffb .MoveTo ,&_ggcedd );_fagbe !=nil {return _fagbe ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fdgee );_fdgee .EG_RunLevelElts =append (_fdgee .EG_RunLevelElts ,_ddfffb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_gccce :=NewEG_ContentBlockContent ();_ceacf :=NewEG_RunLevelElts ();_cacff :=NewEG_RangeMarkupElements ();_cacff .BookmarkStart =NewCT_Bookmark ();if _aecceg :=d .DecodeElement (_cacff .BookmarkStart ,&_ggcedd );_aecceg !=nil {return _aecceg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gccce );_gccce .EG_RunLevelElts =append (_gccce .EG_RunLevelElts ,_ceacf );_ceacf .EG_RangeMarkupElements =append (_ceacf .EG_RangeMarkupElements ,_cacff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_affagf :=NewEG_ContentBlockContent ();_ebfbe :=NewEG_RunLevelElts ();_cgeeg :=NewEG_RangeMarkupElements ();_cgeeg .BookmarkEnd =NewCT_MarkupRange ();if _fbcgcf :=d .DecodeElement (_cgeeg .BookmarkEnd ,&_ggcedd );_fbcgcf !=nil {return _fbcgcf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_affagf );_affagf .EG_RunLevelElts =append (_affagf .EG_RunLevelElts ,_ebfbe );_ebfbe .EG_RangeMarkupElements =append (_ebfbe .EG_RangeMarkupElements ,_cgeeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_deedfeg :=NewEG_ContentBlockContent ();_cgabee :=NewEG_RunLevelElts ();_ffccge :=NewEG_RangeMarkupElements ();_ffccge .MoveFromRangeStart =NewCT_MoveBookmark ();if _ababba :=d .DecodeElement (_ffccge .MoveFromRangeStart ,&_ggcedd );_ababba !=nil {return _ababba ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_deedfeg );_deedfeg .EG_RunLevelElts =append (_deedfeg .EG_RunLevelElts ,_cgabee );_cgabee .EG_RangeMarkupElements =append (_cgabee .EG_RangeMarkupElements ,_ffccge );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_eefbb :=NewEG_ContentBlockContent ();_eedfb :=NewEG_RunLevelElts ();_efdgcg :=NewEG_RangeMarkupElements ();_efdgcg .MoveFromRangeEnd =NewCT_MarkupRange ();if _dfffeb :=d .DecodeElement (_efdgcg .MoveFromRangeEnd ,&_ggcedd );_dfffeb !=nil {return _dfffeb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_eefbb );_eefbb .EG_RunLevelElts =append (_eefbb .EG_RunLevelElts ,_eedfb );_eedfb .EG_RangeMarkupElements =append (_eedfb .EG_RangeMarkupElements ,_efdgcg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_debag :=NewEG_ContentBlockContent ();_cagcab :=NewEG_RunLevelElts ();_agfabff :=NewEG_RangeMarkupElements ();_agfabff .MoveToRangeStart =NewCT_MoveBookmark ();if _fgecbb :=d .DecodeElement (_agfabff .MoveToRangeStart ,&_ggcedd );_fgecbb !=nil {return _fgecbb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_debag );_debag .EG_RunLevelElts =append (_debag .EG_RunLevelElts ,_cagcab );_cagcab .EG_RangeMarkupElements =append (_cagcab .EG_RangeMarkupElements ,_agfabff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_bcadfc :=NewEG_ContentBlockContent ();_cgccg :=NewEG_RunLevelElts ();_cbfffb :=NewEG_RangeMarkupElements ();_cbfffb .MoveToRangeEnd =NewCT_MarkupRange ();if _fdggbf :=d .DecodeElement (_cbfffb .MoveToRangeEnd ,&_ggcedd );_fdggbf !=nil {return _fdggbf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bcadfc );_bcadfc .EG_RunLevelElts =append (_bcadfc .EG_RunLevelElts ,_cgccg );_cgccg .EG_RangeMarkupElements =append (_cgccg .EG_RangeMarkupElements ,_cbfffb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_bedaf :=NewEG_ContentBlockContent ();_ffcad :=NewEG_RunLevelElts ();_egcdcb :=NewEG_RangeMarkupElements ();_egcdcb .CommentRangeStart =NewCT_MarkupRange ();if _dfbfeg :=d .DecodeElement (_egcdcb .CommentRangeStart ,&_ggcedd );_dfbfeg !=nil {return _dfbfeg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bedaf );_bedaf .EG_RunLevelElts =append (_bedaf .EG_RunLevelElts ,_ffcad );_ffcad .EG_RangeMarkupElements =append (_ffcad .EG_RangeMarkupElements ,_egcdcb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_gbdfga :=NewEG_ContentBlockContent ();_ccagff :=NewEG_RunLevelElts ();_deffe :=NewEG_RangeMarkupElements ();_deffe .CommentRangeEnd =NewCT_MarkupRange ();if _dgagf :=d .DecodeElement (_deffe .CommentRangeEnd ,&_ggcedd );_dgagf !=nil {return _dgagf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gbdfga );_gbdfga .EG_RunLevelElts =append (_gbdfga .EG_RunLevelElts ,_ccagff );_ccagff .EG_RangeMarkupElements =append (_ccagff .EG_RangeMarkupElements ,_deffe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_dbcbc :=NewEG_ContentBlockContent ();_cebdea :=NewEG_RunLevelElts ();_ccfdfc :=NewEG_RangeMarkupElements ();_ccfdfc .CustomXmlInsRangeStart =NewCT_TrackChange ();if _adabba :=d .DecodeElement (_ccfdfc .CustomXmlInsRangeStart ,&_ggcedd );_adabba !=nil {return _adabba ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_dbcbc );_dbcbc .EG_RunLevelElts =append (_dbcbc .EG_RunLevelElts ,_cebdea );_cebdea .EG_RangeMarkupElements =append (_cebdea .EG_RangeMarkupElements ,_ccfdfc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_ffgeabf :=NewEG_ContentBlockContent ();_dcggbb :=NewEG_RunLevelElts ();_ggfdg :=NewEG_RangeMarkupElements ();_ggfdg .CustomXmlInsRangeEnd =NewCT_Markup ();if _daeae :=d .DecodeElement (_ggfdg .CustomXmlInsRangeEnd ,&_ggcedd );_daeae !=nil {return _daeae ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ffgeabf );_ffgeabf .EG_RunLevelElts =append (_ffgeabf .EG_RunLevelElts ,_dcggbb );_dcggbb .EG_RangeMarkupElements =append (_dcggbb .EG_RangeMarkupElements ,_ggfdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_dabadf :=NewEG_ContentBlockContent ();_dbeaaff :=NewEG_RunLevelElts ();_dgebgf :=NewEG_RangeMarkupElements ();_dgebgf .CustomXmlDelRangeStart =NewCT_TrackChange ();if _feedac :=d .DecodeElement (_dgebgf .CustomXmlDelRangeStart ,&_ggcedd );_feedac !=nil {return _feedac ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_dabadf );_dabadf .EG_RunLevelElts =append (_dabadf .EG_RunLevelElts ,_dbeaaff );_dbeaaff .EG_RangeMarkupElements =append (_dbeaaff .EG_RangeMarkupElements ,_dgebgf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_cbggcc :=NewEG_ContentBlockContent ();_ebabfg :=NewEG_RunLevelElts ();_bfdgef :=NewEG_RangeMarkupElements ();_bfdgef .CustomXmlDelRangeEnd =NewCT_Markup ();if _agbdd :=d .DecodeElement (_bfdgef .CustomXmlDelRangeEnd ,&_ggcedd );_agbdd !=nil {return _agbdd ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_cbggcc );_cbggcc .EG_RunLevelElts =append (_cbggcc .EG_RunLevelElts ,_ebabfg );_ebabfg .EG_RangeMarkupElements =append (_ebabfg .EG_RangeMarkupElements ,_bfdgef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_effab :=NewEG_ContentBlockContent ();_gefag :=NewEG_RunLevelElts ();_ccgbf :=NewEG_RangeMarkupElements ();_ccgbf .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _bgdedg :=d .DecodeElement (_ccgbf .CustomXmlMoveFromRangeStart ,&_ggcedd );_bgdedg !=nil {return _bgdedg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_effab );_effab .EG_RunLevelElts =append (_effab .EG_RunLevelElts ,_gefag );_gefag .EG_RangeMarkupElements =append (_gefag .EG_RangeMarkupElements ,_ccgbf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_cbeedf :=NewEG_ContentBlockContent ();_ebfde :=NewEG_RunLevelElts ();_gegeg :=NewEG_RangeMarkupElements ();_gegeg .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _ffdbf :=d .DecodeElement (_gegeg .CustomXmlMoveFromRangeEnd ,&_ggcedd );_ffdbf !=nil {return _ffdbf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_cbeedf );_cbeedf .EG_RunLevelElts =append (_cbeedf .EG_RunLevelElts ,_ebfde );_ebfde .EG_RangeMarkupElements =append (_ebfde .EG_RangeMarkupElements ,_gegeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_gfcdb :=NewEG_ContentBlockContent ();_bbadc :=NewEG_RunLevelElts ();_bebgba :=NewEG_RangeMarkupElements ();_bebgba .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _gfcga :=d .DecodeElement (_bebgba .CustomXmlMoveToRangeStart ,&_ggcedd );_gfcga !=nil {return _gfcga ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gfcdb );_gfcdb .EG_RunLevelElts =append (_gfcdb .EG_RunLevelElts ,_bbadc );_bbadc .EG_RangeMarkupElements =append (_bbadc .EG_RangeMarkupElements ,_bebgba );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_cffce :=NewEG_ContentBlockContent ();_fcbcf :=NewEG_RunLevelElts ();_deged :=NewEG_RangeMarkupElements ();_deged .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _baddec :=d .DecodeElement (_deged .CustomXmlMoveToRangeEnd ,&_ggcedd );_baddec !=nil {return _baddec ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_cffce );_cffce .EG_RunLevelElts =append (_cffce .EG_RunLevelElts ,_fcbcf );_fcbcf .EG_RangeMarkupElements =append (_fcbcf .EG_RangeMarkupElements ,_deged );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_ababdg :=NewEG_ContentBlockContent ();_fcgfa :=NewEG_RunLevelElts ();_becba :=NewEG_MathContent ();_becba .OMathPara =_ec .NewOMathPara ();if _fdaffb :=d .DecodeElement (_becba .OMathPara ,&_ggcedd );_fdaffb !=nil {return _fdaffb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ababdg );_ababdg .EG_RunLevelElts =append (_ababdg .EG_RunLevelElts ,_fcgfa );_fcgfa .EG_MathContent =append (_fcgfa .EG_MathContent ,_becba );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_adcdbe :=NewEG_ContentBlockContent ();_fdfde :=NewEG_RunLevelElts ();_ccdba :=NewEG_MathContent ();_ccdba .OMath =_ec .NewOMath ();if _caggbb :=d .DecodeElement (_ccdba .OMath ,&_ggcedd );_caggbb !=nil {return _caggbb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_adcdbe );_adcdbe .EG_RunLevelElts =append (_adcdbe .EG_RunLevelElts ,_fdfde );_fdfde .EG_MathContent =append (_fdfde .EG_MathContent ,_ccdba );default:_ga .Log ("\u0073\u006bi\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0054\u0078\u0062\u0078\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0020\u0025\u0076",_ggcedd .Name );if _aebcbee :=d .Skip ();_aebcbee !=nil {return _aebcbee ;};};case _g .EndElement :break _ddebbc ;case _g .CharData :};};return nil ;};func (_bagbf *Footnotes )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006cn\u0073"},Value :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0061"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u006d"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u006d\u0061"},Value :"\u0068\u0074\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067/\u0073\u0063\u0068\u0065\u006da\u004c\u0069\u0062\u0072\u0061\u0072\u0079\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0070\u0069c"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0072"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068\u0065\u006da\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u006f\u0066\u0066\u0069c\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002fr\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073h\u0069\u0070\u0073"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0073"},Value :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0073\u0063\u0068\u0065m\u0061s\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067/\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002f\u0073\u0068\u0061\u0072e\u0064\u0054\u0079\u0070\u0065\u0073"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0077"},Value :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u0077\u0070"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0078\u006dl"},Value :"\u0068\u0074tp\u003a\u002f\u002fw\u0077\u0077\u002e\u00773.o\u0072g/\u0058\u004d\u004c\u002f\u0031\u0039\u00398/\u006e\u0061\u006d\u0065\u0073\u0070\u0061c\u0065"});start .Name .Local ="w\u003a\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0073";return _bagbf .CT_Footnotes .MarshalXML (e ,start );};func (_bcccd *ST_TextboxTightWrap )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_bcccd =0;case "\u006e\u006f\u006e\u0065":*_bcccd =1;case "\u0061\u006c\u006c\u004c\u0069\u006e\u0065\u0073":*_bcccd =2;case "\u0066\u0069r\u0073\u0074\u0041n\u0064\u004c\u0061\u0073\u0074\u004c\u0069\u006e\u0065":*_bcccd =3;case "\u0066\u0069\u0072\u0073\u0074\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079":*_bcccd =4;case "\u006c\u0061\u0073t\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079":*_bcccd =5;};return nil ;};type CT_Style struct{
+ValAttr ST_Merge ;};func (_fegcd *CT_MathCtrlDel )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_fegcd .AuthorAttr )});if _fegcd .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_fegcd .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_fegcd .IdAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_bfdbfa *TxbxContent )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_bfdbfa .CT_TxbxContent =*NewCT_TxbxContent ();_ddebbc :for {_ccbfb ,_cbbfg :=d .Token ();if _cbbfg !=nil {return _cbbfg ;};switch _ggcedd :=_ccbfb .(type ){case _g .StartElement :switch _ggcedd .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u006c\u0074\u0043\u0068\u0075\u006e\u006b"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u006c\u0074\u0043\u0068\u0075\u006e\u006b"}:_fbafca :=NewCT_AltChunk ();if _gfgb :=d .DecodeElement (_fbafca ,&_ggcedd );_gfgb !=nil {return _gfgb ;};_bfdbfa .AltChunk =append (_bfdbfa .AltChunk ,_fbafca );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006d\u0058\u006dl"}:_bdcada :=NewEG_ContentBlockContent ();_bdcada .CustomXml =NewCT_CustomXmlBlock ();if _afefc :=d .DecodeElement (_bdcada .CustomXml ,&_ggcedd );_afefc !=nil {return _afefc ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bdcada );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0064\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0064\u0074"}:_fgfgb :=NewEG_ContentBlockContent ();_fgfgb .Sdt =NewCT_SdtBlock ();if _bbebg :=d .DecodeElement (_fgfgb .Sdt ,&_ggcedd );_bbebg !=nil {return _bbebg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fgfgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070"}:_ggeab :=NewEG_ContentBlockContent ();_ccegec :=NewCT_P ();if _cfead :=d .DecodeElement (_ccegec ,&_ggcedd );_cfead !=nil {return _cfead ;};_ggeab .P =append (_ggeab .P ,_ccegec );_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ggeab );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0062\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0062\u006c"}:_bfcfga :=NewEG_ContentBlockContent ();_bafde :=NewCT_Tbl ();if _bcgbga :=d .DecodeElement (_bafde ,&_ggcedd );_bcgbga !=nil {return _bcgbga ;};_bfcfga .Tbl =append (_bfcfga .Tbl ,_bafde );_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bfcfga );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0072\u006f\u006f\u0066\u0045\u0072\u0072"}:_gcegcg :=NewEG_ContentBlockContent ();_bdfce :=NewEG_RunLevelElts ();_bdfce .ProofErr =NewCT_ProofErr ();if _degfa :=d .DecodeElement (_bdfce .ProofErr ,&_ggcedd );_degfa !=nil {return _degfa ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gcegcg );_gcegcg .EG_RunLevelElts =append (_gcegcg .EG_RunLevelElts ,_bdfce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0053\u0074\u0061\u0072t"}:_ggfbbd :=NewEG_ContentBlockContent ();_cdfgca :=NewEG_RunLevelElts ();_cdfgca .PermStart =NewCT_PermStart ();if _edebg :=d .DecodeElement (_cdfgca .PermStart ,&_ggcedd );_edebg !=nil {return _edebg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ggfbbd );_ggfbbd .EG_RunLevelElts =append (_ggfbbd .EG_RunLevelElts ,_cdfgca );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070e\u0072\u006d\u0045\u006e\u0064"}:_caecccg :=NewEG_ContentBlockContent ();_dabgf :=NewEG_RunLevelElts ();_dabgf .PermEnd =NewCT_Perm ();if _abaff :=d .DecodeElement (_dabgf .PermEnd ,&_ggcedd );_abaff !=nil {return _abaff ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_caecccg );_caecccg .EG_RunLevelElts =append (_caecccg .EG_RunLevelElts ,_dabgf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069\u006e\u0073"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069\u006e\u0073"}:_fceeg :=NewEG_ContentBlockContent ();_gggbgb :=NewEG_RunLevelElts ();_gggbgb .Ins =NewCT_RunTrackChange ();if _gdfec :=d .DecodeElement (_gggbgb .Ins ,&_ggcedd );_gdfec !=nil {return _gdfec ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fceeg );_fceeg .EG_RunLevelElts =append (_fceeg .EG_RunLevelElts ,_gggbgb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006c"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006c"}:_fddba :=NewEG_ContentBlockContent ();_dcbfe :=NewEG_RunLevelElts ();_dcbfe .Del =NewCT_RunTrackChange ();if _bggcec :=d .DecodeElement (_dcbfe .Del ,&_ggcedd );_bggcec !=nil {return _bggcec ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fddba );_fddba .EG_RunLevelElts =append (_fddba .EG_RunLevelElts ,_dcbfe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0046\u0072\u006f\u006d"}:_decca :=NewEG_ContentBlockContent ();_gedagf :=NewEG_RunLevelElts ();_gedagf .MoveFrom =NewCT_RunTrackChange ();if _decfgd :=d .DecodeElement (_gedagf .MoveFrom ,&_ggcedd );_decfgd !=nil {return _decfgd ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_decca );_decca .EG_RunLevelElts =append (_decca .EG_RunLevelElts ,_gedagf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f"}:_fdgee :=NewEG_ContentBlockContent ();_ddfffb :=NewEG_RunLevelElts ();_ddfffb .MoveTo =NewCT_RunTrackChange ();if _fagbe :=d .DecodeElement (_ddfffb .MoveTo ,&_ggcedd );_fagbe !=nil {return _fagbe ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_fdgee );_fdgee .EG_RunLevelElts =append (_fdgee .EG_RunLevelElts ,_ddfffb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0053\u0074\u0061\u0072\u0074"}:_gccce :=NewEG_ContentBlockContent ();_ceacf :=NewEG_RunLevelElts ();_cacff :=NewEG_RangeMarkupElements ();_cacff .BookmarkStart =NewCT_Bookmark ();if _aecceg :=d .DecodeElement (_cacff .BookmarkStart ,&_ggcedd );_aecceg !=nil {return _aecceg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gccce );_gccce .EG_RunLevelElts =append (_gccce .EG_RunLevelElts ,_ceacf );_ceacf .EG_RangeMarkupElements =append (_ceacf .EG_RangeMarkupElements ,_cacff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"b\u006f\u006f\u006b\u006d\u0061\u0072\u006b\u0045\u006e\u0064"}:_affagf :=NewEG_ContentBlockContent ();_ebfbe :=NewEG_RunLevelElts ();_cgeeg :=NewEG_RangeMarkupElements ();_cgeeg .BookmarkEnd =NewCT_MarkupRange ();if _fbcgcf :=d .DecodeElement (_cgeeg .BookmarkEnd ,&_ggcedd );_fbcgcf !=nil {return _fbcgcf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_affagf );_affagf .EG_RunLevelElts =append (_affagf .EG_RunLevelElts ,_ebfbe );_ebfbe .EG_RangeMarkupElements =append (_ebfbe .EG_RangeMarkupElements ,_cgeeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u0076e\u0046\u0072\u006f\u006d\u0052a\u006e\u0067e\u0053\u0074\u0061\u0072\u0074"}:_deedfeg :=NewEG_ContentBlockContent ();_cgabee :=NewEG_RunLevelElts ();_ffccge :=NewEG_RangeMarkupElements ();_ffccge .MoveFromRangeStart =NewCT_MoveBookmark ();if _ababba :=d .DecodeElement (_ffccge .MoveFromRangeStart ,&_ggcedd );_ababba !=nil {return _ababba ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_deedfeg );_deedfeg .EG_RunLevelElts =append (_deedfeg .EG_RunLevelElts ,_cgabee );_cgabee .EG_RangeMarkupElements =append (_cgabee .EG_RangeMarkupElements ,_ffccge );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0046\u0072o\u006d\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_eefbb :=NewEG_ContentBlockContent ();_eedfb :=NewEG_RunLevelElts ();_efdgcg :=NewEG_RangeMarkupElements ();_efdgcg .MoveFromRangeEnd =NewCT_MarkupRange ();if _dfffeb :=d .DecodeElement (_efdgcg .MoveFromRangeEnd ,&_ggcedd );_dfffeb !=nil {return _dfffeb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_eefbb );_eefbb .EG_RunLevelElts =append (_eefbb .EG_RunLevelElts ,_eedfb );_eedfb .EG_RangeMarkupElements =append (_eedfb .EG_RangeMarkupElements ,_efdgcg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006fv\u0065\u0054\u006fR\u0061\u006e\u0067\u0065\u0053\u0074\u0061\u0072\u0074"}:_debag :=NewEG_ContentBlockContent ();_cagcab :=NewEG_RunLevelElts ();_agfabff :=NewEG_RangeMarkupElements ();_agfabff .MoveToRangeStart =NewCT_MoveBookmark ();if _fgecbb :=d .DecodeElement (_agfabff .MoveToRangeStart ,&_ggcedd );_fgecbb !=nil {return _fgecbb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_debag );_debag .EG_RunLevelElts =append (_debag .EG_RunLevelElts ,_cagcab );_cagcab .EG_RangeMarkupElements =append (_cagcab .EG_RangeMarkupElements ,_agfabff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006eg\u0065\u0045\u006e\u0064"}:_bcadfc :=NewEG_ContentBlockContent ();_cgccg :=NewEG_RunLevelElts ();_cbfffb :=NewEG_RangeMarkupElements ();_cbfffb .MoveToRangeEnd =NewCT_MarkupRange ();if _fdggbf :=d .DecodeElement (_cbfffb .MoveToRangeEnd ,&_ggcedd );_fdggbf !=nil {return _fdggbf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bcadfc );_bcadfc .EG_RunLevelElts =append (_bcadfc .EG_RunLevelElts ,_cgccg );_cgccg .EG_RangeMarkupElements =append (_cgccg .EG_RangeMarkupElements ,_cbfffb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006f\u006d\u006d\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065S\u0074\u0061\u0072\u0074"}:_bedaf :=NewEG_ContentBlockContent ();_ffcad :=NewEG_RunLevelElts ();_egcdcb :=NewEG_RangeMarkupElements ();_egcdcb .CommentRangeStart =NewCT_MarkupRange ();if _dfbfeg :=d .DecodeElement (_egcdcb .CommentRangeStart ,&_ggcedd );_dfbfeg !=nil {return _dfbfeg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_bedaf );_bedaf .EG_RunLevelElts =append (_bedaf .EG_RunLevelElts ,_ffcad );_ffcad .EG_RangeMarkupElements =append (_ffcad .EG_RangeMarkupElements ,_egcdcb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063o\u006dm\u0065\u006e\u0074\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_gbdfga :=NewEG_ContentBlockContent ();_ccagff :=NewEG_RunLevelElts ();_deffe :=NewEG_RangeMarkupElements ();_deffe .CommentRangeEnd =NewCT_MarkupRange ();if _dgagf :=d .DecodeElement (_deffe .CommentRangeEnd ,&_ggcedd );_dgagf !=nil {return _dgagf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gbdfga );_gbdfga .EG_RunLevelElts =append (_gbdfga .EG_RunLevelElts ,_ccagff );_ccagff .EG_RangeMarkupElements =append (_ccagff .EG_RangeMarkupElements ,_deffe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0049\u006e\u0073\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_dbcbc :=NewEG_ContentBlockContent ();_cebdea :=NewEG_RunLevelElts ();_ccfdfc :=NewEG_RangeMarkupElements ();_ccfdfc .CustomXmlInsRangeStart =NewCT_TrackChange ();if _adabba :=d .DecodeElement (_ccfdfc .CustomXmlInsRangeStart ,&_ggcedd );_adabba !=nil {return _adabba ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_dbcbc );_dbcbc .EG_RunLevelElts =append (_dbcbc .EG_RunLevelElts ,_cebdea );_cebdea .EG_RangeMarkupElements =append (_cebdea .EG_RangeMarkupElements ,_ccfdfc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0049\u006es\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_ffgeabf :=NewEG_ContentBlockContent ();_dcggbb :=NewEG_RunLevelElts ();_ggfdg :=NewEG_RangeMarkupElements ();_ggfdg .CustomXmlInsRangeEnd =NewCT_Markup ();if _daeae :=d .DecodeElement (_ggfdg .CustomXmlInsRangeEnd ,&_ggcedd );_daeae !=nil {return _daeae ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ffgeabf );_ffgeabf .EG_RunLevelElts =append (_ffgeabf .EG_RunLevelElts ,_dcggbb );_dcggbb .EG_RangeMarkupElements =append (_dcggbb .EG_RangeMarkupElements ,_ggfdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073\u0074om\u0058\u006d\u006c\u0044\u0065\u006c\u0052\u0061\u006e\u0067\u0065\u0053\u0074\u0061r\u0074"}:_dabadf :=NewEG_ContentBlockContent ();_dbeaaff :=NewEG_RunLevelElts ();_dgebgf :=NewEG_RangeMarkupElements ();_dgebgf .CustomXmlDelRangeStart =NewCT_TrackChange ();if _feedac :=d .DecodeElement (_dgebgf .CustomXmlDelRangeStart ,&_ggcedd );_feedac !=nil {return _feedac ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_dabadf );_dabadf .EG_RunLevelElts =append (_dabadf .EG_RunLevelElts ,_dbeaaff );_dbeaaff .EG_RangeMarkupElements =append (_dbeaaff .EG_RangeMarkupElements ,_dgebgf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075s\u0074\u006f\u006d\u0058\u006d\u006c\u0044\u0065l\u0052\u0061\u006e\u0067eE\u006e\u0064"}:_cbggcc :=NewEG_ContentBlockContent ();_ebabfg :=NewEG_RunLevelElts ();_bfdgef :=NewEG_RangeMarkupElements ();_bfdgef .CustomXmlDelRangeEnd =NewCT_Markup ();if _agbdd :=d .DecodeElement (_bfdgef .CustomXmlDelRangeEnd ,&_ggcedd );_agbdd !=nil {return _agbdd ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_cbggcc );_cbggcc .EG_RunLevelElts =append (_cbggcc .EG_RunLevelElts ,_ebabfg );_ebabfg .EG_RangeMarkupElements =append (_ebabfg .EG_RangeMarkupElements ,_bfdgef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u0075\u0073\u0074\u006f\u006d\u0058m\u006c\u004d\u006f\u0076\u0065\u0046\u0072\u006f\u006dR\u0061\u006e\u0067e\u0053t\u0061\u0072\u0074"}:_effab :=NewEG_ContentBlockContent ();_gefag :=NewEG_RunLevelElts ();_ccgbf :=NewEG_RangeMarkupElements ();_ccgbf .CustomXmlMoveFromRangeStart =NewCT_TrackChange ();if _bgdedg :=d .DecodeElement (_ccgbf .CustomXmlMoveFromRangeStart ,&_ggcedd );_bgdedg !=nil {return _bgdedg ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_effab );_effab .EG_RunLevelElts =append (_effab .EG_RunLevelElts ,_gefag );_gefag .EG_RangeMarkupElements =append (_gefag .EG_RangeMarkupElements ,_ccgbf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0046r\u006fm\u0052\u0061\u006e\u0067\u0065\u0045\u006ed"}:_cbeedf :=NewEG_ContentBlockContent ();_ebfde :=NewEG_RunLevelElts ();_gegeg :=NewEG_RangeMarkupElements ();_gegeg .CustomXmlMoveFromRangeEnd =NewCT_Markup ();if _ffdbf :=d .DecodeElement (_gegeg .CustomXmlMoveFromRangeEnd ,&_ggcedd );_ffdbf !=nil {return _ffdbf ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_cbeedf );_cbeedf .EG_RunLevelElts =append (_cbeedf .EG_RunLevelElts ,_ebfde );_ebfde .EG_RangeMarkupElements =append (_ebfde .EG_RangeMarkupElements ,_gegeg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063u\u0073\u0074\u006f\u006dX\u006d\u006c\u004d\u006f\u0076e\u0054o\u0052a\u006e\u0067\u0065\u0053\u0074\u0061\u0072t"}:_gfcdb :=NewEG_ContentBlockContent ();_bbadc :=NewEG_RunLevelElts ();_bebgba :=NewEG_RangeMarkupElements ();_bebgba .CustomXmlMoveToRangeStart =NewCT_TrackChange ();if _gfcga :=d .DecodeElement (_bebgba .CustomXmlMoveToRangeStart ,&_ggcedd );_gfcga !=nil {return _gfcga ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_gfcdb );_gfcdb .EG_RunLevelElts =append (_gfcdb .EG_RunLevelElts ,_bbadc );_bbadc .EG_RangeMarkupElements =append (_bbadc .EG_RangeMarkupElements ,_bebgba );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0075\u0073to\u006d\u0058\u006d\u006c\u004d\u006f\u0076\u0065\u0054\u006f\u0052\u0061\u006e\u0067\u0065\u0045\u006e\u0064"}:_cffce :=NewEG_ContentBlockContent ();_fcbcf :=NewEG_RunLevelElts ();_deged :=NewEG_RangeMarkupElements ();_deged .CustomXmlMoveToRangeEnd =NewCT_Markup ();if _baddec :=d .DecodeElement (_deged .CustomXmlMoveToRangeEnd ,&_ggcedd );_baddec !=nil {return _baddec ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_cffce );_cffce .EG_RunLevelElts =append (_cffce .EG_RunLevelElts ,_fcbcf );_fcbcf .EG_RangeMarkupElements =append (_fcbcf .EG_RangeMarkupElements ,_deged );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006fM\u0061\u0074\u0068\u0050\u0061\u0072a"}:_ababdg :=NewEG_ContentBlockContent ();_fcgfa :=NewEG_RunLevelElts ();_becba :=NewEG_MathContent ();_becba .OMathPara =_ec .NewOMathPara ();if _fdaffb :=d .DecodeElement (_becba .OMathPara ,&_ggcedd );_fdaffb !=nil {return _fdaffb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_ababdg );_ababdg .EG_RunLevelElts =append (_ababdg .EG_RunLevelElts ,_fcgfa );_fcgfa .EG_MathContent =append (_fcgfa .EG_MathContent ,_becba );case _g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"},_g .Name {Space :"\u0068\u0074t\u0070\u003a\u002f\u002f\u0070\u0075\u0072\u006c\u002e\u006f\u0063\u006c\u0063\u002e\u006f\u0072\u0067\u002f\u006f\u006f\u0078\u006d\u006c\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u006d\u0061\u0074\u0068",Local :"\u006f\u004d\u0061t\u0068"}:_adcdbe :=NewEG_ContentBlockContent ();_fdfde :=NewEG_RunLevelElts ();_ccdba :=NewEG_MathContent ();_ccdba .OMath =_ec .NewOMath ();if _caggbb :=d .DecodeElement (_ccdba .OMath ,&_ggcedd );_caggbb !=nil {return _caggbb ;};_bfdbfa .EG_ContentBlockContent =append (_bfdbfa .EG_ContentBlockContent ,_adcdbe );_adcdbe .EG_RunLevelElts =append (_adcdbe .EG_RunLevelElts ,_fdfde );_fdfde .EG_MathContent =append (_fdfde .EG_MathContent ,_ccdba );default:_ga .Log ("\u0073\u006bi\u0070\u0070\u0069\u006e\u0067\u0020\u0075\u006e\u0073\u0075\u0070\u0070\u006f\u0072\u0074\u0065\u0064\u0020\u0065\u006c\u0065\u006d\u0065\u006e\u0074\u0020\u006f\u006e\u0020\u0054\u0078\u0062\u0078\u0043\u006f\u006e\u0074\u0065\u006e\u0074\u0020\u0025\u0076",_ggcedd .Name );if _aebcbee :=d .Skip ();_aebcbee !=nil {return _aebcbee ;};};case _g .EndElement :break _ddebbc ;case _g .CharData :};};return nil ;};func (_bagbf *Footnotes )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006cn\u0073"},Value :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0061"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065m\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006cf\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067m\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u006d"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006e\u0078m\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002eo\u0072\u0067\u002f\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075m\u0065\u006e\u0074\u002f\u0032\u00300\u0036\u002f\u006da\u0074\u0068"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u006d\u0061"},Value :"\u0068\u0074\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067/\u0073\u0063\u0068\u0065\u006da\u004c\u0069\u0062\u0072\u0061\u0072\u0079\u002f\u0032\u0030\u0030\u0036\u002f\u006d\u0061\u0069\u006e"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0070\u0069c"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068e\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006frg\u002f\u0064\u0072\u0061\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0070\u0069\u0063\u0074\u0075\u0072\u0065"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0072"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f/\u0073\u0063\u0068\u0065\u006da\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072m\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u006f\u0066\u0066\u0069c\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002fr\u0065\u006c\u0061\u0074\u0069\u006f\u006e\u0073h\u0069\u0070\u0073"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0073"},Value :"\u0068\u0074\u0074\u0070\u003a/\u002f\u0073\u0063\u0068\u0065m\u0061s\u002e\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067/\u006f\u0066\u0066\u0069\u0063\u0065\u0044\u006f\u0063\u0075\u006d\u0065\u006e\u0074\u002f\u0032\u0030\u0030\u0036\u002f\u0073\u0068\u0061\u0072e\u0064\u0054\u0079\u0070\u0065\u0073"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0077"},Value :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078\u006d\u006c\u006e\u0073\u003a\u0077\u0070"},Value :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073\u002e\u006f\u0070\u0065\u006ex\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0064\u0072a\u0077\u0069\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u0030\u0036\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065\u0073\u0073\u0069n\u0067\u0044\u0072\u0061\u0077i\u006e\u0067"});start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0078m\u006c\u006e\u0073\u003a\u0078\u006dl"},Value :"\u0068\u0074tp\u003a\u002f\u002fw\u0077\u0077\u002e\u00773.o\u0072g/\u0058\u004d\u004c\u002f\u0031\u0039\u00398/\u006e\u0061\u006d\u0065\u0073\u0070\u0061c\u0065"});start .Name .Local ="w\u003a\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0073";return _bagbf .CT_Footnotes .MarshalXML (e ,start );};func (_bcccd *ST_TextboxTightWrap )UnmarshalXMLAttr (attr _g .Attr )error {switch attr .Value {case "":*_bcccd =0;case "\u006e\u006f\u006e\u0065":*_bcccd =1;case "\u0061\u006c\u006c\u004c\u0069\u006e\u0065\u0073":*_bcccd =2;case "\u0066\u0069r\u0073\u0074\u0041n\u0064\u004c\u0061\u0073\u0074\u004c\u0069\u006e\u0065":*_bcccd =3;case "\u0066\u0069\u0072\u0073\u0074\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079":*_bcccd =4;case "\u006c\u0061\u0073t\u004c\u0069\u006e\u0065\u004f\u006e\u006c\u0079":*_bcccd =5;};return nil ;};type CT_Style struct{
 
 // Style Type
 TypeAttr ST_StyleType ;
@@ -6258,7 +6272,7 @@ AltChunk []*CT_AltChunk ;EG_ContentBlockContent []*EG_ContentBlockContent ;};fun
 func (_gbcdd *CT_FFDDList )ValidateWithPath (path string )error {if _gbcdd .Result !=nil {if _fbab :=_gbcdd .Result .ValidateWithPath (path +"\u002fR\u0065\u0073\u0075\u006c\u0074");_fbab !=nil {return _fbab ;};};if _gbcdd .Default !=nil {if _fcdgc :=_gbcdd .Default .ValidateWithPath (path +"\u002f\u0044\u0065\u0066\u0061\u0075\u006c\u0074");_fcdgc !=nil {return _fcdgc ;};};for _fdba ,_eceeb :=range _gbcdd .ListEntry {if _afbaa :=_eceeb .ValidateWithPath (_gd .Sprintf ("\u0025\u0073/\u004c\u0069\u0073t\u0045\u006e\u0074\u0072\u0079\u005b\u0025\u0064\u005d",path ,_fdba ));_afbaa !=nil {return _afbaa ;};};return nil ;};func (_eegae *CT_Comments )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _eegae .Comment !=nil {_deec :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0063\u006f\u006d\u006d\u0065\u006et"}};for _ ,_cbgf :=range _eegae .Comment {e .EncodeElement (_cbgf ,_deec );};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type WdWpc struct{WdCT_WordprocessingCanvas };func (_faaad *CT_Markup )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for _ ,_geaae :=range start .Attr {if _geaae .Name .Local =="\u0069\u0064"{_gecfag ,_cdgea :=_b .ParseInt (_geaae .Value ,10,64);if _cdgea !=nil {return _cdgea ;};_faaad .IdAttr =_gecfag ;continue ;};};for {_faddg ,_adega :=d .Token ();if _adega !=nil {return _gd .Errorf ("p\u0061\u0072\u0073\u0069ng\u0020C\u0054\u005f\u004d\u0061\u0072k\u0075\u0070\u003a\u0020\u0025\u0073",_adega );};if _fbbfe ,_eabab :=_faddg .(_g .EndElement );_eabab &&_fbbfe .Name ==start .Name {break ;};};return nil ;};
 
 // Validate validates the CT_Lvl and its children
-func (_gcgbe *CT_Lvl )Validate ()error {return _gcgbe .ValidateWithPath ("\u0043\u0054\u005f\u004c\u0076\u006c");};const (ST_PTabLeaderUnset ST_PTabLeader =0;ST_PTabLeaderNone ST_PTabLeader =1;ST_PTabLeaderDot ST_PTabLeader =2;ST_PTabLeaderHyphen ST_PTabLeader =3;ST_PTabLeaderUnderscore ST_PTabLeader =4;ST_PTabLeaderMiddleDot ST_PTabLeader =5;);func (_cgegg *ST_Zoom )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cgafa ,_ccafa :=d .Token ();if _ccafa !=nil {return _ccafa ;};if _cdfbb ,_fcccf :=_cgafa .(_g .EndElement );_fcccf &&_cdfbb .Name ==start .Name {*_cgegg =1;return nil ;};if _abbgbd ,_deded :=_cgafa .(_g .CharData );!_deded {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_cgafa );}else {switch string (_abbgbd ){case "":*_cgegg =0;case "\u006e\u006f\u006e\u0065":*_cgegg =1;case "\u0066\u0075\u006c\u006c\u0050\u0061\u0067\u0065":*_cgegg =2;case "\u0062e\u0073\u0074\u0046\u0069\u0074":*_cgegg =3;case "\u0074e\u0078\u0074\u0046\u0069\u0074":*_cgegg =4;};};_cgafa ,_ccafa =d .Token ();if _ccafa !=nil {return _ccafa ;};if _edcaf ,_fbegfe :=_cgafa .(_g .EndElement );_fbegfe &&_edcaf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_cgafa );};func (_ccgg *CT_Column )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ccgg .WAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0077"},Value :_gd .Sprintf ("\u0025\u0076",*_ccgg .WAttr )});};if _ccgg .SpaceAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0073\u0070\u0061\u0063\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_ccgg .SpaceAttr )});};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type WdCT_GraphicFrame struct{CNvPr *_e .CT_NonVisualDrawingProps ;CNvFrPr *_e .CT_NonVisualGraphicFrameProperties ;Xfrm *_e .CT_Transform2D ;Graphic *_e .Graphic ;ExtLst *_e .CT_OfficeArtExtensionList ;};func (_dadge *CT_TblPrExChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_dadge .AuthorAttr )});if _dadge .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_dadge .DateAttr )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_dadge .IdAttr )});e .EncodeToken (start );_dgeag :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u0050\u0072\u0045x"}};e .EncodeElement (_dadge .TblPrEx ,_dgeag );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_NumRestart ()*CT_NumRestart {_gabgf :=&CT_NumRestart {};_gabgf .ValAttr =ST_RestartNumber (1);return _gabgf ;};func (_fcfdac *CT_String )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076a\u006c"},Value :_gd .Sprintf ("\u0025\u0076",_fcfdac .ValAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewAG_TransitionalPassword ()*AG_TransitionalPassword {_cdf :=&AG_TransitionalPassword {};return _cdf ;};func (_cbaaf *CT_TcPrBase )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _cbaaf .CnfStyle !=nil {_afdeg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u006e\u0066\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_cbaaf .CnfStyle ,_afdeg );};if _cbaaf .TcW !=nil {_gagbc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074c\u0057"}};e .EncodeElement (_cbaaf .TcW ,_gagbc );};if _cbaaf .GridSpan !=nil {_fccgcd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0067\u0072\u0069\u0064\u0053\u0070\u0061\u006e"}};e .EncodeElement (_cbaaf .GridSpan ,_fccgcd );};if _cbaaf .HMerge !=nil {_bgdgge :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0068\u004d\u0065\u0072\u0067\u0065"}};e .EncodeElement (_cbaaf .HMerge ,_bgdgge );};if _cbaaf .VMerge !=nil {_fabaf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0076\u004d\u0065\u0072\u0067\u0065"}};e .EncodeElement (_cbaaf .VMerge ,_fabaf );};if _cbaaf .TcBorders !=nil {_gfgde :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0063\u0042\u006f\u0072\u0064\u0065\u0072\u0073"}};e .EncodeElement (_cbaaf .TcBorders ,_gfgde );};if _cbaaf .Shd !=nil {_ecbag :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_cbaaf .Shd ,_ecbag );};if _cbaaf .NoWrap !=nil {_abgdfb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006e\u006f\u0057\u0072\u0061\u0070"}};e .EncodeElement (_cbaaf .NoWrap ,_abgdfb );};if _cbaaf .TcMar !=nil {_aebbe :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0063\u004d\u0061\u0072"}};e .EncodeElement (_cbaaf .TcMar ,_aebbe );};if _cbaaf .TextDirection !=nil {_beefdd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0044\u0069\u0072\u0065\u0063\u0074\u0069\u006f\u006e"}};e .EncodeElement (_cbaaf .TextDirection ,_beefdd );};if _cbaaf .TcFitText !=nil {_fbageb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0063\u0046\u0069\u0074\u0054\u0065\u0078\u0074"}};e .EncodeElement (_cbaaf .TcFitText ,_fbageb );};if _cbaaf .VAlign !=nil {_gbfcec :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0076\u0041\u006c\u0069\u0067\u006e"}};e .EncodeElement (_cbaaf .VAlign ,_gbfcec );};if _cbaaf .HideMark !=nil {_gaccf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0068\u0069\u0064\u0065\u004d\u0061\u0072\u006b"}};e .EncodeElement (_cbaaf .HideMark ,_gaccf );};if _cbaaf .Headers !=nil {_cbbaa :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0068\u0065\u0061\u0064\u0065\u0072s"}};e .EncodeElement (_cbaaf .Headers ,_cbbaa );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fgcbf ST_FtnPos )String ()string {switch _fgcbf {case 0:return "";case 1:return "\u0070\u0061\u0067\u0065\u0042\u006f\u0074\u0074\u006f\u006d";case 2:return "b\u0065\u006e\u0065\u0061\u0074\u0068\u0054\u0065\u0078\u0074";case 3:return "\u0073e\u0063\u0074\u0045\u006e\u0064";case 4:return "\u0064\u006f\u0063\u0045\u006e\u0064";};return "";};func (_fdccf ST_DocPartType )Validate ()error {return _fdccf .ValidateWithPath ("")};func (_dded *CT_DocVars )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _dded .DocVar !=nil {_cefeb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0064\u006f\u0063\u0056\u0061\u0072"}};for _ ,_ccdga :=range _dded .DocVar {e .EncodeElement (_ccdga ,_cefeb );};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
+func (_gcgbe *CT_Lvl )Validate ()error {return _gcgbe .ValidateWithPath ("\u0043\u0054\u005f\u004c\u0076\u006c");};const (ST_PTabLeaderUnset ST_PTabLeader =0;ST_PTabLeaderNone ST_PTabLeader =1;ST_PTabLeaderDot ST_PTabLeader =2;ST_PTabLeaderHyphen ST_PTabLeader =3;ST_PTabLeaderUnderscore ST_PTabLeader =4;ST_PTabLeaderMiddleDot ST_PTabLeader =5;);func (_cgegg *ST_Zoom )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_cgafa ,_ccafa :=d .Token ();if _ccafa !=nil {return _ccafa ;};if _cdfbb ,_fcccf :=_cgafa .(_g .EndElement );_fcccf &&_cdfbb .Name ==start .Name {*_cgegg =1;return nil ;};if _abbgbd ,_deded :=_cgafa .(_g .CharData );!_deded {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_cgafa );}else {switch string (_abbgbd ){case "":*_cgegg =0;case "\u006e\u006f\u006e\u0065":*_cgegg =1;case "\u0066\u0075\u006c\u006c\u0050\u0061\u0067\u0065":*_cgegg =2;case "\u0062e\u0073\u0074\u0046\u0069\u0074":*_cgegg =3;case "\u0074e\u0078\u0074\u0046\u0069\u0074":*_cgegg =4;};};_cgafa ,_ccafa =d .Token ();if _ccafa !=nil {return _ccafa ;};if _edcaf ,_fbegfe :=_cgafa .(_g .EndElement );_fbegfe &&_edcaf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_cgafa );};func (_ccgg *CT_Column )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {if _ccgg .WAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0077"},Value :_gd .Sprintf ("\u0025\u0076",*_ccgg .WAttr )});};if _ccgg .SpaceAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077:\u0073\u0070\u0061\u0063\u0065"},Value :_gd .Sprintf ("\u0025\u0076",*_ccgg .SpaceAttr )});};e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};type WdCT_GraphicFrame struct{CNvPr *_e .CT_NonVisualDrawingProps ;CNvFrPr *_e .CT_NonVisualGraphicFrameProperties ;Xfrm *_e .CT_Transform2D ;Graphic *_e .Graphic ;ExtLst *_e .CT_OfficeArtExtensionList ;};func (_dadge *CT_TblPrExChange )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0061\u0075\u0074\u0068\u006f\u0072"},Value :_gd .Sprintf ("\u0025\u0076",_dadge .AuthorAttr )});if _dadge .DateAttr !=nil {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0064\u0061\u0074\u0065"},Value :_dadge .DateAttr .Format (_f .RFC3339 )});};start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0069\u0064"},Value :_gd .Sprintf ("\u0025\u0076",_dadge .IdAttr )});e .EncodeToken (start );_dgeag :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0062\u006c\u0050\u0072\u0045x"}};e .EncodeElement (_dadge .TblPrEx ,_dgeag );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewCT_NumRestart ()*CT_NumRestart {_gabgf :=&CT_NumRestart {};_gabgf .ValAttr =ST_RestartNumber (1);return _gabgf ;};func (_fcfdac *CT_String )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {start .Attr =append (start .Attr ,_g .Attr {Name :_g .Name {Local :"\u0077\u003a\u0076a\u006c"},Value :_gd .Sprintf ("\u0025\u0076",_fcfdac .ValAttr )});e .EncodeToken (start );e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func NewAG_TransitionalPassword ()*AG_TransitionalPassword {_cdf :=&AG_TransitionalPassword {};return _cdf ;};func (_cbaaf *CT_TcPrBase )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _cbaaf .CnfStyle !=nil {_afdeg :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0063\u006e\u0066\u0053\u0074\u0079\u006c\u0065"}};e .EncodeElement (_cbaaf .CnfStyle ,_afdeg );};if _cbaaf .TcW !=nil {_gagbc :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0074c\u0057"}};e .EncodeElement (_cbaaf .TcW ,_gagbc );};if _cbaaf .GridSpan !=nil {_fccgcd :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0067\u0072\u0069\u0064\u0053\u0070\u0061\u006e"}};e .EncodeElement (_cbaaf .GridSpan ,_fccgcd );};if _cbaaf .HMerge !=nil {_bgdgge :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0068\u004d\u0065\u0072\u0067\u0065"}};e .EncodeElement (_cbaaf .HMerge ,_bgdgge );};if _cbaaf .VMerge !=nil {_fabaf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0076\u004d\u0065\u0072\u0067\u0065"}};e .EncodeElement (_cbaaf .VMerge ,_fabaf );};if _cbaaf .TcBorders !=nil {_gfgde :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0063\u0042\u006f\u0072\u0064\u0065\u0072\u0073"}};e .EncodeElement (_cbaaf .TcBorders ,_gfgde );};if _cbaaf .Shd !=nil {_ecbag :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0073h\u0064"}};e .EncodeElement (_cbaaf .Shd ,_ecbag );};if _cbaaf .NoWrap !=nil {_abgdfb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u006e\u006f\u0057\u0072\u0061\u0070"}};e .EncodeElement (_cbaaf .NoWrap ,_abgdfb );};if _cbaaf .TcMar !=nil {_aebbe :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074\u0063\u004d\u0061\u0072"}};e .EncodeElement (_cbaaf .TcMar ,_aebbe );};if _cbaaf .TextDirection !=nil {_beefdd :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0074e\u0078\u0074\u0044\u0069\u0072\u0065\u0063\u0074\u0069\u006f\u006e"}};e .EncodeElement (_cbaaf .TextDirection ,_beefdd );};if _cbaaf .TcFitText !=nil {_fbageb :=_g .StartElement {Name :_g .Name {Local :"w\u003a\u0074\u0063\u0046\u0069\u0074\u0054\u0065\u0078\u0074"}};e .EncodeElement (_cbaaf .TcFitText ,_fbageb );};if _cbaaf .VAlign !=nil {_gbfcec :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0076\u0041\u006c\u0069\u0067\u006e"}};e .EncodeElement (_cbaaf .VAlign ,_gbfcec );};if _cbaaf .HideMark !=nil {_gaccf :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0068\u0069\u0064\u0065\u004d\u0061\u0072\u006b"}};e .EncodeElement (_cbaaf .HideMark ,_gaccf );};if _cbaaf .Headers !=nil {_cbbaa :=_g .StartElement {Name :_g .Name {Local :"\u0077:\u0068\u0065\u0061\u0064\u0065\u0072s"}};e .EncodeElement (_cbaaf .Headers ,_cbbaa );};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};func (_fgcbf ST_FtnPos )String ()string {switch _fgcbf {case 0:return "";case 1:return "\u0070\u0061\u0067\u0065\u0042\u006f\u0074\u0074\u006f\u006d";case 2:return "b\u0065\u006e\u0065\u0061\u0074\u0068\u0054\u0065\u0078\u0074";case 3:return "\u0073e\u0063\u0074\u0045\u006e\u0064";case 4:return "\u0064\u006f\u0063\u0045\u006e\u0064";};return "";};func (_fdccf ST_DocPartType )Validate ()error {return _fdccf .ValidateWithPath ("")};func (_dded *CT_DocVars )MarshalXML (e *_g .Encoder ,start _g .StartElement )error {e .EncodeToken (start );if _dded .DocVar !=nil {_cefeb :=_g .StartElement {Name :_g .Name {Local :"\u0077\u003a\u0064\u006f\u0063\u0056\u0061\u0072"}};for _ ,_ccdga :=range _dded .DocVar {e .EncodeElement (_ccdga ,_cefeb );};};e .EncodeToken (_g .EndElement {Name :start .Name });return nil ;};
 
 // ValidateWithPath validates the CT_WebSettings and its children, prefixing error messages with path
 func (_ccbgf *CT_WebSettings )ValidateWithPath (path string )error {if _ccbgf .Frameset !=nil {if _fccdde :=_ccbgf .Frameset .ValidateWithPath (path +"\u002fF\u0072\u0061\u006d\u0065\u0073\u0065t");_fccdde !=nil {return _fccdde ;};};if _ccbgf .Divs !=nil {if _gbcaec :=_ccbgf .Divs .ValidateWithPath (path +"\u002f\u0044\u0069v\u0073");_gbcaec !=nil {return _gbcaec ;};};if _ccbgf .Encoding !=nil {if _cebgc :=_ccbgf .Encoding .ValidateWithPath (path +"\u002fE\u006e\u0063\u006f\u0064\u0069\u006eg");_cebgc !=nil {return _cebgc ;};};if _ccbgf .OptimizeForBrowser !=nil {if _cagbf :=_ccbgf .OptimizeForBrowser .ValidateWithPath (path +"\u002f\u004f\u0070\u0074im\u0069\u007a\u0065\u0046\u006f\u0072\u0042\u0072\u006f\u0077\u0073\u0065\u0072");_cagbf !=nil {return _cagbf ;};};if _ccbgf .RelyOnVML !=nil {if _fbfaf :=_ccbgf .RelyOnVML .ValidateWithPath (path +"\u002f\u0052\u0065\u006c\u0079\u004f\u006e\u0056\u004d\u004c");_fbfaf !=nil {return _fbfaf ;};};if _ccbgf .AllowPNG !=nil {if _cefge :=_ccbgf .AllowPNG .ValidateWithPath (path +"\u002fA\u006c\u006c\u006f\u0077\u0050\u004eG");_cefge !=nil {return _cefge ;};};if _ccbgf .DoNotRelyOnCSS !=nil {if _ccbefb :=_ccbgf .DoNotRelyOnCSS .ValidateWithPath (path +"\u002fD\u006fN\u006f\u0074\u0052\u0065\u006c\u0079\u004f\u006e\u0043\u0053\u0053");_ccbefb !=nil {return _ccbefb ;};};if _ccbgf .DoNotSaveAsSingleFile !=nil {if _fdbed :=_ccbgf .DoNotSaveAsSingleFile .ValidateWithPath (path +"\u002f\u0044\u006f\u004eot\u0053\u0061\u0076\u0065\u0041\u0073\u0053\u0069\u006e\u0067\u006c\u0065\u0046\u0069l\u0065");_fdbed !=nil {return _fdbed ;};};if _ccbgf .DoNotOrganizeInFolder !=nil {if _fagfe :=_ccbgf .DoNotOrganizeInFolder .ValidateWithPath (path +"\u002f\u0044\u006f\u004eot\u004f\u0072\u0067\u0061\u006e\u0069\u007a\u0065\u0049\u006e\u0046\u006f\u006c\u0064e\u0072");_fagfe !=nil {return _fagfe ;};};if _ccbgf .DoNotUseLongFileNames !=nil {if _egdcf :=_ccbgf .DoNotUseLongFileNames .ValidateWithPath (path +"\u002f\u0044\u006f\u004eot\u0055\u0073\u0065\u004c\u006f\u006e\u0067\u0046\u0069\u006c\u0065\u004e\u0061\u006de\u0073");_egdcf !=nil {return _egdcf ;};};if _ccbgf .PixelsPerInch !=nil {if _bcggb :=_ccbgf .PixelsPerInch .ValidateWithPath (path +"\u002f\u0050\u0069\u0078\u0065\u006c\u0073\u0050\u0065r\u0049\u006e\u0063\u0068");_bcggb !=nil {return _bcggb ;};};if _ccbgf .TargetScreenSz !=nil {if _cddgc :=_ccbgf .TargetScreenSz .ValidateWithPath (path +"\u002fT\u0061r\u0067\u0065\u0074\u0053\u0063\u0072\u0065\u0065\u006e\u0053\u007a");_cddgc !=nil {return _cddgc ;};};if _ccbgf .SaveSmartTagsAsXml !=nil {if _aeeed :=_ccbgf .SaveSmartTagsAsXml .ValidateWithPath (path +"\u002f\u0053\u0061\u0076eS\u006d\u0061\u0072\u0074\u0054\u0061\u0067\u0073\u0041\u0073\u0058\u006d\u006c");_aeeed !=nil {return _aeeed ;};};return nil ;};type CT_R struct{
@@ -6273,7 +6287,7 @@ RsidDelAttr *string ;
 RsidRAttr *string ;
 
 // Run Properties
-RPr *CT_RPr ;EG_RunInnerContent []*EG_RunInnerContent ;Extra []_ga .Any ;};func (_aacce *ST_Merge )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_dgbgb ,_aadadf :=d .Token ();if _aadadf !=nil {return _aadadf ;};if _cggcc ,_cacccb :=_dgbgb .(_g .EndElement );_cacccb &&_cggcc .Name ==start .Name {*_aacce =1;return nil ;};if _egada ,_begaaca :=_dgbgb .(_g .CharData );!_begaaca {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_dgbgb );}else {switch string (_egada ){case "":*_aacce =0;case "\u0063\u006f\u006e\u0074\u0069\u006e\u0075\u0065":*_aacce =1;case "\u0072e\u0073\u0074\u0061\u0072\u0074":*_aacce =2;};};_dgbgb ,_aadadf =d .Token ();if _aadadf !=nil {return _aadadf ;};if _afddbf ,_gfeee :=_dgbgb .(_g .EndElement );_gfeee &&_afddbf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_dgbgb );};func (_dcbae ST_TabJc )Validate ()error {return _dcbae .ValidateWithPath ("")};type ST_DocPartType byte ;
+RPr *CT_RPr ;EG_RunInnerContent []*EG_RunInnerContent ;Extra []_ga .Any ;_cfdaeb map[_ga .Any ]*EG_RunInnerContent ;};func (_aacce *ST_Merge )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {_dgbgb ,_aadadf :=d .Token ();if _aadadf !=nil {return _aadadf ;};if _cggcc ,_cacccb :=_dgbgb .(_g .EndElement );_cacccb &&_cggcc .Name ==start .Name {*_aacce =1;return nil ;};if _egada ,_begaaca :=_dgbgb .(_g .CharData );!_begaaca {return _gd .Errorf ("\u0065\u0078\u0070\u0065\u0063\u0074\u0065\u0064\u0020\u0063\u0068a\u0072\u0020\u0064\u0061\u0074\u0061\u002c\u0020\u0067\u006ft\u0020\u0025\u0054",_dgbgb );}else {switch string (_egada ){case "":*_aacce =0;case "\u0063\u006f\u006e\u0074\u0069\u006e\u0075\u0065":*_aacce =1;case "\u0072e\u0073\u0074\u0061\u0072\u0074":*_aacce =2;};};_dgbgb ,_aadadf =d .Token ();if _aadadf !=nil {return _aadadf ;};if _afddbf ,_gfeee :=_dgbgb .(_g .EndElement );_gfeee &&_afddbf .Name ==start .Name {return nil ;};return _gd .Errorf ("\u0065\u0078\u0070\u0065c\u0074\u0065\u0064\u0020\u0065\u006e\u0064\u0020\u0065\u006ce\u006de\u006e\u0074\u002c\u0020\u0067\u006f\u0074 \u0025\u0076",_dgbgb );};func (_dcbae ST_TabJc )Validate ()error {return _dcbae .ValidateWithPath ("")};type ST_DocPartType byte ;
 
 // ValidateWithPath validates the EG_RubyContent and its children, prefixing error messages with path
 func (_ggebg *EG_RubyContent )ValidateWithPath (path string )error {if _ggebg .R !=nil {if _fbgga :=_ggebg .R .ValidateWithPath (path +"\u002f\u0052");_fbgga !=nil {return _fbgga ;};};for _eadeab ,_edbbg :=range _ggebg .EG_RunLevelElts {if _fgagbf :=_edbbg .ValidateWithPath (_gd .Sprintf ("\u0025\u0073\u002f\u0045G_\u0052\u0075\u006e\u004c\u0065\u0076\u0065\u006c\u0045\u006c\u0074\u0073\u005b\u0025d\u005d",path ,_eadeab ));_fgagbf !=nil {return _fgagbf ;};};return nil ;};
@@ -6489,7 +6503,7 @@ SpecVanish *CT_OnOff ;
 OMath *CT_OnOff ;
 
 // Revision Information for Run Properties
-RPrChange *CT_RPrChange ;};func (_egabc *CT_R )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for _ ,_addce :=range start .Attr {if _addce .Name .Local =="\u0072s\u0069\u0064\u0052\u0050\u0072"{_agffe ,_dfbbg :=_addce .Value ,error (nil );if _dfbbg !=nil {return _dfbbg ;};_egabc .RsidRPrAttr =&_agffe ;continue ;};if _addce .Name .Local =="\u0072s\u0069\u0064\u0044\u0065\u006c"{_ecbcf ,_edbgf :=_addce .Value ,error (nil );if _edbgf !=nil {return _edbgf ;};_egabc .RsidDelAttr =&_ecbcf ;continue ;};if _addce .Name .Local =="\u0072\u0073\u0069d\u0052"{_adgb ,_bfbaf :=_addce .Value ,error (nil );if _bfbaf !=nil {return _bfbaf ;};_egabc .RsidRAttr =&_adgb ;continue ;};};_eefdc :for {_deacc ,_gdbbg :=d .Token ();if _gdbbg !=nil {return _gdbbg ;};switch _bddgdf :=_deacc .(type ){case _g .StartElement :switch _bddgdf .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0050\u0072"}:_egabc .RPr =NewCT_RPr ();if _accce :=d .DecodeElement (_egabc .RPr ,&_bddgdf );_accce !=nil {return _accce ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0072"}:_cffge :=NewEG_RunInnerContent ();_cffge .Br =NewCT_Br ();if _cfacdd :=d .DecodeElement (_cffge .Br ,&_bddgdf );_cfacdd !=nil {return _cfacdd ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_cffge );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074"}:_bggfa :=NewEG_RunInnerContent ();_bggfa .T =NewCT_Text ();if _fbbcf :=d .DecodeElement (_bggfa .T ,&_bddgdf );_fbbcf !=nil {return _fbbcf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bggfa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u006f\u006e\u0074\u0065\u006e\u0074\u0050\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u006f\u006e\u0074\u0065\u006e\u0074\u0050\u0061\u0072\u0074"}:_bbcgd :=NewEG_RunInnerContent ();_bbcgd .ContentPart =NewCT_Rel ();if _gffge :=d .DecodeElement (_bbcgd .ContentPart ,&_bddgdf );_gffge !=nil {return _gffge ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bbcgd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064e\u006c\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064e\u006c\u0054\u0065\u0078\u0074"}:_fcada :=NewEG_RunInnerContent ();_fcada .DelText =NewCT_Text ();if _ceaed :=d .DecodeElement (_fcada .DelText ,&_bddgdf );_ceaed !=nil {return _ceaed ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fcada );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069n\u0073\u0074\u0072\u0054\u0065\u0078t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069n\u0073\u0074\u0072\u0054\u0065\u0078t"}:_eggbc :=NewEG_RunInnerContent ();_eggbc .InstrText =NewCT_Text ();if _ddced :=d .DecodeElement (_eggbc .InstrText ,&_bddgdf );_ddced !=nil {return _ddced ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_eggbc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006cI\u006e\u0073\u0074\u0072\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006cI\u006e\u0073\u0074\u0072\u0054\u0065\u0078\u0074"}:_ebaga :=NewEG_RunInnerContent ();_ebaga .DelInstrText =NewCT_Text ();if _fdbgcg :=d .DecodeElement (_ebaga .DelInstrText ,&_bddgdf );_fdbgcg !=nil {return _fdbgcg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_ebaga );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006e\u006f\u0042\u0072\u0065\u0061\u006b\u0048\u0079\u0070\u0068\u0065\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006e\u006f\u0042\u0072\u0065\u0061\u006b\u0048\u0079\u0070\u0068\u0065\u006e"}:_bdbg :=NewEG_RunInnerContent ();_bdbg .NoBreakHyphen =NewCT_Empty ();if _fdefa :=d .DecodeElement (_bdbg .NoBreakHyphen ,&_bddgdf );_fdefa !=nil {return _fdefa ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bdbg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006f\u0066\u0074\u0048\u0079\u0070\u0068\u0065\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006f\u0066\u0074\u0048\u0079\u0070\u0068\u0065\u006e"}:_bdbcd :=NewEG_RunInnerContent ();_bdbcd .SoftHyphen =NewCT_Empty ();if _bcffac :=d .DecodeElement (_bdbcd .SoftHyphen ,&_bddgdf );_bcffac !=nil {return _bcffac ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bdbcd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0061\u0079\u0053\u0068\u006f\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0061\u0079\u0053\u0068\u006f\u0072\u0074"}:_daggff :=NewEG_RunInnerContent ();_daggff .DayShort =NewCT_Empty ();if _fabdb :=d .DecodeElement (_daggff .DayShort ,&_bddgdf );_fabdb !=nil {return _fabdb ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_daggff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u006e\u0074\u0068\u0053\u0068\u006f\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u006e\u0074\u0068\u0053\u0068\u006f\u0072\u0074"}:_daegg :=NewEG_RunInnerContent ();_daegg .MonthShort =NewCT_Empty ();if _cfffd :=d .DecodeElement (_daegg .MonthShort ,&_bddgdf );_cfffd !=nil {return _cfffd ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_daegg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0079e\u0061\u0072\u0053\u0068\u006f\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0079e\u0061\u0072\u0053\u0068\u006f\u0072t"}:_fcafe :=NewEG_RunInnerContent ();_fcafe .YearShort =NewCT_Empty ();if _acgae :=d .DecodeElement (_fcafe .YearShort ,&_bddgdf );_acgae !=nil {return _acgae ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fcafe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064a\u0079\u004c\u006f\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064a\u0079\u004c\u006f\u006e\u0067"}:_fbaee :=NewEG_RunInnerContent ();_fbaee .DayLong =NewCT_Empty ();if _fgaaea :=d .DecodeElement (_fbaee .DayLong ,&_bddgdf );_fgaaea !=nil {return _fgaaea ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fbaee );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u006e\u0074\u0068\u004c\u006f\u006eg"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u006e\u0074\u0068\u004c\u006f\u006eg"}:_gbeda :=NewEG_RunInnerContent ();_gbeda .MonthLong =NewCT_Empty ();if _cdecb :=d .DecodeElement (_gbeda .MonthLong ,&_bddgdf );_cdecb !=nil {return _cdecb ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gbeda );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0079\u0065\u0061\u0072\u004c\u006f\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0079\u0065\u0061\u0072\u004c\u006f\u006e\u0067"}:_faeeef :=NewEG_RunInnerContent ();_faeeef .YearLong =NewCT_Empty ();if _bbdg :=d .DecodeElement (_faeeef .YearLong ,&_bddgdf );_bbdg !=nil {return _bbdg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_faeeef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u006e\u006e\u006f\u0074\u0061\u0074\u0069\u006f\u006e\u0052\u0065\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u006e\u006e\u006f\u0074\u0061\u0074\u0069\u006f\u006e\u0052\u0065\u0066"}:_fadggb :=NewEG_RunInnerContent ();_fadggb .AnnotationRef =NewCT_Empty ();if _eacdf :=d .DecodeElement (_fadggb .AnnotationRef ,&_bddgdf );_eacdf !=nil {return _eacdf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fadggb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"f\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"f\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066"}:_edfega :=NewEG_RunInnerContent ();_edfega .FootnoteRef =NewCT_Empty ();if _bcggc :=d .DecodeElement (_edfega .FootnoteRef ,&_bddgdf );_bcggc !=nil {return _bcggc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_edfega );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006e\u0064\u006e\u006f\u0074\u0065\u0052\u0065\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006e\u0064\u006e\u006f\u0074\u0065\u0052\u0065\u0066"}:_eaffe :=NewEG_RunInnerContent ();_eaffe .EndnoteRef =NewCT_Empty ();if _fcgag :=d .DecodeElement (_eaffe .EndnoteRef ,&_bddgdf );_fcgag !=nil {return _fcgag ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_eaffe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073e\u0070\u0061\u0072\u0061\u0074\u006fr"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073e\u0070\u0061\u0072\u0061\u0074\u006fr"}:_bbcce :=NewEG_RunInnerContent ();_bbcce .Separator =NewCT_Empty ();if _gegd :=d .DecodeElement (_bbcce .Separator ,&_bddgdf );_gegd !=nil {return _gegd ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bbcce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u006f\u006e\u0074\u0069nu\u0061t\u0069\u006f\u006e\u0053\u0065p\u0061\u0072\u0061\u0074\u006f\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u006f\u006e\u0074\u0069nu\u0061t\u0069\u006f\u006e\u0053\u0065p\u0061\u0072\u0061\u0074\u006f\u0072"}:_fdbad :=NewEG_RunInnerContent ();_fdbad .ContinuationSeparator =NewCT_Empty ();if _afbgc :=d .DecodeElement (_fdbad .ContinuationSeparator ,&_bddgdf );_afbgc !=nil {return _afbgc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fdbad );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0079\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0079\u006d"}:_dedcf :=NewEG_RunInnerContent ();_dedcf .Sym =NewCT_Sym ();if _dacggg :=d .DecodeElement (_dedcf .Sym ,&_bddgdf );_dacggg !=nil {return _dacggg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_dedcf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0067\u004eu\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0067\u004eu\u006d"}:_gdcca :=NewEG_RunInnerContent ();_gdcca .PgNum =NewCT_Empty ();if _dcgf :=d .DecodeElement (_gdcca .PgNum ,&_bddgdf );_dcgf !=nil {return _dcgf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gdcca );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0072"}:_ebfee :=NewEG_RunInnerContent ();_ebfee .Cr =NewCT_Empty ();if _fgddg :=d .DecodeElement (_ebfee .Cr ,&_bddgdf );_fgddg !=nil {return _fgddg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_ebfee );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0061\u0062"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0061\u0062"}:_gbaa :=NewEG_RunInnerContent ();_gbaa .Tab =NewCT_Empty ();if _gdga :=d .DecodeElement (_gbaa .Tab ,&_bddgdf );_gdga !=nil {return _gdga ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gbaa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006f\u0062\u006a\u0065\u0063\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006f\u0062\u006a\u0065\u0063\u0074"}:_cgeae :=NewEG_RunInnerContent ();_cgeae .Object =NewCT_Object ();if _ccdge :=d .DecodeElement (_cgeae .Object ,&_bddgdf );_ccdge !=nil {return _ccdge ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_cgeae );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0069\u0063\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0069\u0063\u0074"}:_dbeag :=NewEG_RunInnerContent ();_dbeag .Pict =NewCT_Picture ();if _dacde :=d .DecodeElement (_dbeag .Pict ,&_bddgdf );_dacde !=nil {return _dacde ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_dbeag );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066l\u0064\u0043\u0068\u0061\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066l\u0064\u0043\u0068\u0061\u0072"}:_gafdf :=NewEG_RunInnerContent ();_gafdf .FldChar =NewCT_FldChar ();if _fdecc :=d .DecodeElement (_gafdf .FldChar ,&_bddgdf );_fdecc !=nil {return _fdecc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gafdf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0075\u0062\u0079"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0075\u0062\u0079"}:_ccff :=NewEG_RunInnerContent ();_ccff .Ruby =NewCT_Ruby ();if _dfgaag :=d .DecodeElement (_ccff .Ruby ,&_bddgdf );_dfgaag !=nil {return _dfgaag ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_ccff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066\u0065r\u0065\u006e\u0063\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066\u0065r\u0065\u006e\u0063\u0065"}:_cccfdg :=NewEG_RunInnerContent ();_cccfdg .FootnoteReference =NewCT_FtnEdnRef ();if _agbcbf :=d .DecodeElement (_cccfdg .FootnoteReference ,&_bddgdf );_agbcbf !=nil {return _agbcbf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_cccfdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006ed\u006e\u006f\u0074e\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006ed\u006e\u006f\u0074e\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"}:_adgda :=NewEG_RunInnerContent ();_adgda .EndnoteReference =NewCT_FtnEdnRef ();if _cdefb :=d .DecodeElement (_adgda .EndnoteReference ,&_bddgdf );_cdefb !=nil {return _cdefb ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_adgda );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006fm\u006d\u0065\u006et\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006fm\u006d\u0065\u006et\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"}:_dcdeb :=NewEG_RunInnerContent ();_dcdeb .CommentReference =NewCT_Markup ();if _cegde :=d .DecodeElement (_dcdeb .CommentReference ,&_bddgdf );_cegde !=nil {return _cegde ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_dcdeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064r\u0061\u0077\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064r\u0061\u0077\u0069\u006e\u0067"}:_fdefc :=NewEG_RunInnerContent ();_fdefc .Drawing =NewCT_Drawing ();if _dagde :=d .DecodeElement (_fdefc .Drawing ,&_bddgdf );_dagde !=nil {return _dagde ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fdefc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0074\u0061\u0062"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0074\u0061\u0062"}:_agade :=NewEG_RunInnerContent ();_agade .Ptab =NewCT_PTab ();if _fbcfc :=d .DecodeElement (_agade .Ptab ,&_bddgdf );_fbcfc !=nil {return _fbcfc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_agade );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"l\u0061\u0073\u0074\u0052en\u0064e\u0072\u0065\u0064\u0050\u0061g\u0065\u0042\u0072\u0065\u0061\u006b"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"l\u0061\u0073\u0074\u0052en\u0064e\u0072\u0065\u0064\u0050\u0061g\u0065\u0042\u0072\u0065\u0061\u006b"}:_fecebc :=NewEG_RunInnerContent ();_fecebc .LastRenderedPageBreak =NewCT_Empty ();if _fdebf :=d .DecodeElement (_fecebc .LastRenderedPageBreak ,&_bddgdf );_fdebf !=nil {return _fdebf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fecebc );default:_ecbea :=&_ga .XSDAny {};if _gdgfb :=d .DecodeElement (_ecbea ,&_bddgdf );_gdgfb !=nil {return _gdgfb ;};_egabc .Extra =append (_egabc .Extra ,_ecbea );};case _g .EndElement :break _eefdc ;case _g .CharData :};};return nil ;};func (_acfbd ST_SectionMark )ValidateWithPath (path string )error {switch _acfbd {case 0,1,2,3,4,5:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_acfbd ));};return nil ;};func (_efbgg ST_ObjectUpdateMode )ValidateWithPath (path string )error {switch _efbgg {case 0,1,2:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_efbgg ));};return nil ;};
+RPrChange *CT_RPrChange ;};func (_egabc *CT_R )UnmarshalXML (d *_g .Decoder ,start _g .StartElement )error {for _ ,_addce :=range start .Attr {if _addce .Name .Local =="\u0072s\u0069\u0064\u0052\u0050\u0072"{_agffe ,_dfbbg :=_addce .Value ,error (nil );if _dfbbg !=nil {return _dfbbg ;};_egabc .RsidRPrAttr =&_agffe ;continue ;};if _addce .Name .Local =="\u0072s\u0069\u0064\u0044\u0065\u006c"{_ecbcf ,_edbgf :=_addce .Value ,error (nil );if _edbgf !=nil {return _edbgf ;};_egabc .RsidDelAttr =&_ecbcf ;continue ;};if _addce .Name .Local =="\u0072\u0073\u0069d\u0052"{_adgb ,_bfbaf :=_addce .Value ,error (nil );if _bfbaf !=nil {return _bfbaf ;};_egabc .RsidRAttr =&_adgb ;continue ;};};_eefdc :for {_deacc ,_gdbbg :=d .Token ();if _gdbbg !=nil {return _gdbbg ;};switch _bddgdf :=_deacc .(type ){case _g .StartElement :switch _bddgdf .Name {case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0050\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0050\u0072"}:_egabc .RPr =NewCT_RPr ();if _accce :=d .DecodeElement (_egabc .RPr ,&_bddgdf );_accce !=nil {return _accce ;};case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0062\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0062\u0072"}:_cffge :=NewEG_RunInnerContent ();_cffge .Br =NewCT_Br ();if _cfacdd :=d .DecodeElement (_cffge .Br ,&_bddgdf );_cfacdd !=nil {return _cfacdd ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_cffge );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074"}:_bggfa :=NewEG_RunInnerContent ();_bggfa .T =NewCT_Text ();if _fbbcf :=d .DecodeElement (_bggfa .T ,&_bddgdf );_fbbcf !=nil {return _fbbcf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bggfa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u006f\u006e\u0074\u0065\u006e\u0074\u0050\u0061\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u006f\u006e\u0074\u0065\u006e\u0074\u0050\u0061\u0072\u0074"}:_bbcgd :=NewEG_RunInnerContent ();_bbcgd .ContentPart =NewCT_Rel ();if _gffge :=d .DecodeElement (_bbcgd .ContentPart ,&_bddgdf );_gffge !=nil {return _gffge ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bbcgd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064e\u006c\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064e\u006c\u0054\u0065\u0078\u0074"}:_fcada :=NewEG_RunInnerContent ();_fcada .DelText =NewCT_Text ();if _ceaed :=d .DecodeElement (_fcada .DelText ,&_bddgdf );_ceaed !=nil {return _ceaed ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fcada );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0069n\u0073\u0074\u0072\u0054\u0065\u0078t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0069n\u0073\u0074\u0072\u0054\u0065\u0078t"}:_eggbc :=NewEG_RunInnerContent ();_eggbc .InstrText =NewCT_Text ();if _ddced :=d .DecodeElement (_eggbc .InstrText ,&_bddgdf );_ddced !=nil {return _ddced ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_eggbc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0065\u006cI\u006e\u0073\u0074\u0072\u0054\u0065\u0078\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0065\u006cI\u006e\u0073\u0074\u0072\u0054\u0065\u0078\u0074"}:_ebaga :=NewEG_RunInnerContent ();_ebaga .DelInstrText =NewCT_Text ();if _fdbgcg :=d .DecodeElement (_ebaga .DelInstrText ,&_bddgdf );_fdbgcg !=nil {return _fdbgcg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_ebaga );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006e\u006f\u0042\u0072\u0065\u0061\u006b\u0048\u0079\u0070\u0068\u0065\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006e\u006f\u0042\u0072\u0065\u0061\u006b\u0048\u0079\u0070\u0068\u0065\u006e"}:_bdbg :=NewEG_RunInnerContent ();_bdbg .NoBreakHyphen =NewCT_Empty ();if _fdefa :=d .DecodeElement (_bdbg .NoBreakHyphen ,&_bddgdf );_fdefa !=nil {return _fdefa ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bdbg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u006f\u0066\u0074\u0048\u0079\u0070\u0068\u0065\u006e"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u006f\u0066\u0074\u0048\u0079\u0070\u0068\u0065\u006e"}:_bdbcd :=NewEG_RunInnerContent ();_bdbcd .SoftHyphen =NewCT_Empty ();if _bcffac :=d .DecodeElement (_bdbcd .SoftHyphen ,&_bddgdf );_bcffac !=nil {return _bcffac ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bdbcd );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064\u0061\u0079\u0053\u0068\u006f\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064\u0061\u0079\u0053\u0068\u006f\u0072\u0074"}:_daggff :=NewEG_RunInnerContent ();_daggff .DayShort =NewCT_Empty ();if _fabdb :=d .DecodeElement (_daggff .DayShort ,&_bddgdf );_fabdb !=nil {return _fabdb ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_daggff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006d\u006f\u006e\u0074\u0068\u0053\u0068\u006f\u0072\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006d\u006f\u006e\u0074\u0068\u0053\u0068\u006f\u0072\u0074"}:_daegg :=NewEG_RunInnerContent ();_daegg .MonthShort =NewCT_Empty ();if _cfffd :=d .DecodeElement (_daegg .MonthShort ,&_bddgdf );_cfffd !=nil {return _cfffd ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_daegg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0079e\u0061\u0072\u0053\u0068\u006f\u0072t"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0079e\u0061\u0072\u0053\u0068\u006f\u0072t"}:_fcafe :=NewEG_RunInnerContent ();_fcafe .YearShort =NewCT_Empty ();if _acgae :=d .DecodeElement (_fcafe .YearShort ,&_bddgdf );_acgae !=nil {return _acgae ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fcafe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064a\u0079\u004c\u006f\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064a\u0079\u004c\u006f\u006e\u0067"}:_fbaee :=NewEG_RunInnerContent ();_fbaee .DayLong =NewCT_Empty ();if _fgaaea :=d .DecodeElement (_fbaee .DayLong ,&_bddgdf );_fgaaea !=nil {return _fgaaea ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fbaee );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006do\u006e\u0074\u0068\u004c\u006f\u006eg"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006do\u006e\u0074\u0068\u004c\u006f\u006eg"}:_gbeda :=NewEG_RunInnerContent ();_gbeda .MonthLong =NewCT_Empty ();if _cdecb :=d .DecodeElement (_gbeda .MonthLong ,&_bddgdf );_cdecb !=nil {return _cdecb ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gbeda );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0079\u0065\u0061\u0072\u004c\u006f\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0079\u0065\u0061\u0072\u004c\u006f\u006e\u0067"}:_faeeef :=NewEG_RunInnerContent ();_faeeef .YearLong =NewCT_Empty ();if _bbdg :=d .DecodeElement (_faeeef .YearLong ,&_bddgdf );_bbdg !=nil {return _bbdg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_faeeef );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0061\u006e\u006e\u006f\u0074\u0061\u0074\u0069\u006f\u006e\u0052\u0065\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0061\u006e\u006e\u006f\u0074\u0061\u0074\u0069\u006f\u006e\u0052\u0065\u0066"}:_fadggb :=NewEG_RunInnerContent ();_fadggb .AnnotationRef =NewCT_Empty ();if _eacdf :=d .DecodeElement (_fadggb .AnnotationRef ,&_bddgdf );_eacdf !=nil {return _eacdf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fadggb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"f\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"f\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066"}:_edfega :=NewEG_RunInnerContent ();_edfega .FootnoteRef =NewCT_Empty ();if _bcggc :=d .DecodeElement (_edfega .FootnoteRef ,&_bddgdf );_bcggc !=nil {return _bcggc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_edfega );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006e\u0064\u006e\u006f\u0074\u0065\u0052\u0065\u0066"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006e\u0064\u006e\u006f\u0074\u0065\u0052\u0065\u0066"}:_eaffe :=NewEG_RunInnerContent ();_eaffe .EndnoteRef =NewCT_Empty ();if _fcgag :=d .DecodeElement (_eaffe .EndnoteRef ,&_bddgdf );_fcgag !=nil {return _fcgag ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_eaffe );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073e\u0070\u0061\u0072\u0061\u0074\u006fr"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073e\u0070\u0061\u0072\u0061\u0074\u006fr"}:_bbcce :=NewEG_RunInnerContent ();_bbcce .Separator =NewCT_Empty ();if _gegd :=d .DecodeElement (_bbcce .Separator ,&_bddgdf );_gegd !=nil {return _gegd ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_bbcce );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"c\u006f\u006e\u0074\u0069nu\u0061t\u0069\u006f\u006e\u0053\u0065p\u0061\u0072\u0061\u0074\u006f\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"c\u006f\u006e\u0074\u0069nu\u0061t\u0069\u006f\u006e\u0053\u0065p\u0061\u0072\u0061\u0074\u006f\u0072"}:_fdbad :=NewEG_RunInnerContent ();_fdbad .ContinuationSeparator =NewCT_Empty ();if _afbgc :=d .DecodeElement (_fdbad .ContinuationSeparator ,&_bddgdf );_afbgc !=nil {return _afbgc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fdbad );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0073\u0079\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0073\u0079\u006d"}:_dedcf :=NewEG_RunInnerContent ();_dedcf .Sym =NewCT_Sym ();if _dacggg :=d .DecodeElement (_dedcf .Sym ,&_bddgdf );_dacggg !=nil {return _dacggg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_dedcf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0067\u004eu\u006d"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0067\u004eu\u006d"}:_gdcca :=NewEG_RunInnerContent ();_gdcca .PgNum =NewCT_Empty ();if _dcgf :=d .DecodeElement (_gdcca .PgNum ,&_bddgdf );_dcgf !=nil {return _dcgf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gdcca );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u0072"}:_ebfee :=NewEG_RunInnerContent ();_ebfee .Cr =NewCT_Empty ();if _fgddg :=d .DecodeElement (_ebfee .Cr ,&_bddgdf );_fgddg !=nil {return _fgddg ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_ebfee );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0074\u0061\u0062"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0074\u0061\u0062"}:_gbaa :=NewEG_RunInnerContent ();_gbaa .Tab =NewCT_Empty ();if _gdga :=d .DecodeElement (_gbaa .Tab ,&_bddgdf );_gdga !=nil {return _gdga ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gbaa );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u006f\u0062\u006a\u0065\u0063\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u006f\u0062\u006a\u0065\u0063\u0074"}:_cgeae :=NewEG_RunInnerContent ();_cgeae .Object =NewCT_Object ();if _ccdge :=d .DecodeElement (_cgeae .Object ,&_bddgdf );_ccdge !=nil {return _ccdge ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_cgeae );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0069\u0063\u0074"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0069\u0063\u0074"}:_dbeag :=NewEG_RunInnerContent ();_dbeag .Pict =NewCT_Picture ();if _dacde :=d .DecodeElement (_dbeag .Pict ,&_bddgdf );_dacde !=nil {return _dacde ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_dbeag );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066l\u0064\u0043\u0068\u0061\u0072"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066l\u0064\u0043\u0068\u0061\u0072"}:_gafdf :=NewEG_RunInnerContent ();_gafdf .FldChar =NewCT_FldChar ();if _fdecc :=d .DecodeElement (_gafdf .FldChar ,&_bddgdf );_fdecc !=nil {return _fdecc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_gafdf );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0072\u0075\u0062\u0079"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0072\u0075\u0062\u0079"}:_ccff :=NewEG_RunInnerContent ();_ccff .Ruby =NewCT_Ruby ();if _dfgaag :=d .DecodeElement (_ccff .Ruby ,&_bddgdf );_dfgaag !=nil {return _dfgaag ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_ccff );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066\u0065r\u0065\u006e\u0063\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0066\u006f\u006f\u0074\u006e\u006f\u0074\u0065\u0052\u0065\u0066\u0065r\u0065\u006e\u0063\u0065"}:_cccfdg :=NewEG_RunInnerContent ();_cccfdg .FootnoteReference =NewCT_FtnEdnRef ();if _agbcbf :=d .DecodeElement (_cccfdg .FootnoteReference ,&_bddgdf );_agbcbf !=nil {return _agbcbf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_cccfdg );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0065\u006ed\u006e\u006f\u0074e\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0065\u006ed\u006e\u006f\u0074e\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"}:_adgda :=NewEG_RunInnerContent ();_adgda .EndnoteReference =NewCT_FtnEdnRef ();if _cdefb :=d .DecodeElement (_adgda .EndnoteReference ,&_bddgdf );_cdefb !=nil {return _cdefb ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_adgda );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0063\u006fm\u006d\u0065\u006et\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0063\u006fm\u006d\u0065\u006et\u0052\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065"}:_dcdeb :=NewEG_RunInnerContent ();_dcdeb .CommentReference =NewCT_Markup ();if _cegde :=d .DecodeElement (_dcdeb .CommentReference ,&_bddgdf );_cegde !=nil {return _cegde ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_dcdeb );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0064r\u0061\u0077\u0069\u006e\u0067"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0064r\u0061\u0077\u0069\u006e\u0067"}:_fdefc :=NewEG_RunInnerContent ();_fdefc .Drawing =NewCT_Drawing ();if _dagde :=d .DecodeElement (_fdefc .Drawing ,&_bddgdf );_dagde !=nil {return _dagde ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fdefc );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"\u0070\u0074\u0061\u0062"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"\u0070\u0074\u0061\u0062"}:_agade :=NewEG_RunInnerContent ();_agade .Ptab =NewCT_PTab ();if _fbcfc :=d .DecodeElement (_agade .Ptab ,&_bddgdf );_fbcfc !=nil {return _fbcfc ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_agade );case _g .Name {Space :"ht\u0074\u0070:\u002f\u002f\u0073\u0063\u0068\u0065\u006d\u0061\u0073.\u006f\u0070\u0065\u006e\u0078\u006d\u006c\u0066\u006f\u0072\u006d\u0061\u0074\u0073\u002e\u006f\u0072\u0067\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073i\u006e\u0067\u006d\u006c\u002f\u0032\u0030\u00306\u002fm\u0061\u0069n",Local :"l\u0061\u0073\u0074\u0052en\u0064e\u0072\u0065\u0064\u0050\u0061g\u0065\u0042\u0072\u0065\u0061\u006b"},_g .Name {Space :"\u0068\u0074\u0074\u0070\u003a\u002f\u002f\u0070u\u0072\u006c\u002eoc\u006c\u0063\u002e\u006f\u0072\u0067/\u006f\u006f\u0078\u006d\u006c\u002f\u0077\u006f\u0072\u0064\u0070\u0072\u006f\u0063\u0065s\u0073\u0069\u006e\u0067\u006d\u006c\u002f\u006da\u0069\u006e",Local :"l\u0061\u0073\u0074\u0052en\u0064e\u0072\u0065\u0064\u0050\u0061g\u0065\u0042\u0072\u0065\u0061\u006b"}:_fecebc :=NewEG_RunInnerContent ();_fecebc .LastRenderedPageBreak =NewCT_Empty ();if _fdebf :=d .DecodeElement (_fecebc .LastRenderedPageBreak ,&_bddgdf );_fdebf !=nil {return _fdebf ;};_egabc .EG_RunInnerContent =append (_egabc .EG_RunInnerContent ,_fecebc );default:_ecbea :=&_ga .XSDAny {};if _gdgfb :=d .DecodeElement (_ecbea ,&_bddgdf );_gdgfb !=nil {return _gdgfb ;};_egabc .Extra =append (_egabc .Extra ,_ecbea );var _dbfcea *EG_RunInnerContent ;if _egfcad :=len (_egabc .EG_RunInnerContent );_egfcad > 0{_dbfcea =_egabc .EG_RunInnerContent [_egfcad -1];};if _egabc ._cfdaeb ==nil {_egabc ._cfdaeb =map[_ga .Any ]*EG_RunInnerContent {};};_egabc ._cfdaeb [_ecbea ]=_dbfcea ;};case _g .EndElement :break _eefdc ;case _g .CharData :};};return nil ;};func (_acfbd ST_SectionMark )ValidateWithPath (path string )error {switch _acfbd {case 0,1,2,3,4,5:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_acfbd ));};return nil ;};func (_efbgg ST_ObjectUpdateMode )ValidateWithPath (path string )error {switch _efbgg {case 0,1,2:default:return _gd .Errorf ("\u0025s\u003a\u0020\u006f\u0075t\u0020\u006f\u0066\u0020\u0072a\u006eg\u0065 \u0076\u0061\u006c\u0075\u0065\u0020\u0025d",path ,int (_efbgg ));};return nil ;};
 
 // ValidateWithPath validates the CT_P and its children, prefixing error messages with path
 func (_bdgbc *CT_P )ValidateWithPath (path string )error {if _bdgbc .PPr !=nil {if _eebef :=_bdgbc .PPr .ValidateWithPath (path +"\u002f\u0050\u0050\u0072");_eebef !=nil {return _eebef ;};};for _agafe ,_bcfff :=range _bdgbc .EG_PContent {if _ffaadf :=_bcfff .ValidateWithPath (_gd .Sprintf ("\u0025s\u002fE\u0047\u005f\u0050\u0043\u006fn\u0074\u0065n\u0074\u005b\u0025\u0064\u005d",path ,_agafe ));_ffaadf !=nil {return _ffaadf ;};};return nil ;};type WdAnchor struct{WdCT_Anchor };
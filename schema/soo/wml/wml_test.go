@@ -0,0 +1,148 @@
+package wml_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unidoc/unioffice/schema/soo/wml"
+)
+
+const wNS = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+func decodeRun(t *testing.T, s string) *wml.CT_R {
+	doc := `<root xmlns:w="` + wNS + `" xmlns:x="urn:example">` + s + `</root>`
+	dec := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			t.Fatalf("no run found: %s", err)
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "r" {
+			r := wml.NewCT_R()
+			if err := dec.DecodeElement(r, &se); err != nil {
+				t.Fatalf("error decoding run: %s", err)
+			}
+			return r
+		}
+	}
+}
+
+func encodeRun(t *testing.T, r *wml.CT_R) string {
+	buf := bytes.Buffer{}
+	enc := xml.NewEncoder(&buf)
+	if err := enc.EncodeElement(r, xml.StartElement{Name: xml.Name{Local: "w:r"}}); err != nil {
+		t.Fatalf("error encoding run: %s", err)
+	}
+	enc.Flush()
+	return buf.String()
+}
+
+func TestCT_RUnknownContentRoundTrip(t *testing.T) {
+	r := decodeRun(t, `<w:r><w:t>a</w:t><x:unknown x:val="1"/><w:t>b</w:t><w:tab/></w:r>`)
+	if len(r.EG_RunInnerContent) != 3 || len(r.Extra) != 1 {
+		t.Fatalf("expected 3 known and 1 unknown elements, got %d and %d",
+			len(r.EG_RunInnerContent), len(r.Extra))
+	}
+
+	for i := 0; i < 2; i++ {
+		out := encodeRun(t, r)
+		a := strings.Index(out, ">a<")
+		unknown := strings.Index(out, "unknown")
+		b := strings.Index(out, ">b<")
+		if a < 0 || unknown < 0 || b < 0 || !(a < unknown && unknown < b) {
+			t.Fatalf("expected unknown content between the text elements, got %s", out)
+		}
+		r = decodeRun(t, out)
+		if len(r.EG_RunInnerContent) != 3 || len(r.Extra) != 1 {
+			t.Fatalf("round trip %d lost content: %s", i, out)
+		}
+	}
+}
+
+func TestCT_RPrW14RoundTrip(t *testing.T) {
+	const w14NS = "http://schemas.microsoft.com/office/word/2010/wordml"
+	in := `<w:r xmlns:w14="` + w14NS + `"><w:rPr><w:b/><w14:glow w14:rad="63500"><w14:srgbClr w14:val="FF0000"/></w14:glow>` +
+		`<x:unknown/></w:rPr><w:t>a</w:t></w:r>`
+	r := decodeRun(t, in)
+	if r.RPr == nil || r.RPr.B == nil || len(r.RPr.Extra) != 1 {
+		t.Fatalf("expected bold and a single w14 element to be decoded")
+	}
+
+	out := encodeRun(t, r)
+	if !strings.Contains(out, "glow") || !strings.Contains(out, `rad="63500"`) || strings.Contains(out, "unknown") {
+		t.Fatalf("expected only the w14 element to be written, got %s", out)
+	}
+	r = decodeRun(t, out)
+	if r.RPr == nil || r.RPr.B == nil || len(r.RPr.Extra) != 1 {
+		t.Errorf("round trip lost run properties: %s", out)
+	}
+}
+
+func TestTrackChangeDates(t *testing.T) {
+	for date, exp := range map[string]time.Time{
+		"2020-01-02T03:04:05Z": time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		"2020-01-02T03:04Z":    time.Date(2020, 1, 2, 3, 4, 0, 0, time.UTC),
+		"2020-01-02":           time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		"yesterday":            {},
+	} {
+		tc := wml.NewCT_TrackChange()
+		doc := `<w:ins xmlns:w="` + wNS + `" w:id="1" w:author="a" w:date="` + date + `"/>`
+		if err := xml.Unmarshal([]byte(doc), tc); err != nil {
+			t.Errorf("error reading date %q: %s", date, err)
+			continue
+		}
+		if tc.DateAttr == nil || !tc.DateAttr.Equal(exp) {
+			t.Errorf("expected %q to be read as %s, got %v", date, exp, tc.DateAttr)
+		}
+	}
+}
+
+func TestCT_RPrW14Order(t *testing.T) {
+	const w14NS = "http://schemas.microsoft.com/office/word/2010/wordml"
+	in := `<w:r xmlns:w14="` + w14NS + `"><w:rPr><w14:glow w14:rad="63500"><w14:srgbClr w14:val="FF0000"/></w14:glow>` +
+		`<w:rPrChange w:id="1" w:author="a"><w:rPr/></w:rPrChange><w:lang w:val="en-US"/><w:b/></w:rPr><w:t>a</w:t></w:r>`
+	out := encodeRun(t, decodeRun(t, in))
+	b := strings.Index(out, "<w:b>")
+	lang := strings.Index(out, "<w:lang ")
+	glow := strings.Index(out, "glow")
+	change := strings.Index(out, "<w:rPrChange ")
+	if b < 0 || lang < 0 || glow < 0 || change < 0 || !(b < lang && lang < glow && glow < change) {
+		t.Errorf("expected the w14 element between the base properties and the revision, got %s", out)
+	}
+}
+
+func TestParseUnderline(t *testing.T) {
+	for v := wml.ST_UnderlineSingle; v.String() != ""; v++ {
+		got, err := wml.ParseUnderline(v.String())
+		if err != nil || got != v {
+			t.Errorf("expected %q to parse as %d, got %d (%v)", v.String(), v, got, err)
+		}
+	}
+	if got, err := wml.ParseUnderline("double"); err != nil || got != wml.ST_UnderlineDouble {
+		t.Errorf("expected double, got %s (%v)", got, err)
+	}
+	if got, err := wml.ParseUnderline(""); err != nil || got != wml.ST_UnderlineUnset {
+		t.Errorf("expected an empty string to be unset, got %s (%v)", got, err)
+	}
+	if _, err := wml.ParseUnderline("squiggly"); err == nil {
+		t.Errorf("expected an error for an unknown underline")
+	}
+}
+
+func TestParseHighlightColor(t *testing.T) {
+	for v := wml.ST_HighlightColorBlack; v.String() != ""; v++ {
+		got, err := wml.ParseHighlightColor(v.String())
+		if err != nil || got != v {
+			t.Errorf("expected %q to parse as %d, got %d (%v)", v.String(), v, got, err)
+		}
+	}
+	if got, err := wml.ParseHighlightColor("yellow"); err != nil || got != wml.ST_HighlightColorYellow {
+		t.Errorf("expected yellow, got %s (%v)", got, err)
+	}
+	if _, err := wml.ParseHighlightColor("ultraviolet"); err == nil {
+		t.Errorf("expected an error for an unknown highlight color")
+	}
+}
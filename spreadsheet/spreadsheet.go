@@ -745,7 +745,7 @@ func (_cfbd Sheet )Extents ()string {_beaf ,_ggc ,_ccggg ,_dfff :=_cfbd .Extents
 func (_deae *Sheet )Row (rowNum uint32 )Row {for _ ,_badc :=range _deae ._edeb .SheetData .Row {if _badc .RAttr !=nil &&*_badc .RAttr ==rowNum {return Row {_deae ._ebee ,_deae ,_badc };};};return _deae .AddNumberedRow (rowNum );};func _adf (_gad _ag .Time )_ag .Time {_gad =_gad .UTC ();return _ag .Date (_gad .Year (),_gad .Month (),_gad .Day (),_gad .Hour (),_gad .Minute (),_gad .Second (),_gad .Nanosecond (),_ag .Local );};
 
 // SetVerticalAlignment sets the vertical alignment of a cell style.
-func (_dffe CellStyle )SetVerticalAlignment (a _ba .ST_VerticalAlignment ){if _dffe ._daa .Alignment ==nil {_dffe ._daa .Alignment =_ba .NewCT_CellAlignment ();};_dffe ._daa .ApplyAlignmentAttr =_g .Bool (true );_dffe ._daa .Alignment .VerticalAttr =a ;};func (_bfbe Sheet )validateMergedCells ()error {_fcgc :=map[uint64 ]struct{}{};for _ ,_afca :=range _bfbe .MergedCells (){_dgff ,_fac ,_gacd :=_dg .ParseRangeReference (_afca .Reference ());if _gacd !=nil {return _ade .Errorf ("\u0073\u0068e\u0065\u0074\u0020\u006e\u0061m\u0065\u0020\u0027\u0025\u0073'\u0020\u0068\u0061\u0073\u0020\u0069\u006e\u0076\u0061\u006c\u0069\u0064\u0020\u006d\u0065\u0072\u0067\u0065\u0064\u0020\u0063\u0065\u006c\u006c\u0020\u0072\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065\u0020\u0025\u0073",_bfbe .Name (),_afca .Reference ());};for _acbe :=_dgff .RowIdx ;_acbe <=_fac .RowIdx ;_acbe ++{for _cfb :=_dgff .ColumnIdx ;_cfb <=_fac .ColumnIdx ;_cfb ++{_fcbb :=uint64 (_acbe )<<32|uint64 (_cfb );if _ ,_ecea :=_fcgc [_fcbb ];_ecea {return _ade .Errorf ("\u0073\u0068\u0065\u0065\u0074\u0020n\u0061\u006d\u0065\u0020\u0027\u0025\u0073\u0027\u0020\u0068\u0061\u0073\u0020\u006f\u0076\u0065\u0072\u006c\u0061\u0070p\u0069\u006e\u0067\u0020\u006d\u0065\u0072\u0067\u0065\u0064\u0020\u0063\u0065\u006cl\u0020r\u0061\u006e\u0067\u0065",_bfbe .Name ());};_fcgc [_fcbb ]=struct{}{};};};};return nil ;};func (_fa Cell )getFormat ()string {if _fa ._eeg .SAttr ==nil {return "\u0047e\u006e\u0065\u0072\u0061\u006c";};_gbe :=*_fa ._eeg .SAttr ;_gec :=_fa ._agg .StyleSheet .GetCellStyle (_gbe );_geg :=_fa ._agg .StyleSheet .GetNumberFormat (_gec .NumberFormat ());return _geg .GetFormat ();};func (_bcbb Font )SetColor (c _gb .Color ){_edb :=_ba .NewCT_Color ();_aafe :="\u0066\u0066"+*c .AsRGBString ();_edb .RgbAttr =&_aafe ;_bcbb ._gcc .Color =[]*_ba .CT_Color {_edb };};
+func (_dffe CellStyle )SetVerticalAlignment (a _ba .ST_VerticalAlignment ){if _dffe ._daa .Alignment ==nil {_dffe ._daa .Alignment =_ba .NewCT_CellAlignment ();};_dffe ._daa .ApplyAlignmentAttr =_g .Bool (true );_dffe ._daa .Alignment .VerticalAttr =a ;};func (_bfbe Sheet )validateMergedCells ()error {_fcgc :=map[uint64 ]struct{}{};for _ ,_afca :=range _bfbe .MergedCells (){_dgff ,_fac ,_gacd :=_dg .ParseRangeReference (_afca .Reference ());if _gacd !=nil {return _ade .Errorf ("\u0073\u0068e\u0065\u0074\u0020\u006e\u0061m\u0065\u0020\u0027\u0025\u0073'\u0020\u0068\u0061\u0073\u0020\u0069\u006e\u0076\u0061\u006c\u0069\u0064\u0020\u006d\u0065\u0072\u0067\u0065\u0064\u0020\u0063\u0065\u006c\u006c\u0020\u0072\u0065\u0066\u0065\u0072\u0065\u006e\u0063\u0065\u0020\u0025\u0073",_bfbe .Name (),_afca .Reference ());};for _acbe :=_dgff .RowIdx ;_acbe <=_fac .RowIdx ;_acbe ++{for _cfb :=_dgff .ColumnIdx ;_cfb <=_fac .ColumnIdx ;_cfb ++{_fcbb :=uint64 (_acbe )<<32|uint64 (_cfb );if _ ,_ecea :=_fcgc [_fcbb ];_ecea {return _ade .Errorf ("\u0073\u0068\u0065\u0065\u0074\u0020n\u0061\u006d\u0065\u0020\u0027\u0025\u0073\u0027\u0020\u0068\u0061\u0073\u0020\u006f\u0076\u0065\u0072\u006c\u0061\u0070p\u0069\u006e\u0067\u0020\u006d\u0065\u0072\u0067\u0065\u0064\u0020\u0063\u0065\u006cl\u0020r\u0061\u006e\u0067\u0065",_bfbe .Name ());};_fcgc [_fcbb ]=struct{}{};};};};return nil ;};func (_fa Cell )getFormat ()string {if _fa ._eeg .SAttr ==nil {return "\u0047e\u006e\u0065\u0072\u0061\u006c";};_gbe :=*_fa ._eeg .SAttr ;_gec :=_fa ._agg .StyleSheet .GetCellStyle (_gbe );_geg :=_fa ._agg .StyleSheet .GetNumberFormat (_gec .NumberFormat ());return _geg .GetFormat ();};func (_bcbb Font )SetColor (c _gb .Color ){_edb :=_ba .NewCT_Color ();_aafe :="\u0046\u0046"+*c .AsRGBString ();_edb .RgbAttr =&_aafe ;_bcbb ._gcc .Color =[]*_ba .CT_Color {_edb };};
 
 // ClearFill clears any fill configuration from the cell style.
 func (_afa CellStyle )ClearFill (){_afa ._daa .FillIdAttr =nil ;_afa ._daa .ApplyFillAttr =nil };
@@ -778,7 +778,7 @@ func PasswordHash (s string )string {_ebce :=uint16 (0);if len (s )> 0{for _fdbb
 func (_fae ConditionalFormattingRule )SetStyle (d DifferentialStyle ){_fae ._bcca .DxfIdAttr =_g .Uint32 (d .Index ());};
 
 // SetColor sets the text color.
-func (_gbf RichTextRun )SetColor (c _gb .Color ){_gbf .ensureRpr ();_gbf ._fgfg .RPr .Color =_ba .NewCT_Color ();_eab :="\u0066\u0066"+*c .AsRGBString ();_gbf ._fgfg .RPr .Color .RgbAttr =&_eab ;};
+func (_gbf RichTextRun )SetColor (c _gb .Color ){_gbf .ensureRpr ();_gbf ._fgfg .RPr .Color =_ba .NewCT_Color ();_eab :="\u0046\u0046"+*c .AsRGBString ();_gbf ._fgfg .RPr .Color .RgbAttr =&_eab ;};
 
 // ColOffset returns the offset from the row cell.
 func (_eaae CellMarker )ColOffset ()_cf .Distance {if _eaae ._ccb .RowOff .ST_CoordinateUnqualified ==nil {return 0;};return _cf .Distance (float64 (*_eaae ._ccb .ColOff .ST_CoordinateUnqualified )*_cf .EMU );};